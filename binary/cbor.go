@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"io"
 	"math"
-	"net/url"
-	"path"
 
 	. "github.com/philandstuff/dhall-golang/core"
 	"github.com/ugorji/go/codec"
@@ -383,47 +381,47 @@ func decode(decodedCbor interface{}) (Term, error) {
 					if err != nil {
 						return nil, err
 					}
-					urlPath := "/"
+					var pathComponents []string
 					for i := 6; i < len(val)-1; i++ {
 						pathComponent, err := unwrapString(val[i])
 						if err != nil {
 							return nil, err
 						}
 
-						urlPath = path.Join(urlPath, pathComponent)
+						pathComponents = append(pathComponents, pathComponent)
 					}
-					query := ""
+					var query *string
 					if val[len(val)-1] != nil {
 						content, err := unwrapString(val[len(val)-1])
 						if err != nil {
 							return nil, err
 						}
-						query = "?" + content
+						query = &content
 					}
-					u, err := url.Parse(scheme + "://" + authority + urlPath + query)
+					f, err = NewRemoteParts(scheme, authority, pathComponents, query)
 					if err != nil {
 						return nil, err
 					}
-					f = NewRemote(u)
 				case 2, 3, 4, 5:
-					var file string
+					var prefix string
 					if importLabel == 2 {
-						file = "/"
+						prefix = "/"
 					} else if importLabel == 3 {
-						file = "."
+						prefix = "."
 					} else if importLabel == 4 {
-						file = ".."
+						prefix = ".."
 					} else {
-						file = "~"
+						prefix = "~"
 					}
+					var components []string
 					for i := 4; i < len(val); i++ {
 						component, err := unwrapString(val[i])
 						if err != nil {
 							return nil, err
 						}
-						file = path.Join(file, component)
+						components = append(components, component)
 					}
-					f = Local(file)
+					f = NewLocal(prefix, components)
 				case 6:
 					name, err := unwrapString(val[4])
 					if err != nil {
@@ -635,26 +633,13 @@ func (b *cborBox) CodecEncodeSelf(e *codec.Encoder) {
 	case IntegerLit:
 		e.Encode(append([]interface{}{16}, int(val)))
 	case DoubleLit:
-		// special-case values to encode as float16
-		if float64(val) == 0.0 { // 0.0
-			if math.Signbit(float64(val)) {
-				e.Encode(codec.Raw([]byte{0xf9, 0x80, 0x00}))
-			} else {
-				e.Encode(codec.Raw([]byte{0xf9, 0x00, 0x00}))
-			}
-		} else if math.IsNaN(float64(val)) { // NaN
-			e.Encode(codec.Raw([]byte{0xf9, 0x7e, 0x00}))
-		} else if math.IsInf(float64(val), 1) { // Infinity
-			e.Encode(codec.Raw([]byte{0xf9, 0x7c, 0x00}))
-		} else if math.IsInf(float64(val), -1) { // -Infinity
-			e.Encode(codec.Raw([]byte{0xf9, 0xfc, 0x00}))
+		// use the smallest width which represents val exactly
+		if half, ok := float16Bits(float64(val)); ok {
+			e.Encode(codec.Raw([]byte{0xf9, byte(half >> 8), byte(half)}))
+		} else if single := float32(val); float64(single) == float64(val) {
+			e.Encode(single)
 		} else {
-			single := float32(val)
-			if float64(single) == float64(val) {
-				e.Encode(single)
-			} else {
-				e.Encode(float64(val))
-			}
+			e.Encode(float64(val))
 		}
 	case TextLitTerm:
 		output := []interface{}{18}
@@ -735,6 +720,9 @@ func (b *cborBox) CodecEncodeSelf(e *codec.Encoder) {
 		e.Encode(output)
 	case Annot:
 		e.Encode([]interface{}{26, box(val.Expr), box(val.Annotation)})
+	case DuplicateLabel:
+		// EncodeAsCbor rejects these before encoding
+		panic("can't happen")
 	default:
 		e.Encode(b.content)
 	}
@@ -750,8 +738,59 @@ func (b *cborBox) CodecDecodeSelf(d *codec.Decoder) {
 	b.content = expr
 }
 
-// EncodeAsCbor encodes a Term as CBOR and writes it to the io.Writer
+// float16Bits returns the IEEE 754 half-precision representation of
+// f, if f can be represented exactly at half precision.  All NaNs are
+// represented by the same quiet NaN.
+func float16Bits(f float64) (uint16, bool) {
+	var sign uint16
+	if math.Signbit(f) {
+		sign = 0x8000
+	}
+	switch {
+	case math.IsNaN(f):
+		return 0x7e00, true
+	case math.IsInf(f, 0):
+		return sign | 0x7c00, true
+	case f == 0:
+		return sign, true
+	}
+	bits := math.Float64bits(f)
+	exp := int(bits>>52&0x7ff) - 1023
+	mantissa := bits & (1<<52 - 1)
+	switch {
+	case exp >= -14 && exp <= 15:
+		// a normal half-precision number, which has 10 bits of
+		// mantissa to our 52
+		if mantissa&(1<<42-1) != 0 {
+			return 0, false
+		}
+		return sign | uint16(exp+15)<<10 | uint16(mantissa>>42), true
+	case exp >= -24 && exp < -14:
+		// a subnormal half-precision number: a multiple of 2^-24
+		shift := uint(42 + -14 - exp)
+		mantissa |= 1 << 52
+		if mantissa&(1<<shift-1) != 0 {
+			return 0, false
+		}
+		return sign | uint16(mantissa>>shift), true
+	}
+	return 0, false
+}
+
+// EncodeAsCbor encodes a Term as CBOR and writes it to the io.Writer.
+// It returns an error, without writing anything, if the Term contains
+// a DuplicateLabel, which has no encoding.
 func EncodeAsCbor(w io.Writer, e Term) error {
+	var err error
+	Walk(e, func(t Term) bool {
+		if d, ok := t.(DuplicateLabel); ok && err == nil {
+			err = fmt.Errorf("can't encode %v with duplicate label %s", d.Term, d.Label)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
 	enc := codec.NewEncoder(w, cbor)
 	return enc.Encode(box(e))
 }
@@ -0,0 +1,68 @@
+package binary_test
+
+import (
+	"bytes"
+
+	. "github.com/philandstuff/dhall-golang/binary"
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+	"github.com/ugorji/go/codec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// cbor returns the CBOR encoding of the array of items, made without
+// EncodeAsCbor so that it can check it.
+func cbor(items ...interface{}) []byte {
+	var b []byte
+	codec.NewEncoderBytes(&b, &codec.CborHandle{}).MustEncode(items)
+	return b
+}
+
+var _ = Describe("EncodeAsCbor", func() {
+	It("Round-trips a Term", func() {
+		var buf bytes.Buffer
+		Expect(EncodeAsCbor(&buf, NewList(NaturalLit(1), NaturalLit(2)))).To(Succeed())
+		Expect(DecodeAsCbor(&buf)).To(Equal(NewList(NaturalLit(1), NaturalLit(2))))
+	})
+	It("Returns an error for a duplicate label, however deep", func() {
+		for _, source := range []string{
+			"{ x : Natural, x : Bool }",
+			"Some < A | A >",
+			"λ(r : { x : Natural, x : Bool }) → r",
+		} {
+			parsed, err := parser.Parse("-", []byte(source))
+			Expect(err).ToNot(HaveOccurred())
+			var buf bytes.Buffer
+			Expect(EncodeAsCbor(&buf, parsed.(Term))).To(MatchError(ContainSubstring("duplicate label")))
+			Expect(buf.Len()).To(Equal(0))
+		}
+	})
+})
+
+// These are the cases of the standard's parser tests for imports,
+// which check that parsing source and encoding the result gives the
+// standard's encoding.
+var _ = DescribeTable("Encoding parsed imports",
+	func(source string, expected []byte) {
+		parsed, err := parser.Parse("-", []byte(source))
+		Expect(err).ToNot(HaveOccurred())
+		var buf bytes.Buffer
+		Expect(EncodeAsCbor(&buf, parsed.(Term))).To(Succeed())
+		Expect(buf.Bytes()).To(Equal(expected))
+	},
+	Entry("quoted local path components",
+		`/"foo"/bar/"baz qux"`,
+		cbor(24, nil, 0, 2, "foo", "bar", "baz qux")),
+	Entry("quoted URL path components",
+		`https://example.com/foo/"bar?baz"?qux`,
+		cbor(24, nil, 0, 1, nil, "example.com", "foo", "bar%3Fbaz", "qux")),
+	Entry("URL with every kind of character",
+		`https://-._~%2C!$&'*+;=:@0--1.example:12/foo/bar%20baz/-._~!$&'*+;=:@?-._~!$&'*+;=:@/?`,
+		cbor(24, nil, 0, 1, nil, "-._~%2C!$&'*+;=:@0--1.example:12", "foo", "bar%20baz", "-._~!$&'*+;=:@", "-._~!$&'*+;=:@/?")),
+	Entry("URL with future IP address",
+		`http://[v1.fe80::a+en1]:80/foo`,
+		cbor(24, nil, 0, 0, nil, "[v1.fe80::a+en1]:80", "foo", nil)),
+)
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/philandstuff/dhall-golang/diff"
+)
+
+// diffCmd implements `diff OLD NEW`, which shows the semantic
+// differences between two expressions.  Like diff(1), it exits with
+// status 0 if there are none, 1 if there are some, and 2 on error.
+func diffCmd(args []string) int {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	plain := flags.Bool("plain", false, "don't color the output")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: diff [-plain] OLD NEW")
+		fmt.Fprintln(flags.Output(), "Show the differences between the normal forms of the expressions OLD and NEW")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+	old, err := parseAndLoad(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	new, err := parseAndLoad(flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	d, err := diff.Compare(old, new)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Type error: %v\n", err)
+		return 2
+	}
+	if d.Empty() {
+		return 0
+	}
+	if err := d.Render(os.Stdout, !*plain && isTerminal(os.Stdout)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	return 1
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/philandstuff/dhall-golang/docs"
+	"github.com/philandstuff/dhall-golang/parser"
+)
+
+// docsCmd implements `docs [-output DIR] [-format FORMAT] [-title
+// TITLE] [PACKAGE]`, which writes a static site documenting the Dhall
+// files in the directory PACKAGE, or the current directory if it is
+// not given.  Files which don't typecheck are still documented, but
+// are reported on standard error, and the exit status is then 1.
+func docsCmd(args []string) int {
+	flags := flag.NewFlagSet("docs", flag.ContinueOnError)
+	output := flags.String("output", "docs", "write the site to the directory `DIR`")
+	format := flags.String("format", "html", "write the site as `FORMAT`, html or markdown")
+	title := flags.String("title", "", "the `TITLE` of the index page (default the name of the package directory)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: docs [-output DIR] [-format FORMAT] [-title TITLE] [PACKAGE]")
+		fmt.Fprintln(flags.Output(), "Generate documentation for a directory of Dhall files")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	root := "."
+	switch flags.NArg() {
+	case 0:
+	case 1:
+		root = flags.Arg(0)
+	default:
+		flags.Usage()
+		return 1
+	}
+	var siteFormat docs.Format
+	switch *format {
+	case "html":
+		siteFormat = docs.HTML
+	case "markdown", "md":
+		siteFormat = docs.Markdown
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", *format)
+		flags.Usage()
+		return 1
+	}
+	if *title == "" {
+		abs, err := filepath.Abs(root)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		*title = filepath.Base(abs)
+	}
+	pkg, err := docs.Load(root)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if err := pkg.Write(*output, *title, siteFormat); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	status := 0
+	for _, f := range pkg.Files {
+		if f.Err == nil {
+			continue
+		}
+		// parse errors say where they are
+		var perr parser.Error
+		if errors.As(f.Err, &perr) {
+			fmt.Fprintln(os.Stderr, f.Err)
+		} else {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", f.Path, f.Err)
+		}
+		status = 1
+	}
+	return status
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/philandstuff/dhall-golang/lint"
+)
+
+// lintCmd implements `lint [-fix] [FILE...]`, which reports problems
+// in Dhall files, or in standard input if no files are given.  With
+// -fix, it fixes what it can, rewriting the files in place or writing
+// the fixed input to standard output.  It exits with status 1 if any
+// file doesn't parse or has problems which are warnings or errors, so
+// that it can be used in CI.
+func lintCmd(args []string) int {
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	fix := flags.Bool("fix", false, "fix the problems which can be fixed automatically")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lint [-fix] [FILE...]")
+		fmt.Fprintln(flags.Output(), "Report problems in Dhall files")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	status := 0
+	for _, file := range files {
+		if !lintFile(file, *fix) {
+			status = 1
+		}
+	}
+	return status
+}
+
+// lintFile lints a single file, or standard input if file is "-",
+// and reports whether it passed.
+func lintFile(file string, fix bool) bool {
+	var src []byte
+	var err error
+	if file == "-" {
+		src, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		src, err = ioutil.ReadFile(file)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return false
+	}
+	var findings []lint.Finding
+	var fixed []byte
+	applied := 0
+	if fix {
+		fixed, applied, findings, err = lint.FixAll(file, src)
+	} else {
+		findings, err = lint.Lint(file, src)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Parse error: %v\n", err)
+		return false
+	}
+	// when fixing standard input, standard output is for the fixed
+	// source
+	out := os.Stdout
+	if fix {
+		if err := writeFixed(file, fixed); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return false
+		}
+		if applied > 0 {
+			fmt.Fprintf(os.Stderr, "Applied %d fixes to %s\n", applied, file)
+		}
+		if file == "-" {
+			out = os.Stderr
+		}
+	}
+	ok := true
+	for _, f := range findings {
+		fmt.Fprintf(out, "%s:%v\n", file, f)
+		if f.Severity >= lint.Warning {
+			ok = false
+		}
+	}
+	return ok
+}
+
+// writeFixed writes the fixed source of file back to it, or to
+// standard output if file is "-".
+func writeFixed(file string, fixed []byte) error {
+	if file == "-" {
+		_, err := os.Stdout.Write(fixed)
+		return err
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, fixed, info.Mode())
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/philandstuff/dhall-golang/imports"
+	"github.com/philandstuff/dhall-golang/lsp"
+)
+
+// lspCmd implements `lsp`, a language server which editors talk to
+// over stdin and stdout.
+func lspCmd(args []string) int {
+	flags := flag.NewFlagSet("lsp", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lsp")
+		fmt.Fprintln(flags.Output(), "Run a language server, speaking LSP over stdin and stdout")
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 1
+	}
+	s := lsp.Server{Cache: imports.StandardCache{}}
+	if err := s.Serve(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
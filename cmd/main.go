@@ -3,8 +3,11 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/philandstuff/dhall-golang/binary"
 	"github.com/philandstuff/dhall-golang/core"
@@ -13,13 +16,34 @@ import (
 )
 
 func main() {
-	expr, err := parser.ParseReader("-", os.Stdin)
-	if err != nil {
-		log.Fatalf("Parse error: %v", err)
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diff":
+			os.Exit(diffCmd(os.Args[2:]))
+		case "text":
+			os.Exit(textCmd(os.Args[2:]))
+		case "repl":
+			os.Exit(replCmd(os.Args[2:]))
+		case "lsp":
+			os.Exit(lspCmd(os.Args[2:]))
+		case "lint":
+			os.Exit(lintCmd(os.Args[2:]))
+		case "docs":
+			os.Exit(docsCmd(os.Args[2:]))
+		}
+	}
+	var resolvedExpr core.Term
+	var err error
+	switch len(os.Args) {
+	case 1:
+		resolvedExpr, err = parseAndLoadReader("-", os.Stdin)
+	case 2:
+		resolvedExpr, err = parseAndLoadFile(os.Args[1])
+	default:
+		log.Fatalf("Usage: %s [FILE]", filepath.Base(os.Args[0]))
 	}
-	resolvedExpr, err := imports.Load(expr.(core.Term))
 	if err != nil {
-		log.Fatalf("Import resolve error: %v", err)
+		log.Fatal(err)
 	}
 	inferredType, err := core.TypeOf(resolvedExpr)
 	if err != nil {
@@ -37,3 +61,42 @@ func main() {
 	}
 	fmt.Printf("decoded as %+v\n", final)
 }
+
+// parseAndLoad parses source, given on the command line, and resolves
+// its imports relative to the current directory
+func parseAndLoad(source string) (core.Term, error) {
+	return parseAndLoadReader("(input)", strings.NewReader(source))
+}
+
+// parseAndLoadReader parses the source read from r, and resolves its
+// imports relative to the current directory
+func parseAndLoadReader(name string, r io.Reader) (core.Term, error) {
+	expr, err := parser.ParseReader(name, r)
+	if err != nil {
+		return nil, fmt.Errorf("Parse error: %v", err)
+	}
+	resolvedExpr, err := imports.Load(expr.(core.Term))
+	if err != nil {
+		return nil, fmt.Errorf("Import resolve error: %v", err)
+	}
+	return resolvedExpr, nil
+}
+
+// parseAndLoadFile parses the file at path, and resolves its imports
+// relative to the file, as if it had been imported
+func parseAndLoadFile(path string) (core.Term, error) {
+	expr, err := parser.ParseFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Parse error: %v", err)
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	here := core.NewLocal("/", strings.Split(strings.TrimPrefix(filepath.ToSlash(abs), "/"), "/"))
+	resolvedExpr, err := imports.Load(expr.(core.Term), here)
+	if err != nil {
+		return nil, fmt.Errorf("Import resolve error: %v", err)
+	}
+	return resolvedExpr, nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/philandstuff/dhall-golang/imports"
+	"github.com/philandstuff/dhall-golang/repl"
+)
+
+// replCmd implements `repl`, an interactive session for
+// experimenting with expressions.
+func replCmd(args []string) int {
+	flags := flag.NewFlagSet("repl", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: repl")
+		fmt.Fprintln(flags.Output(), "Evaluate expressions interactively")
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 1
+	}
+	fmt.Println("Enter :help for a list of commands, or :quit to quit.")
+	r := repl.REPL{Cache: imports.StandardCache{}}
+	if err := r.Run(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
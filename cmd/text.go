@@ -0,0 +1,81 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/philandstuff/dhall-golang/core"
+)
+
+// textCmd implements `text [-output FILE] [FILE]`, which evaluates an
+// expression of type Text and writes the resulting string as it is,
+// without quotes or escapes.  It reads the expression from FILE, or
+// from standard input if FILE is not given, so that it can be used
+// in a `#!/usr/bin/env dhall-golang text` line.  It exits with status
+// 0 on success and 1 on error.
+func textCmd(args []string) int {
+	flags := flag.NewFlagSet("text", flag.ContinueOnError)
+	output := flags.String("output", "", "write the text to `FILE` instead of standard output")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: text [-output FILE] [FILE]")
+		fmt.Fprintln(flags.Output(), "Render an expression of type Text as raw text")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	var expr core.Term
+	var err error
+	switch flags.NArg() {
+	case 0:
+		expr, err = parseAndLoadReader("-", os.Stdin)
+	case 1:
+		expr, err = parseAndLoadFile(flags.Arg(0))
+	default:
+		flags.Usage()
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	text, err := renderText(expr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if *output != "" {
+		err = ioutil.WriteFile(*output, []byte(text), 0666)
+	} else {
+		_, err = os.Stdout.WriteString(text)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// renderText typechecks and evaluates expr, which must have type
+// Text, and returns the string it normalizes to.  It fails if an
+// interpolation is left in the result because it didn't normalize to
+// a Text literal.
+func renderText(expr core.Term) (string, error) {
+	typ, err := core.TypeOf(expr)
+	if err != nil {
+		return "", fmt.Errorf("Type error: %v", err)
+	}
+	if typ != core.Text {
+		return "", fmt.Errorf("Type error: expression has type %v, but text output needs Text", core.Quote(typ))
+	}
+	lit, ok := core.Eval(expr).(core.TextLitVal)
+	if !ok {
+		return "", fmt.Errorf("expression did not normalize to a Text literal: %v", core.AlphaBetaEval(expr))
+	}
+	if len(lit.Chunks) > 0 {
+		return "", fmt.Errorf("interpolation `${%v}` did not normalize to a Text literal", core.Quote(lit.Chunks[0].Expr))
+	}
+	return lit.Suffix, nil
+}
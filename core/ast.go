@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 )
 
@@ -122,6 +123,8 @@ type (
 		typ  Value
 		succ Value
 		// zero Value
+
+		budget *budget
 	}
 	naturalIsZeroVal   struct{}
 	naturalOddVal      struct{}
@@ -146,11 +149,13 @@ type (
 		// none Value
 	}
 
-	textShowVal struct{}
+	textShowVal struct{ budget *budget }
 
 	listBuildVal struct {
 		typ Value
 		// fn  Value
+
+		budget *budget
 	}
 	listFoldVal struct {
 		typ1 Value
@@ -158,12 +163,20 @@ type (
 		typ2 Value
 		cons Value
 		// empty Value
+
+		budget *budget
 	}
 	listLengthVal  struct{ typ Value }
 	listHeadVal    struct{ typ Value }
 	listLastVal    struct{ typ Value }
-	listIndexedVal struct{ typ Value }
-	listReverseVal struct{ typ Value }
+	listIndexedVal struct {
+		typ    Value
+		budget *budget
+	}
+	listReverseVal struct {
+		typ    Value
+		budget *budget
+	}
 )
 
 func (naturalBuildVal) isValue()     {}
@@ -425,7 +438,9 @@ type (
 		Arg Value
 	}
 
-	opValue struct {
+	// An OpValue is two Values combined by an operator which can't
+	// be evaluated any further, such as `x + 1` with x free.
+	OpValue struct {
 		OpCode int
 		L      Value
 		R      Value
@@ -438,7 +453,7 @@ func (PiValue) isValue() {}
 
 func (AppValue) isValue() {}
 
-func (opValue) isValue() {}
+func (OpValue) isValue() {}
 
 // NewPiVal returns a new pi Value.
 func NewPiVal(label string, d Value, r func(Value) Value) Value {
@@ -527,7 +542,9 @@ type (
 		F    Term
 	}
 
-	ifVal struct {
+	// An IfVal is an if-then-else whose condition is not a BoolLit,
+	// so can't be evaluated any further.
+	IfVal struct {
 		Cond Value
 		T    Value
 		F    Value
@@ -553,7 +570,9 @@ type (
 		Record Term
 		Type   Term // optional
 	}
-	toMapVal struct {
+	// A ToMapVal is a toMap whose record is not a RecordLitVal, so
+	// can't be evaluated any further.
+	ToMapVal struct {
 		Record Value
 		Type   Value // optional
 	}
@@ -562,7 +581,11 @@ type (
 		Record    Term
 		FieldName string
 	}
-	fieldVal struct {
+	// A FieldVal is a field selection which can't be evaluated any
+	// further, such as `r.a` with r free.  A FieldVal whose Record
+	// is a UnionTypeVal is an alternative of that union type; see
+	// UnionAlternative.
+	FieldVal struct {
 		Record    Value
 		FieldName string
 	}
@@ -571,7 +594,9 @@ type (
 		Record     Term
 		FieldNames []string
 	}
-	projectVal struct {
+	// A ProjectVal is a projection which can't be evaluated any
+	// further, such as `r.{ a, b }` with r free.
+	ProjectVal struct {
 		Record     Value
 		FieldNames []string
 	}
@@ -582,24 +607,48 @@ type (
 	}
 	// no ProjectTypeVal because it cannot be in a normal form
 
-	UnionType    map[string]Term
-	unionTypeVal map[string]Value
+	// A UnionType maps each alternative's name to the type of its
+	// payload, which is nil for an alternative without a payload.
+	UnionType map[string]Term
+	// A UnionTypeVal is the Value of a UnionType.
+	UnionTypeVal map[string]Value
 
 	Merge struct {
 		Handler    Term
 		Union      Term
 		Annotation Term // optional
 	}
-	mergeVal struct {
+	// A MergeVal is a merge whose Union is not a union alternative,
+	// so can't be evaluated any further.
+	MergeVal struct {
 		Handler    Value
 		Union      Value
 		Annotation Value // optional
 	}
 
-	Assert    struct{ Annotation Term }
-	assertVal struct{ Annotation Value }
+	// An Assert is an assertion that its Annotation, an
+	// equivalence, holds.
+	Assert struct{ Annotation Term }
+	// An AssertVal is the Value of an Assert.
+	AssertVal struct{ Annotation Value }
 )
 
+// A DuplicateLabel is a record type or union type which uses the
+// same field or alternative label more than once, such as
+// `{ x : Natural, x : Bool }`.  Term is the record type or union type
+// with only the first use of each label, and Label is the first
+// duplicated label.
+//
+// The standard requires duplicate labels to be a type error rather
+// than a parse error, so the parser produces a DuplicateLabel and
+// TypeOf rejects it.  A DuplicateLabel is never well-typed.
+type DuplicateLabel struct {
+	Term  Term
+	Label string
+}
+
+func (DuplicateLabel) isTerm() {}
+
 func (NaturalLit) isTerm()  {}
 func (NaturalLit) isValue() {}
 
@@ -620,27 +669,44 @@ func (TextLitVal) isValue() {}
 func (BoolLit) isTerm()  {}
 func (BoolLit) isValue() {}
 func (IfTerm) isTerm()   {}
-func (ifVal) isValue()   {}
+func (IfVal) isValue()   {}
 
 func (DoubleLit) isTerm()   {}
 func (DoubleLit) isValue()  {}
 func (IntegerLit) isTerm()  {}
 func (IntegerLit) isValue() {}
 
+// String formats d as a Dhall Double literal, in the format the
+// standard requires of Double/show: the shortest decimal which
+// represents d exactly, in positional notation if 0.1 <= |d| < 10^7
+// and in scientific notation otherwise, always with a fractional
+// part.  For example, 1.0, -0.0, 1.0e-2 and 1.2345678e7.
 func (d DoubleLit) String() string {
 	f := float64(d)
-	if math.IsInf(f, 1) {
+	switch {
+	case math.IsInf(f, 1):
 		return "Infinity"
-	}
-	if math.IsInf(f, -1) {
+	case math.IsInf(f, -1):
 		return "-Infinity"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	abs := math.Abs(f)
+	if abs == 0 || abs >= 0.1 && abs < 1e7 {
+		s := strconv.FormatFloat(f, 'f', -1, 64)
+		if !strings.Contains(s, ".") {
+			s += ".0"
+		}
+		return s
 	}
-	// if we have a whole number, we need to append .0 to it so we get a valid
-	// Double literal
-	if f == float64(int64(f)) {
-		return fmt.Sprintf("%#v.0", float64(d))
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	i := strings.IndexByte(s, 'e')
+	mantissa, exponent := s[:i], s[i+1:]
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
 	}
-	return fmt.Sprintf("%#v", float64(d))
+	exp, _ := strconv.Atoi(exponent)
+	return mantissa + "e" + strconv.Itoa(exp)
 }
 
 func (Some) isTerm()     {}
@@ -651,18 +717,18 @@ func (RecordTypeVal) isValue() {}
 func (RecordLit) isTerm()      {}
 func (RecordLitVal) isValue()  {}
 func (ToMap) isTerm()          {}
-func (toMapVal) isValue()      {}
+func (ToMapVal) isValue()      {}
 func (Field) isTerm()          {}
-func (fieldVal) isValue()      {}
+func (FieldVal) isValue()      {}
 func (Project) isTerm()        {}
-func (projectVal) isValue()    {}
+func (ProjectVal) isValue()    {}
 func (ProjectType) isTerm()    {}
 func (UnionType) isTerm()      {}
-func (unionTypeVal) isValue()  {}
+func (UnionTypeVal) isValue()  {}
 func (Merge) isTerm()          {}
-func (mergeVal) isValue()      {}
+func (MergeVal) isValue()      {}
 func (Assert) isTerm()         {}
-func (assertVal) isValue()     {}
+func (AssertVal) isValue()     {}
 
 type (
 	// An Import is an import Term.
@@ -0,0 +1,189 @@
+package core_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/philandstuff/dhall-golang/core"
+)
+
+// nestedLambdas returns λ(x0 : Natural) → … → λ(xn : Natural) → x0 + … + xn
+func nestedLambdas(n int) Term {
+	var body Term = NaturalLit(0)
+	for i := 0; i < n; i++ {
+		body = NaturalPlus(NewVar(fmt.Sprintf("x%d", i)), body)
+	}
+	for i := n - 1; i >= 0; i-- {
+		body = NewLambda(fmt.Sprintf("x%d", i), Natural, body)
+	}
+	return body
+}
+
+// nestedLets returns let x0 = 0 let x1 = x0 + 1 … in xn
+func nestedLets(n int) Term {
+	bindings := []Binding{{Variable: "x0", Value: NaturalLit(0)}}
+	for i := 1; i < n; i++ {
+		bindings = append(bindings, Binding{
+			Variable: fmt.Sprintf("x%d", i),
+			Value:    NaturalPlus(NewVar(fmt.Sprintf("x%d", i-1)), NaturalLit(1)),
+		})
+	}
+	return NewLet(NewVar(fmt.Sprintf("x%d", n-1)), bindings...)
+}
+
+func BenchmarkTypeOfNestedLambdas(b *testing.B) {
+	term := nestedLambdas(100)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := TypeOf(term); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEvalNestedLets(b *testing.B) {
+	term := nestedLets(500)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Eval(term)
+	}
+}
+
+func BenchmarkQuoteNestedLambdas(b *testing.B) {
+	val := Eval(nestedLambdas(500))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Quote(val)
+	}
+}
+
+// expensive returns a Term which takes a while to evaluate:
+// Natural/fold 1000 Natural (λ(n : Natural) → n + 1) 0
+func expensive() Term {
+	return Apply(NaturalFold,
+		NaturalLit(1000),
+		Natural,
+		NewLambda("n", Natural, NaturalPlus(NewVar("n"), NaturalLit(1))),
+		NaturalLit(0),
+	)
+}
+
+// largeRecord returns { f0 = expensive, …, fn = expensive }.field
+func largeRecordField(n int, field string) Term {
+	record := RecordLit{}
+	for i := 0; i < n; i++ {
+		record[fmt.Sprintf("f%d", i)] = expensive()
+	}
+	return Field{Record: record, FieldName: field}
+}
+
+func BenchmarkEvalUnusedLet(b *testing.B) {
+	term := NewLet(NaturalLit(1), Binding{Variable: "unused", Value: expensive()})
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Eval(term)
+	}
+}
+
+func BenchmarkEvalFieldOfLargeRecord(b *testing.B) {
+	term := largeRecordField(100, "f0")
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Eval(term)
+	}
+}
+
+func BenchmarkEvalSharedLet(b *testing.B) {
+	// let x = expensive in x + x + … + x
+	var body Term = NewVar("x")
+	for i := 0; i < 100; i++ {
+		body = NaturalPlus(NewVar("x"), body)
+	}
+	term := NewLet(body, Binding{Variable: "x", Value: expensive()})
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Eval(term)
+	}
+}
+
+// preludeLike returns a Term shaped like the Prelude: a chain of n
+// let-bound polymorphic functions over lists, each defined with the
+// one before, collected into a record.
+//
+//	let f0 = λ(a : Type) → λ(xs : List a) →
+//	      List/fold a xs (List a) (λ(x : a) → λ(acc : List a) → [ x ] # acc) ([] : List a)
+//	let f1 = λ(a : Type) → λ(xs : List a) → f0 a xs # xs
+//	…
+//	in  { f0 = f0, …, fn = fn }
+func preludeLike(n int) Term {
+	listA := Apply(List, NewVar("a"))
+	cons := NewLambda("x", NewVar("a"), NewLambda("acc", listA,
+		ListAppend(NonEmptyList{NewVar("x")}, NewVar("acc"))))
+	bindings := []Binding{{
+		Variable: "f0",
+		Value: NewLambda("a", Type, NewLambda("xs", listA,
+			Apply(ListFold, NewVar("a"), NewVar("xs"), listA, cons, EmptyList{Type: listA}))),
+	}}
+	record := RecordLit{"f0": NewVar("f0")}
+	for i := 1; i < n; i++ {
+		name, prev := fmt.Sprintf("f%d", i), NewVar(fmt.Sprintf("f%d", i-1))
+		bindings = append(bindings, Binding{
+			Variable: name,
+			Value: NewLambda("a", Type, NewLambda("xs", listA,
+				ListAppend(Apply(prev, NewVar("a"), NewVar("xs")), NewVar("xs")))),
+		})
+		record[name] = NewVar(name)
+	}
+	return NewLet(record, bindings...)
+}
+
+// largeExpression returns a Term shaped like the parser's large
+// expression test: a list of n records built with a let-bound function.
+//
+//	let f = λ(x : Natural) → { a = x, b = "${Natural/show x}" }
+//	in  [ { name = "item", value = f 1 ⫽ { c = [ 1, 2, 3 ] },
+//	        ok = True && False, kind = < A | B : Natural >.B 2 }, … ]
+func largeExpression(n int) Term {
+	f := NewLambda("x", Natural, RecordLit{
+		"a": NewVar("x"),
+		"b": TextLitTerm{Chunks: Chunks{{Expr: Apply(NaturalShow, NewVar("x"))}}},
+	})
+	items := make(NonEmptyList, n)
+	for i := range items {
+		items[i] = RecordLit{
+			"name": TextLitTerm{Suffix: "item"},
+			"value": OpTerm{
+				OpCode: RightBiasedRecordMergeOp,
+				L:      Apply(NewVar("f"), NaturalLit(1)),
+				R:      RecordLit{"c": NonEmptyList{NaturalLit(1), NaturalLit(2), NaturalLit(3)}},
+			},
+			"ok": BoolAnd(BoolLit(true), BoolLit(false)),
+			"kind": Apply(Field{
+				Record:    UnionType{"A": nil, "B": Natural},
+				FieldName: "B",
+			}, NaturalLit(2)),
+		}
+	}
+	return NewLet(items, Binding{Variable: "f", Value: f})
+}
+
+func BenchmarkTypeOfPreludeLike(b *testing.B) {
+	term := preludeLike(100)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := TypeOf(term); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNormalizeLargeExpression(b *testing.B) {
+	term := largeExpression(100)
+	if _, err := TypeOf(term); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Quote(Eval(term))
+	}
+}
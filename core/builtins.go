@@ -10,10 +10,11 @@ func (naturalBuildVal) Call(x Value) Value {
 		Label:  "x",
 		Domain: Natural,
 		Fn: func(x Value) Value {
+			x = force(x)
 			if n, ok := x.(NaturalLit); ok {
 				return NaturalLit(n + 1)
 			}
-			return opValue{OpCode: PlusOp, L: x, R: NaturalLit(1)}
+			return OpValue{OpCode: PlusOp, L: x, R: NaturalLit(1)}
 		},
 	}
 	if fold, ok := x.(naturalFoldVal); ok {
@@ -33,26 +34,32 @@ func (naturalEvenVal) Call(x Value) Value {
 
 func (fold naturalFoldVal) Call(x Value) Value {
 	if fold.n == nil {
-		return naturalFoldVal{n: x}
+		return naturalFoldVal{n: x, budget: fold.budget}
 	}
 	if fold.typ == nil {
 		return naturalFoldVal{
-			n:   fold.n,
-			typ: x,
+			n:      fold.n,
+			typ:    x,
+			budget: fold.budget,
 		}
 	}
 	if fold.succ == nil {
 		return naturalFoldVal{
-			n:    fold.n,
-			typ:  fold.typ,
-			succ: x,
+			n:      fold.n,
+			typ:    fold.typ,
+			succ:   x,
+			budget: fold.budget,
 		}
 	}
 	zero := x
 	if n, ok := fold.n.(NaturalLit); ok {
+		// charge up front, so that we give up straight away on
+		// an enormous fold
+		fold.budget.charge(int(n))
 		result := zero
 		for i := 0; i < int(n); i++ {
 			result = applyVal(fold.succ, result)
+			fold.budget.checkValueSize(result)
 		}
 		return result
 	}
@@ -186,7 +193,7 @@ func (fold optionalFoldVal) Call(x Value) Value {
 	return nil
 }
 
-func (textShowVal) Call(a0 Value) Value {
+func (show textShowVal) Call(a0 Value) Value {
 	if t, ok := a0.(TextLitVal); ok {
 		if t.Chunks == nil || len(t.Chunks) == 0 {
 			var out strings.Builder
@@ -218,6 +225,7 @@ func (textShowVal) Call(a0 Value) Value {
 				}
 			}
 			out.WriteRune('"')
+			show.budget.checkSize(out.Len())
 			return TextLitVal{Suffix: out.String()}
 		}
 	}
@@ -226,7 +234,7 @@ func (textShowVal) Call(a0 Value) Value {
 
 func (l listBuildVal) Call(x Value) Value {
 	if l.typ == nil {
-		return listBuildVal{typ: x}
+		return listBuildVal{typ: x, budget: l.budget}
 	}
 	var cons Value = LambdaValue{
 		Label:  "a",
@@ -236,13 +244,16 @@ func (l listBuildVal) Call(x Value) Value {
 				Label:  "as",
 				Domain: AppValue{List, l.typ},
 				Fn: func(as Value) Value {
+					l.budget.charge(1)
+					as = force(as)
 					if _, ok := as.(EmptyListVal); ok {
 						return NonEmptyListVal{a}
 					}
 					if as, ok := as.(NonEmptyListVal); ok {
+						l.budget.checkSize(len(as) + 1)
 						return append(NonEmptyListVal{a}, as...)
 					}
-					return opValue{OpCode: ListAppendOp, L: NonEmptyListVal{a}, R: as}
+					return OpValue{OpCode: ListAppendOp, L: NonEmptyListVal{a}, R: as}
 				},
 			}
 		},
@@ -258,24 +269,26 @@ func (l listBuildVal) Call(x Value) Value {
 
 func (l listFoldVal) Call(x Value) Value {
 	if l.typ1 == nil {
-		return listFoldVal{typ1: x}
+		return listFoldVal{typ1: x, budget: l.budget}
 	}
 	if l.list == nil {
-		return listFoldVal{typ1: l.typ1, list: x}
+		return listFoldVal{typ1: l.typ1, list: x, budget: l.budget}
 	}
 	if l.typ2 == nil {
 		return listFoldVal{
-			typ1: l.typ1,
-			list: l.list,
-			typ2: x,
+			typ1:   l.typ1,
+			list:   l.list,
+			typ2:   x,
+			budget: l.budget,
 		}
 	}
 	if l.cons == nil {
 		return listFoldVal{
-			typ1: l.typ1,
-			list: l.list,
-			typ2: l.typ2,
-			cons: x,
+			typ1:   l.typ1,
+			list:   l.list,
+			typ2:   l.typ2,
+			cons:   x,
+			budget: l.budget,
 		}
 	}
 	empty := x
@@ -283,9 +296,11 @@ func (l listFoldVal) Call(x Value) Value {
 		return empty
 	}
 	if list, ok := l.list.(NonEmptyListVal); ok {
+		l.budget.charge(len(list))
 		result := empty
 		for i := len(list) - 1; i >= 0; i-- {
 			result = applyVal(l.cons, list[i], result)
+			l.budget.checkValueSize(result)
 		}
 		return result
 	}
@@ -333,7 +348,7 @@ func (last listLastVal) Call(x Value) Value {
 
 func (indexed listIndexedVal) Call(x Value) Value {
 	if indexed.typ == nil {
-		return listIndexedVal{typ: x}
+		return listIndexedVal{typ: x, budget: indexed.budget}
 	}
 	if _, ok := x.(EmptyListVal); ok {
 		return EmptyListVal{AppValue{
@@ -342,6 +357,7 @@ func (indexed listIndexedVal) Call(x Value) Value {
 		}}
 	}
 	if l, ok := x.(NonEmptyListVal); ok {
+		indexed.budget.charge(len(l))
 		var result []Value
 		for i, v := range l {
 			result = append(result,
@@ -354,12 +370,13 @@ func (indexed listIndexedVal) Call(x Value) Value {
 
 func (rev listReverseVal) Call(x Value) Value {
 	if rev.typ == nil {
-		return listReverseVal{typ: x}
+		return listReverseVal{typ: x, budget: rev.budget}
 	}
 	if _, ok := x.(EmptyListVal); ok {
 		return x
 	}
 	if l, ok := x.(NonEmptyListVal); ok {
+		rev.budget.charge(len(l))
 		result := make([]Value, len(l))
 		for i, v := range l {
 			result[len(l)-i-1] = v
@@ -5,5 +5,13 @@ The main types are Term and Value.  A Term represents an arbitrary
 Dhall expression, while a Value represents a fully beta-normalized
 Dhall value.  Eval() takes Terms and normalizes them to Values;
 Quote() takes Values and expresses them as Terms.
+
+Most Values can be inspected with a type switch: literals such as
+NaturalLit, TextLitVal and RecordLitVal, functions such as
+LambdaValue, and expressions which can't be evaluated any further
+because they depend on a free variable, such as AppValue, OpValue,
+FieldVal and MergeVal.  The exceptions are builtin functions, which
+should be inspected with BuiltinApplication.  UnionAlternative and
+OptionalLit help with the common cases of union and Optional values.
 */
 package core
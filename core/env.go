@@ -0,0 +1,68 @@
+package core
+
+// An Env maps variable names to the values they are bound to.  It is
+// a persistent linked list: extending an Env takes constant time and
+// leaves the original unchanged, so closures can share the
+// environment they were created in rather than copying it.  The zero
+// Env is empty.
+type Env struct {
+	head *envEntry
+	// budget limits the resources evaluation may use; nil means
+	// unlimited
+	budget *budget
+	// locals are the values of the localVars which stand for the
+	// variables in scope when typechecking
+	locals *envEntry
+}
+
+type envEntry struct {
+	name  string
+	value Value
+	next  *envEntry
+}
+
+// extend returns a new Env with name bound to v, shadowing any
+// existing bindings of name.
+func (e Env) extend(name string, v Value) Env {
+	return Env{&envEntry{name: name, value: v, next: e.head}, e.budget, e.locals}
+}
+
+// count returns the number of bindings of name in e.
+func (e Env) count(name string) int {
+	n := 0
+	for entry := e.head; entry != nil; entry = entry.next {
+		if entry.name == name {
+			n++
+		}
+	}
+	return n
+}
+
+// lookup returns the value of the variable name@index, where index
+// counts outwards from the innermost binding of name.
+func (e Env) lookup(name string, index int) (Value, bool) {
+	for entry := e.head; entry != nil; entry = entry.next {
+		if entry.name == name {
+			if index == 0 {
+				return entry.value, true
+			}
+			index--
+		}
+	}
+	return nil, false
+}
+
+// lookupLevel returns the value of the level'th binding of name,
+// where level counts inwards from the outermost binding of name.
+func (e Env) lookupLevel(name string, level int) (Value, bool) {
+	if level < 0 {
+		return nil, false
+	}
+	return e.lookup(name, e.count(name)-1-level)
+}
+
+// local returns the value of the variable which v stands for, if it
+// is in the typechecking scope of e.
+func (e Env) local(v localVar) (Value, bool) {
+	return Env{head: e.locals}.lookupLevel(v.Name, v.Index)
+}
@@ -1,10 +1,317 @@
 package core
 
-import "math"
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Equivalent reports whether a and b are judgmentally equal: that is,
+// whether they have the same normal form, up to alpha-equivalence.
+// Both a and b must typecheck; Equivalent returns an error if either
+// does not.  Imports must already have been resolved.
+func Equivalent(a, b Term) (bool, error) {
+	if _, err := TypeOf(a); err != nil {
+		return false, err
+	}
+	if _, err := TypeOf(b); err != nil {
+		return false, err
+	}
+	return judgmentallyEqual(a, b), nil
+}
+
+// AlphaEquivalent reports whether a and b are the same Term up to
+// the names of their bound variables, such as λ(a : Natural) → a and
+// λ(b : Natural) → b.  Unlike Equivalent it neither typechecks nor
+// normalizes them, so 1 + 1 and 2 are not alpha-equivalent; to compare
+// semantics, pass it normal forms.
+func AlphaEquivalent(a, b Term) bool {
+	return reflect.DeepEqual(alphaNormalize(nil, a), alphaNormalize(nil, b))
+}
+
+// alphaNormalize returns t with every bound variable renamed to _, as
+// in the standard's α-normalization.  binders are the names bound
+// around t, innermost last.  The Terms it returns are only for
+// comparing with reflect.DeepEqual: DoubleLits are replaced with
+// their bit patterns, so that NaN is equal to itself and 0.0 is not
+// equal to -0.0, as the standard requires.
+func alphaNormalize(binders []string, t Term) Term {
+	switch t := t.(type) {
+	case Var:
+		index := t.Index
+		for i := len(binders) - 1; i >= 0; i-- {
+			if binders[i] != t.Name {
+				continue
+			}
+			if index == 0 {
+				return Var{Name: "_", Index: len(binders) - 1 - i}
+			}
+			index--
+		}
+		if t.Name == "_" {
+			index += len(binders)
+		}
+		return Var{Name: t.Name, Index: index}
+	case LambdaTerm:
+		return LambdaTerm{
+			Label: "_",
+			Type:  alphaNormalize(binders, t.Type),
+			Body:  alphaNormalize(append(binders[:len(binders):len(binders)], t.Label), t.Body),
+		}
+	case PiTerm:
+		return PiTerm{
+			Label: "_",
+			Type:  alphaNormalize(binders, t.Type),
+			Body:  alphaNormalize(append(binders[:len(binders):len(binders)], t.Label), t.Body),
+		}
+	case Let:
+		result := Let{}
+		for _, b := range t.Bindings {
+			binding := Binding{Variable: "_", Value: alphaNormalize(binders, b.Value)}
+			if b.Annotation != nil {
+				binding.Annotation = alphaNormalize(binders, b.Annotation)
+			}
+			result.Bindings = append(result.Bindings, binding)
+			binders = append(binders[:len(binders):len(binders)], b.Variable)
+		}
+		result.Body = alphaNormalize(binders, t.Body)
+		return result
+	case DoubleLit:
+		f := float64(t)
+		if math.IsNaN(f) {
+			f = math.NaN()
+		}
+		return doubleBits(math.Float64bits(f))
+	case TextLitTerm:
+		if len(t.Chunks) == 0 {
+			return TextLitTerm{Suffix: t.Suffix}
+		}
+	}
+	result, _ := RewriteChildren(t, func(child Term) (Term, error) {
+		return alphaNormalize(binders, child), nil
+	})
+	return result
+}
+
+// doubleBits is the bit pattern of a DoubleLit, in the Terms returned
+// by alphaNormalize
+type doubleBits uint64
+
+func (doubleBits) isTerm() {}
+
+// A PathStep is one step of a Path: either a record field or union
+// alternative Name, or an Index into a List.
+type PathStep struct {
+	Name  string
+	Index int
+}
+
+func (s PathStep) String() string {
+	if s.Name == "" {
+		return fmt.Sprintf("[%d]", s.Index)
+	}
+	return "." + s.Name
+}
+
+// A Path identifies a subexpression of a normal form, by the record
+// fields, union alternatives and List indices passed through to reach
+// it.  The empty Path identifies the whole expression.
+type Path []PathStep
+
+func (p Path) String() string {
+	var str strings.Builder
+	for _, step := range p {
+		str.WriteString(step.String())
+	}
+	return str.String()
+}
+
+func (p Path) extend(step PathStep) Path {
+	return append(p[:len(p):len(p)], step)
+}
+
+// A Difference describes where two normal forms differ.  Left and
+// Right are the normal forms of the differing subexpressions; one of
+// them is nil if the other is a record field, union alternative or
+// List element which it lacks, or a union alternative without a
+// payload.  Both are nil if only one side has a union alternative,
+// and it has no payload.
+type Difference struct {
+	Path        Path
+	Left, Right Term
+}
+
+func (d *Difference) String() string {
+	path := d.Path.String()
+	if path == "" {
+		path = "top level"
+	}
+	switch {
+	case d.Left == nil && d.Right == nil:
+		return fmt.Sprintf("%s: only on one side", path)
+	case d.Left == nil:
+		return fmt.Sprintf("%s: only on the right: %v", path, d.Right)
+	case d.Right == nil:
+		return fmt.Sprintf("%s: only on the left: %v", path, d.Left)
+	}
+	return fmt.Sprintf("%s: %v is not %v", path, d.Left, d.Right)
+}
+
+// FirstDifference is like Equivalent, but rather than a bool it
+// returns the first place where a and b differ, or nil if they are
+// equivalent.  It descends through records, unions, Lists and
+// Optionals to find the smallest differing subexpressions; fields and
+// alternatives are considered in order of their names, and List
+// elements in order.
+func FirstDifference(a, b Term) (*Difference, error) {
+	var first *Difference
+	err := walkDifferences(a, b, func(d *Difference) bool {
+		first = d
+		return false
+	})
+	return first, err
+}
+
+// Differences is like FirstDifference, but returns every place where
+// a and b differ, in the order FirstDifference considers them.
+func Differences(a, b Term) ([]*Difference, error) {
+	var ds []*Difference
+	err := walkDifferences(a, b, func(d *Difference) bool {
+		ds = append(ds, d)
+		return true
+	})
+	return ds, err
+}
+
+// walkDifferences typechecks a and b, and calls visit with each
+// difference between their normal forms until visit returns false
+func walkDifferences(a, b Term, visit func(*Difference) bool) error {
+	if _, err := TypeOf(a); err != nil {
+		return err
+	}
+	if _, err := TypeOf(b); err != nil {
+		return err
+	}
+	differences(nil, Quote(Eval(a)), Quote(Eval(b)), visit)
+	return nil
+}
+
+// differences calls visit with each difference between the normal
+// forms t1 and t2, which are at path, until visit returns false; it
+// returns false if visit did.  It only compares the subexpressions it
+// doesn't descend into, so each part of t1 and t2 is compared once.
+func differences(path Path, t1, t2 Term, visit func(*Difference) bool) bool {
+	switch t1 := t1.(type) {
+	case RecordLit:
+		if t2, ok := t2.(RecordLit); ok {
+			return fieldDifferences(path, t1, t2, visit)
+		}
+	case RecordType:
+		if t2, ok := t2.(RecordType); ok {
+			return fieldDifferences(path, t1, t2, visit)
+		}
+	case UnionType:
+		if t2, ok := t2.(UnionType); ok {
+			return fieldDifferences(path, t1, t2, visit)
+		}
+	case Some:
+		if t2, ok := t2.(Some); ok {
+			return differences(path, t1.Val, t2.Val, visit)
+		}
+	case AppTerm:
+		// a union alternative applied to its payload
+		t2, ok := t2.(AppTerm)
+		if !ok {
+			break
+		}
+		field, ok := t1.Fn.(Field)
+		if !ok {
+			break
+		}
+		if _, ok := field.Record.(UnionType); ok && AlphaEquivalent(t1.Fn, t2.Fn) {
+			return differences(path.extend(PathStep{Name: field.FieldName}), t1.Arg, t2.Arg, visit)
+		}
+	}
+	if l1, l2, ok := listElements(t1, t2); ok {
+		for i := 0; i < len(l1) || i < len(l2); i++ {
+			elemPath := path.extend(PathStep{Index: i})
+			var cont bool
+			switch {
+			case i >= len(l1):
+				cont = visit(&Difference{Path: elemPath, Right: l2[i]})
+			case i >= len(l2):
+				cont = visit(&Difference{Path: elemPath, Left: l1[i]})
+			default:
+				cont = differences(elemPath, l1[i], l2[i], visit)
+			}
+			if !cont {
+				return false
+			}
+		}
+		return true
+	}
+	if AlphaEquivalent(t1, t2) {
+		return true
+	}
+	return visit(&Difference{Path: path, Left: t1, Right: t2})
+}
+
+// listElements returns the elements of t1 and t2 if they are both
+// List literals and at least one of them isn't empty
+func listElements(t1, t2 Term) ([]Term, []Term, bool) {
+	elements := func(t Term) ([]Term, bool) {
+		switch t := t.(type) {
+		case EmptyList:
+			return nil, true
+		case NonEmptyList:
+			return t, true
+		}
+		return nil, false
+	}
+	l1, ok1 := elements(t1)
+	l2, ok2 := elements(t2)
+	return l1, l2, ok1 && ok2 && len(l1)+len(l2) > 0
+}
+
+// fieldDifferences is like differences, for the fields of two
+// RecordLits, RecordTypes or UnionTypes
+func fieldDifferences(path Path, m1, m2 map[string]Term, visit func(*Difference) bool) bool {
+	var names []string
+	for name := range m1 {
+		names = append(names, name)
+	}
+	for name := range m2 {
+		if _, ok := m1[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fieldPath := path.extend(PathStep{Name: name})
+		v1, ok1 := m1[name]
+		v2, ok2 := m2[name]
+		var cont bool
+		switch {
+		case ok1 && ok2 && v1 == nil && v2 == nil:
+			// the same alternative without a payload
+			continue
+		case !ok1 || !ok2 || v1 == nil || v2 == nil:
+			cont = visit(&Difference{Path: fieldPath, Left: v1, Right: v2})
+		default:
+			cont = differences(fieldPath, v1, v2, visit)
+		}
+		if !cont {
+			return false
+		}
+	}
+	return true
+}
 
 func judgmentallyEqual(t1 Term, t2 Term) bool {
-	v1 := Eval(t1)
-	v2 := Eval(t2)
+	v1 := eval(t1)
+	v2 := eval(t2)
 	return judgmentallyEqualVals(v1, v2)
 }
 
@@ -13,7 +320,8 @@ func judgmentallyEqualVals(v1 Value, v2 Value) bool {
 }
 
 func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
-	switch v1 := v1.(type) {
+	v2 = force(v2)
+	switch v1 := force(v1).(type) {
 	case Universe, Builtin,
 		naturalBuildVal, naturalEvenVal, naturalFoldVal,
 		naturalIsZeroVal, naturalOddVal, naturalShowVal,
@@ -26,8 +334,16 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 		NaturalLit, IntegerLit, BoolLit:
 		return v1 == v2
 	case DoubleLit:
+		// the standard compares Doubles by bit pattern, so NaN is
+		// equal to itself but 0.0 is not equal to -0.0
 		v2, ok := v2.(DoubleLit)
-		return ok && v1 == v2 && math.Signbit(float64(v1)) == math.Signbit(float64(v2))
+		if !ok {
+			return false
+		}
+		if math.IsNaN(float64(v1)) {
+			return math.IsNaN(float64(v2))
+		}
+		return math.Float64bits(float64(v1)) == math.Float64bits(float64(v2))
 	case LambdaValue:
 		v2, ok := v2.(LambdaValue)
 		if !ok {
@@ -59,8 +375,8 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 		}
 		return judgmentallyEqualValsWith(level, v1.Fn, v2.Fn) &&
 			judgmentallyEqualValsWith(level, v1.Arg, v2.Arg)
-	case opValue:
-		v2, ok := v2.(opValue)
+	case OpValue:
+		v2, ok := v2.(OpValue)
 		if !ok {
 			return false
 		}
@@ -106,8 +422,8 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 			}
 		}
 		return true
-	case ifVal:
-		v2, ok := v2.(ifVal)
+	case IfVal:
+		v2, ok := v2.(IfVal)
 		if !ok {
 			return false
 		}
@@ -150,22 +466,22 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 			}
 		}
 		return true
-	case toMapVal:
-		v2, ok := v2.(toMapVal)
+	case ToMapVal:
+		v2, ok := v2.(ToMapVal)
 		if !ok {
 			return false
 		}
 		return judgmentallyEqualValsWith(level, v1.Record, v2.Record) &&
 			judgmentallyEqualValsWith(level, v1.Type, v2.Type)
-	case fieldVal:
-		v2, ok := v2.(fieldVal)
+	case FieldVal:
+		v2, ok := v2.(FieldVal)
 		if !ok {
 			return false
 		}
 		return v1.FieldName == v2.FieldName &&
 			judgmentallyEqualValsWith(level, v1.Record, v2.Record)
-	case projectVal:
-		v2, ok := v2.(projectVal)
+	case ProjectVal:
+		v2, ok := v2.(ProjectVal)
 		if !ok {
 			return false
 		}
@@ -178,8 +494,8 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 			}
 		}
 		return judgmentallyEqualValsWith(level, v1.Record, v2.Record)
-	case unionTypeVal:
-		v2, ok := v2.(unionTypeVal)
+	case UnionTypeVal:
+		v2, ok := v2.(UnionTypeVal)
 		if !ok {
 			return false
 		}
@@ -187,6 +503,9 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 			return false
 		}
 		for k := range v1 {
+			if _, ok := v2[k]; !ok {
+				return false
+			}
 			if v1[k] == nil {
 				if v2[k] != nil {
 					return false
@@ -198,8 +517,8 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 			}
 		}
 		return true
-	case mergeVal:
-		v2, ok := v2.(mergeVal)
+	case MergeVal:
+		v2, ok := v2.(MergeVal)
 		if !ok {
 			return false
 		}
@@ -213,8 +532,8 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 		}
 		return judgmentallyEqualValsWith(level, v1.Handler, v2.Handler) &&
 			judgmentallyEqualValsWith(level, v1.Union, v2.Union)
-	case assertVal:
-		v2, ok := v2.(assertVal)
+	case AssertVal:
+		v2, ok := v2.(AssertVal)
 		if !ok {
 			return false
 		}
@@ -1,6 +1,9 @@
 package core
 
 import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
@@ -30,4 +33,176 @@ var _ = DescribeTable("judgmentallyEqual",
 		NewPi("a", Type, Apply(List, NewVar("a"))),
 		NewPi("b", Type, Apply(List, NewVar("b"))),
 		true),
+	Entry("NaN is equal to itself",
+		DoubleLit(math.NaN()), DoubleLit(math.NaN()),
+		true),
+	Entry("0.0 is not equal to -0.0",
+		DoubleLit(0), DoubleLit(math.Copysign(0, -1)),
+		false),
+	Entry("Union types with different empty alternatives",
+		UnionType{"A": Natural, "B": nil},
+		UnionType{"A": Natural, "C": nil},
+		false),
 )
+
+var _ = Describe("Equivalent", func() {
+	It("Compares normal forms up to alpha-equivalence", func() {
+		Expect(Equivalent(
+			NaturalPlus(NaturalLit(1), NaturalLit(2)),
+			NaturalLit(3),
+		)).To(BeTrue())
+		Expect(Equivalent(
+			NewLambda("a", Natural, NewVar("a")),
+			NewLambda("b", Natural, NewVar("b")),
+		)).To(BeTrue())
+		Expect(Equivalent(NaturalLit(1), NaturalLit(2))).To(BeFalse())
+	})
+	It("Fails if either side doesn't typecheck", func() {
+		_, err := Equivalent(NaturalLit(1), NewVar("x"))
+		Expect(err).To(HaveOccurred())
+		_, err = Equivalent(Apply(NaturalLit(1), NaturalLit(1)), NaturalLit(1))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = DescribeTable("AlphaEquivalent",
+	func(a, b Term, expected bool) {
+		Expect(AlphaEquivalent(a, b)).To(Equal(expected))
+	},
+	Entry("λ(a : Natural) → a and λ(b : Natural) → b",
+		NewLambda("a", Natural, NewVar("a")),
+		NewLambda("b", Natural, NewVar("b")),
+		true),
+	Entry("λ(a : Type) → λ(b : a) → b and λ(x : Type) → λ(x : x) → x",
+		NewLambda("a", Type, NewLambda("b", NewVar("a"), NewVar("b"))),
+		NewLambda("x", Type, NewLambda("x", NewVar("x"), NewVar("x"))),
+		true),
+	Entry("λ(a : Type) → λ(b : Type) → a and λ(x : Type) → λ(x : Type) → x",
+		NewLambda("a", Type, NewLambda("b", Type, NewVar("a"))),
+		NewLambda("x", Type, NewLambda("x", Type, NewVar("x"))),
+		false),
+	Entry("λ(a : Natural) → x and λ(x : Natural) → x@1",
+		NewLambda("a", Natural, NewVar("x")),
+		NewLambda("x", Natural, Var{Name: "x", Index: 1}),
+		true),
+	Entry("λ(a : Natural) → _ and λ(_ : Natural) → _",
+		NewLambda("a", Natural, NewVar("_")),
+		NewLambda("_", Natural, NewVar("_")),
+		false),
+	Entry("let x = 1 in x and let y = 1 in y",
+		NewLet(NewVar("x"), Binding{Variable: "x", Value: NaturalLit(1)}),
+		NewLet(NewVar("y"), Binding{Variable: "y", Value: NaturalLit(1)}),
+		true),
+	Entry("NaN and NaN",
+		DoubleLit(math.NaN()), DoubleLit(math.NaN()),
+		true),
+	Entry("0.0 and -0.0",
+		DoubleLit(0), DoubleLit(math.Copysign(0, -1)),
+		false),
+	Entry("different record fields",
+		RecordLit{"a": NaturalLit(1)},
+		RecordLit{"a": NaturalLit(2)},
+		false),
+	Entry("1 + 1 and 2, which are only equivalent once normalized",
+		NaturalPlus(NaturalLit(1), NaturalLit(1)), NaturalLit(2),
+		false),
+)
+
+var _ = DescribeTable("FirstDifference",
+	func(a, b Term, expected *Difference) {
+		Expect(FirstDifference(a, b)).To(Equal(expected))
+	},
+	Entry("Equivalent terms",
+		RecordLit{"a": NaturalPlus(NaturalLit(1), NaturalLit(1))},
+		RecordLit{"a": NaturalLit(2)},
+		nil),
+	Entry("Different scalars",
+		NaturalLit(1), NaturalLit(2),
+		&Difference{Left: NaturalLit(1), Right: NaturalLit(2)}),
+	Entry("Different record fields",
+		RecordLit{"a": NaturalLit(1), "b": RecordLit{"c": True, "d": True}},
+		RecordLit{"a": NaturalLit(1), "b": RecordLit{"c": True, "d": False}},
+		&Difference{Path: Path{{Name: "b"}, {Name: "d"}}, Left: True, Right: False}),
+	Entry("Added record field",
+		RecordLit{"a": NaturalLit(1)},
+		RecordLit{"a": NaturalLit(1), "b": True},
+		&Difference{Path: Path{{Name: "b"}}, Right: True}),
+	Entry("Removed record field",
+		RecordLit{"a": NaturalLit(1), "b": True},
+		RecordLit{"b": True},
+		&Difference{Path: Path{{Name: "a"}}, Left: NaturalLit(1)}),
+	Entry("Different list elements",
+		NewList(NaturalLit(1), NaturalLit(2)),
+		NewList(NaturalLit(1), NaturalLit(3)),
+		&Difference{Path: Path{{Index: 1}}, Left: NaturalLit(2), Right: NaturalLit(3)}),
+	Entry("Added list element",
+		NewList(NaturalLit(1)),
+		NewList(NaturalLit(1), NaturalLit(2)),
+		&Difference{Path: Path{{Index: 1}}, Right: NaturalLit(2)}),
+	Entry("Emptied list",
+		NewList(NaturalLit(1)),
+		EmptyList{Apply(List, Natural)},
+		&Difference{Path: Path{{Index: 0}}, Left: NaturalLit(1)}),
+	Entry("Empty lists of different types",
+		EmptyList{Apply(List, Natural)},
+		EmptyList{Apply(List, Bool)},
+		&Difference{Left: EmptyList{Apply(List, Natural)}, Right: EmptyList{Apply(List, Bool)}}),
+	Entry("Different union alternative payloads",
+		Apply(Field{UnionType{"A": RecordType{"x": Natural}}, "A"}, RecordLit{"x": NaturalLit(1)}),
+		Apply(Field{UnionType{"A": RecordType{"x": Natural}}, "A"}, RecordLit{"x": NaturalLit(2)}),
+		&Difference{Path: Path{{Name: "A"}, {Name: "x"}}, Left: NaturalLit(1), Right: NaturalLit(2)}),
+	Entry("Different union alternatives",
+		Field{UnionType{"A": nil, "B": nil}, "A"},
+		Field{UnionType{"A": nil, "B": nil}, "B"},
+		&Difference{
+			Left:  Field{UnionType{"A": nil, "B": nil}, "A"},
+			Right: Field{UnionType{"A": nil, "B": nil}, "B"},
+		}),
+	Entry("Different union types",
+		UnionType{"A": Natural, "B": nil},
+		UnionType{"A": Bool, "B": nil},
+		&Difference{Path: Path{{Name: "A"}}, Left: Natural, Right: Bool}),
+	Entry("Union alternative without a payload",
+		UnionType{"A": Natural, "B": nil},
+		UnionType{"A": Natural, "C": nil},
+		&Difference{Path: Path{{Name: "B"}}}),
+	Entry("Union alternative which gained a payload",
+		UnionType{"A": nil},
+		UnionType{"A": Natural},
+		&Difference{Path: Path{{Name: "A"}}, Right: Natural}),
+	Entry("Inside Some",
+		Some{RecordLit{"a": NaturalLit(1)}},
+		Some{RecordLit{"a": NaturalLit(2)}},
+		&Difference{Path: Path{{Name: "a"}}, Left: NaturalLit(1), Right: NaturalLit(2)}),
+)
+
+var _ = Describe("Differences", func() {
+	It("Finds every difference, in order", func() {
+		Expect(Differences(
+			RecordLit{"a": NewList(NaturalLit(1), NaturalLit(2)), "b": True, "c": NaturalLit(3)},
+			RecordLit{"a": NewList(NaturalLit(0), NaturalLit(2), NaturalLit(4)), "b": True, "d": False},
+		)).To(Equal([]*Difference{
+			{Path: Path{{Name: "a"}, {Index: 0}}, Left: NaturalLit(1), Right: NaturalLit(0)},
+			{Path: Path{{Name: "a"}, {Index: 2}}, Right: NaturalLit(4)},
+			{Path: Path{{Name: "c"}}, Left: NaturalLit(3)},
+			{Path: Path{{Name: "d"}}, Right: False},
+		}))
+	})
+	It("Finds nothing between equivalent terms", func() {
+		Expect(Differences(
+			RecordLit{"a": NaturalPlus(NaturalLit(1), NaturalLit(1))},
+			RecordLit{"a": NaturalLit(2)},
+		)).To(BeEmpty())
+	})
+	It("Fails if either side doesn't typecheck", func() {
+		_, err := Differences(NaturalLit(1), NewVar("x"))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Path", func() {
+	It("Formats as field selections and indices", func() {
+		Expect(Path{{Name: "a"}, {Index: 2}, {Name: "b"}}.String()).
+			To(Equal(".a[2].b"))
+	})
+})
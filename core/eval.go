@@ -7,19 +7,28 @@ import (
 	"strings"
 )
 
-type Env map[string][]Value
-
 // Eval normalizes Term to a Value.
 func Eval(t Term) Value {
-	return evalWith(t, Env{}, false)
+	return deepForce(eval(t))
 }
 
 // AlphaBetaEval alpha-beta-normalizes Term to a Value.
 func AlphaBetaEval(t Term) Value {
-	return evalWith(t, Env{}, true)
+	return deepForce(evalWith(t, Env{}, true))
+}
+
+// eval is like Eval, but may leave thunks in the fields of record
+// literals in the Value it returns.
+func eval(t Term) Value {
+	return evalWith(t, Env{}, false)
 }
 
 func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
+	if e.budget != nil {
+		e.budget.charge(1)
+		e.budget.enter()
+		defer e.budget.leave()
+	}
 	switch t := t.(type) {
 	case Universe:
 		return t
@@ -30,7 +39,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		case NaturalEven:
 			return NaturalEvenVal
 		case NaturalFold:
-			return NaturalFoldVal
+			return naturalFoldVal{budget: e.budget}
 		case NaturalIsZero:
 			return NaturalIsZeroVal
 		case NaturalOdd:
@@ -52,42 +61,40 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		case OptionalFold:
 			return OptionalFoldVal
 		case TextShow:
-			return TextShowVal
+			return textShowVal{budget: e.budget}
 		case ListBuild:
-			return ListBuildVal
+			return listBuildVal{budget: e.budget}
 		case ListFold:
-			return ListFoldVal
+			return listFoldVal{budget: e.budget}
 		case ListHead:
 			return ListHeadVal
 		case ListIndexed:
-			return ListIndexedVal
+			return listIndexedVal{budget: e.budget}
 		case ListLength:
 			return ListLengthVal
 		case ListLast:
 			return ListLastVal
 		case ListReverse:
-			return ListReverseVal
+			return listReverseVal{budget: e.budget}
 		default:
 			return t
 		}
 	case Var:
-		if t.Index >= len(e[t.Name]) {
-			return Var{t.Name, t.Index - len(e[t.Name])}
+		if v, ok := e.lookup(t.Name, t.Index); ok {
+			return force(v)
 		}
-		return e[t.Name][t.Index]
+		return Var{t.Name, t.Index - e.count(t.Name)}
 	case localVar:
+		if v, ok := e.local(t); ok {
+			return force(v)
+		}
 		return t
 	case LambdaTerm:
 		v := LambdaValue{
 			Label:  t.Label,
 			Domain: evalWith(t.Type, e, shouldAlphaNormalize),
 			Fn: func(x Value) Value {
-				newEnv := Env{}
-				for k, v := range e {
-					newEnv[k] = v
-				}
-				newEnv[t.Label] = append([]Value{x}, newEnv[t.Label]...)
-				return evalWith(t.Body, newEnv, shouldAlphaNormalize)
+				return evalWith(t.Body, e.extend(t.Label, x), shouldAlphaNormalize)
 			},
 		}
 		if shouldAlphaNormalize {
@@ -99,12 +106,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			Label:  t.Label,
 			Domain: evalWith(t.Type, e, shouldAlphaNormalize),
 			Range: func(x Value) Value {
-				newEnv := Env{}
-				for k, v := range e {
-					newEnv[k] = v
-				}
-				newEnv[t.Label] = append([]Value{x}, newEnv[t.Label]...)
-				return evalWith(t.Body, newEnv, shouldAlphaNormalize)
+				return evalWith(t.Body, e.extend(t.Label, x), shouldAlphaNormalize)
 			}}
 		if shouldAlphaNormalize {
 			v.Label = "_"
@@ -112,17 +114,13 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		return v
 	case AppTerm:
 		fn := evalWith(t.Fn, e, shouldAlphaNormalize)
-		arg := evalWith(t.Arg, e, shouldAlphaNormalize)
+		arg := delay(t.Arg, e, shouldAlphaNormalize)
 		return applyVal(fn, arg)
 	case Let:
-		newEnv := Env{}
-		for k, v := range e {
-			newEnv[k] = v
-		}
-
+		newEnv := e
 		for _, b := range t.Bindings {
-			val := evalWith(b.Value, newEnv, shouldAlphaNormalize)
-			newEnv[b.Variable] = append([]Value{val}, newEnv[b.Variable]...)
+			val := delay(b.Value, newEnv, shouldAlphaNormalize)
+			newEnv = newEnv.extend(b.Variable, val)
 		}
 		return evalWith(t.Body, newEnv, shouldAlphaNormalize)
 	case Annot:
@@ -154,6 +152,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		}
 		str.WriteString(t.Suffix)
 		newSuffix := str.String()
+		e.budget.checkSize(len(newSuffix))
 
 		// Special case: "${<expr>}" → <expr>
 		if len(newChunks) == 1 && newChunks[0].Prefix == "" && newSuffix == "" {
@@ -179,7 +178,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		if judgmentallyEqualVals(tVal, fVal) {
 			return tVal
 		}
-		return ifVal{
+		return IfVal{
 			Cond: condVal,
 			T:    evalWith(t.T, e, shouldAlphaNormalize),
 			F:    evalWith(t.F, e, shouldAlphaNormalize),
@@ -277,6 +276,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			ll, lok := l.(NonEmptyListVal)
 			rl, rok := r.(NonEmptyListVal)
 			if lok && rok {
+				e.budget.checkSize(len(ll) + len(rl))
 				return append(ll, rl...)
 			}
 		case PlusOp:
@@ -366,7 +366,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		case EquivOp:
 			// nothing special
 		}
-		return opValue{OpCode: t.OpCode, L: l, R: r}
+		return OpValue{OpCode: t.OpCode, L: l, R: r}
 	case EmptyList:
 		return EmptyListVal{Type: evalWith(t.Type, e, shouldAlphaNormalize)}
 	case NonEmptyList:
@@ -386,7 +386,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 	case RecordLit:
 		newRT := RecordLitVal{}
 		for k, v := range t {
-			newRT[k] = evalWith(v, e, shouldAlphaNormalize)
+			newRT[k] = delay(v, e, shouldAlphaNormalize)
 		}
 		return newRT
 	case ToMap:
@@ -407,23 +407,23 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			}
 			return result
 		}
-		return toMapVal{
+		return ToMapVal{
 			Record: record,
 			Type:   evalWith(t.Type, e, shouldAlphaNormalize),
 		}
 	case Field:
 		record := evalWith(t.Record, e, shouldAlphaNormalize)
 		for { // simplifications
-			if proj, ok := record.(projectVal); ok {
+			if proj, ok := record.(ProjectVal); ok {
 				record = proj.Record
 				continue
 			}
-			op, ok := record.(opValue)
+			op, ok := record.(OpValue)
 			if ok && op.OpCode == RecordMergeOp {
 				if l, ok := op.L.(RecordLitVal); ok {
 					if lField, ok := l[t.FieldName]; ok {
-						return fieldVal{
-							Record: opValue{
+						return FieldVal{
+							Record: OpValue{
 								L:      RecordLitVal{t.FieldName: lField},
 								R:      op.R,
 								OpCode: RecordMergeOp,
@@ -436,8 +436,8 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 				}
 				if r, ok := op.R.(RecordLitVal); ok {
 					if rField, ok := r[t.FieldName]; ok {
-						return fieldVal{
-							Record: opValue{
+						return FieldVal{
+							Record: OpValue{
 								L:      op.L,
 								R:      RecordLitVal{t.FieldName: rField},
 								OpCode: RecordMergeOp,
@@ -452,8 +452,8 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			if ok && op.OpCode == RightBiasedRecordMergeOp {
 				if l, ok := op.L.(RecordLitVal); ok {
 					if lField, ok := l[t.FieldName]; ok {
-						return fieldVal{
-							Record: opValue{
+						return FieldVal{
+							Record: OpValue{
 								L:      RecordLitVal{t.FieldName: lField},
 								R:      op.R,
 								OpCode: RightBiasedRecordMergeOp,
@@ -466,7 +466,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 				}
 				if r, ok := op.R.(RecordLitVal); ok {
 					if rField, ok := r[t.FieldName]; ok {
-						return rField
+						return force(rField)
 					}
 					record = op.L
 					continue
@@ -475,9 +475,9 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			break
 		}
 		if lit, ok := record.(RecordLitVal); ok {
-			return lit[t.FieldName]
+			return force(lit[t.FieldName])
 		}
-		return fieldVal{
+		return FieldVal{
 			Record:    record,
 			FieldName: t.FieldName,
 		}
@@ -487,11 +487,11 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		sort.Strings(fieldNames)
 		// simplifications
 		for {
-			if proj, ok := record.(projectVal); ok {
+			if proj, ok := record.(ProjectVal); ok {
 				record = proj.Record
 				continue
 			}
-			op, ok := record.(opValue)
+			op, ok := record.(OpValue)
 			if ok && op.OpCode == RightBiasedRecordMergeOp {
 				if r, ok := op.R.(RecordLitVal); ok {
 					notOverridden := []string{}
@@ -506,9 +506,9 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 					if len(notOverridden) == 0 {
 						return overrides
 					}
-					return opValue{
+					return OpValue{
 						OpCode: RightBiasedRecordMergeOp,
-						L: projectVal{
+						L: ProjectVal{
 							Record:     op.L,
 							FieldNames: notOverridden,
 						},
@@ -529,7 +529,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		if len(fieldNames) == 0 {
 			return RecordLitVal{}
 		}
-		return projectVal{
+		return ProjectVal{
 			Record:     record,
 			FieldNames: fieldNames,
 		}
@@ -548,7 +548,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			},
 			e, shouldAlphaNormalize)
 	case UnionType:
-		result := make(unionTypeVal, len(t))
+		result := make(UnionTypeVal, len(t))
 		for k, v := range t {
 			if v == nil {
 				result[k] = nil
@@ -563,19 +563,26 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		if handlers, ok := handlerVal.(RecordLitVal); ok {
 			// TODO: test tricky Field inputs
 			if union, ok := unionVal.(AppValue); ok {
-				if field, ok := union.Fn.(fieldVal); ok {
+				if field, ok := union.Fn.(FieldVal); ok {
 					return applyVal(
-						handlers[field.FieldName],
+						force(handlers[field.FieldName]),
 						union.Arg,
 					)
 				}
 			}
-			if union, ok := unionVal.(fieldVal); ok {
+			if union, ok := unionVal.(FieldVal); ok {
 				// empty union alternative
-				return handlers[union.FieldName]
+				return force(handlers[union.FieldName])
+			}
+			// merge treats Optional T as < None | Some : T >
+			if payload, ok := OptionalLit(unionVal); ok {
+				if payload == nil {
+					return force(handlers["None"])
+				}
+				return applyVal(force(handlers["Some"]), payload)
 			}
 		}
-		output := mergeVal{
+		output := MergeVal{
 			Handler: handlerVal,
 			Union:   unionVal,
 		}
@@ -584,15 +591,26 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		}
 		return output
 	case Assert:
-		return assertVal{Annotation: evalWith(t.Annotation, e, shouldAlphaNormalize)}
+		return AssertVal{Annotation: evalWith(t.Annotation, e, shouldAlphaNormalize)}
+	case DuplicateLabel:
+		// ill-typed, so there's no right answer; ignore the
+		// duplicates
+		return evalWith(t.Term, e, shouldAlphaNormalize)
 	default:
 		panic(fmt.Sprint("unknown term type", t))
 	}
 }
 
+// applyVal applies fn to args.  The args may be thunks, which are
+// passed on unforced to a LambdaValue but forced for anything else.
 func applyVal(fn Value, args ...Value) Value {
 	out := fn
 	for _, arg := range args {
+		if f, ok := out.(LambdaValue); ok {
+			out = f.Fn(arg)
+			continue
+		}
+		arg = force(arg)
 		if f, ok := out.(Callable); ok {
 			if result := f.Call(arg); result != nil {
 				out = result
@@ -635,8 +653,8 @@ func mustMergeRecordLitVals(l RecordLitVal, r RecordLitVal) RecordLitVal {
 	}
 	for k, v := range r {
 		if lField, ok := output[k]; ok {
-			lSubrecord, Lok := lField.(RecordLitVal)
-			rSubrecord, Rok := v.(RecordLitVal)
+			lSubrecord, Lok := force(lField).(RecordLitVal)
+			rSubrecord, Rok := force(v).(RecordLitVal)
 			if !(Lok && Rok) {
 				// typecheck ought to have caught this
 				panic("Record mismatch")
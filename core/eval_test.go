@@ -1,7 +1,11 @@
 package core
 
 import (
+	"fmt"
+	"math"
+
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -10,7 +14,7 @@ var _ = Describe("Eval", func() {
 		Expect(Eval(Type)).To(Equal(Type))
 	})
 	It("Bound variable", func() {
-		Expect(evalWith(Var{Name: "foo"}, Env{"foo": []Value{Type}}, false)).
+		Expect(evalWith(Var{Name: "foo"}, Env{}.extend("foo", Type), false)).
 			To(Equal(Type))
 	})
 	It("Free variable", func() {
@@ -44,4 +48,130 @@ var _ = Describe("Eval", func() {
 				To(Equal(Type))
 		})
 	})
+	Describe("Env", func() {
+		env := Env{}.extend("x", Natural).extend("y", Bool).extend("x", Text)
+		It("Looks up the innermost binding first", func() {
+			Expect(evalWith(Var{Name: "x"}, env, false)).To(Equal(Text))
+			Expect(evalWith(Var{Name: "x", Index: 1}, env, false)).To(Equal(Natural))
+		})
+		It("Shifts free variables past bound ones", func() {
+			Expect(evalWith(Var{Name: "x", Index: 3}, env, false)).
+				To(Equal(Var{Name: "x", Index: 1}))
+		})
+		It("Looks up by level", func() {
+			v, ok := env.lookupLevel("x", 0)
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(Natural))
+			_, ok = env.lookupLevel("x", 2)
+			Expect(ok).To(BeFalse())
+		})
+		It("Looks up every binding of a deeply shadowed name", func() {
+			deep := Env{}
+			for i := 0; i < 100; i++ {
+				deep = deep.extend("x", NaturalLit(i)).extend(fmt.Sprintf("y%d", i), Bool)
+			}
+			Expect(deep.count("x")).To(Equal(100))
+			for i := 0; i < 100; i++ {
+				v, ok := deep.lookupLevel("x", i)
+				Expect(ok).To(BeTrue())
+				Expect(v).To(Equal(NaturalLit(i)))
+				v, ok = deep.lookup("x", i)
+				Expect(ok).To(BeTrue())
+				Expect(v).To(Equal(NaturalLit(99 - i)))
+				v, ok = deep.lookup(fmt.Sprintf("y%d", i), 0)
+				Expect(ok).To(BeTrue())
+				Expect(v).To(Equal(Bool))
+			}
+			_, ok := deep.lookup("x", 100)
+			Expect(ok).To(BeFalse())
+			_, ok = deep.lookup("x", -1)
+			Expect(ok).To(BeFalse())
+		})
+		It("Doesn't modify the Env it extends", func() {
+			env.extend("x", Double)
+			Expect(evalWith(Var{Name: "x"}, env, false)).To(Equal(Text))
+		})
+	})
+	Describe("laziness", func() {
+		It("Doesn't evaluate unused let bindings", func() {
+			Expect(Eval(NewLet(NaturalLit(1), Binding{Variable: "x", Value: explodingTerm{}}))).
+				To(Equal(NaturalLit(1)))
+		})
+		It("Doesn't evaluate unselected record fields", func() {
+			Expect(Eval(Field{
+				Record:    RecordLit{"a": NaturalLit(1), "b": explodingTerm{}},
+				FieldName: "a",
+			})).To(Equal(NaturalLit(1)))
+		})
+		It("Doesn't evaluate unused function arguments", func() {
+			Expect(Eval(Apply(NewLambda("x", Natural, NaturalLit(1)), explodingTerm{}))).
+				To(Equal(NaturalLit(1)))
+		})
+		It("Forces the arguments of Natural/build's succ", func() {
+			// Natural/build (λ(natural : Type) → λ(succ : natural → natural) → λ(zero : natural) → succ (succ zero))
+			Expect(Eval(Apply(NaturalBuild, NewLambda("natural", Type,
+				NewLambda("succ", NewAnonPi(NewVar("natural"), NewVar("natural")),
+					NewLambda("zero", NewVar("natural"),
+						Apply(NewVar("succ"), Apply(NewVar("succ"), NewVar("zero"))))))))).
+				To(Equal(NaturalLit(2)))
+		})
+		It("Forces the arguments of List/build's cons", func() {
+			// List/build Natural (λ(list : Type) → λ(cons : Natural → list → list) → λ(nil : list) → cons 1 (cons 2 nil))
+			Expect(Eval(Apply(ListBuild, Natural, NewLambda("list", Type,
+				NewLambda("cons", NewAnonPi(Natural, NewAnonPi(NewVar("list"), NewVar("list"))),
+					NewLambda("nil", NewVar("list"),
+						Apply(NewVar("cons"), NaturalLit(1), Apply(NewVar("cons"), NaturalLit(2), NewVar("nil"))))))))).
+				To(Equal(NonEmptyListVal{NaturalLit(1), NaturalLit(2)}))
+		})
+		It("Forces everything in the result of Eval", func() {
+			Expect(Eval(NewLet(
+				RecordLit{"a": NewVar("x")},
+				Binding{Variable: "x", Value: NaturalPlus(NaturalLit(1), NaturalLit(2))},
+			))).To(Equal(RecordLitVal{"a": NaturalLit(3)}))
+		})
+	})
+	Describe("merge over Optional", func() {
+		handlers := RecordLit{
+			"Some": NewLambda("x", Natural, NaturalPlus(NewVar("x"), NaturalLit(1))),
+			"None": NaturalLit(0),
+		}
+		It("Applies the Some handler to the payload", func() {
+			Expect(Eval(Merge{Handler: handlers, Union: Some{NaturalLit(2)}})).
+				To(Equal(NaturalLit(3)))
+		})
+		It("Returns the None handler", func() {
+			Expect(Eval(Merge{Handler: handlers, Union: Apply(None, Natural)})).
+				To(Equal(NaturalLit(0)))
+		})
+		It("Is stuck on an abstract Optional", func() {
+			Expect(Quote(Eval(Merge{Handler: handlers, Union: NewVar("o")}))).
+				To(Equal(Merge{Handler: handlers, Union: NewVar("o")}))
+		})
+	})
 })
+
+// explodingTerm is a Term which panics if it is ever evaluated
+type explodingTerm struct{}
+
+func (explodingTerm) isTerm() {}
+
+var _ = DescribeTable("Double/show",
+	func(d float64, expected string) {
+		Expect(Eval(Apply(DoubleShow, DoubleLit(d)))).
+			To(Equal(TextLitVal{Suffix: expected}))
+	},
+	Entry("zero", 0.0, "0.0"),
+	Entry("negative zero", math.Copysign(0, -1), "-0.0"),
+	Entry("whole number", 3.0, "3.0"),
+	Entry("fraction", -3.1, "-3.1"),
+	Entry("small fraction", 0.4, "0.4"),
+	Entry("lower limit of positional notation", 0.1, "0.1"),
+	Entry("below positional notation", 0.01, "1.0e-2"),
+	Entry("upper limit of positional notation", 9999999.5, "9999999.5"),
+	Entry("above positional notation", 1e7, "1.0e7"),
+	Entry("scientific notation with fraction", 1.2345678e20, "1.2345678e20"),
+	Entry("shortest round-trip representation", 1.0/3, "0.3333333333333333"),
+	Entry("NaN", math.NaN(), "NaN"),
+	Entry("Infinity", math.Inf(1), "Infinity"),
+	Entry("-Infinity", math.Inf(-1), "-Infinity"),
+)
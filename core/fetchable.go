@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -9,11 +10,18 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 )
 
 type EnvVar string
 type Local string
-type Remote struct{ url *url.URL }
+type Remote struct {
+	scheme    string
+	authority string
+	path      string
+	query     string
+	hasQuery  bool
+}
 type Missing struct{}
 
 const NullOrigin = "null"
@@ -37,10 +45,19 @@ type Fetchable interface {
 	AsLocation() Term
 }
 
-var _ Fetchable = EnvVar("")
-var _ Fetchable = Local("")
-var _ Fetchable = Remote{}
-var _ Fetchable = Missing{}
+// A ContextFetchable is a Fetchable which can give up fetching if a
+// context is cancelled or its deadline passes before the fetch
+// completes.  All the Fetchables in this package are
+// ContextFetchables.
+type ContextFetchable interface {
+	Fetchable
+	FetchContext(ctx context.Context, origin string) (string, error)
+}
+
+var _ ContextFetchable = EnvVar("")
+var _ ContextFetchable = Local("")
+var _ ContextFetchable = Remote{}
+var _ ContextFetchable = Missing{}
 
 func (e EnvVar) Name() string { return string(e) }
 func (EnvVar) Origin() string { return NullOrigin }
@@ -48,6 +65,12 @@ func (e EnvVar) String() string {
 	return "env:" + string(e)
 }
 func (e EnvVar) Fetch(origin string) (string, error) {
+	return e.FetchContext(context.Background(), origin)
+}
+func (e EnvVar) FetchContext(ctx context.Context, origin string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	if origin != NullOrigin {
 		return "", errors.New("Can't access environment variable from remote import")
 	}
@@ -64,29 +87,126 @@ func (e EnvVar) AsLocation() Term {
 	return Apply(Field{LocationType, "Environment"}, TextLitTerm{Suffix: e.String()})
 }
 
+// NewLocal returns the Local path with the given path components.
+// The prefix is one of "/", ".", ".." or "~", for absolute,
+// here-relative, parent-relative and home-relative paths
+// respectively.  Unlike path.Join, NewLocal does not clean the path,
+// so "." and ".." components are preserved.
+func NewLocal(prefix string, components []string) Local {
+	file := strings.Join(components, "/")
+	switch prefix {
+	case "/":
+		return Local("/" + file)
+	case "..":
+		return Local("../" + file)
+	case "~":
+		return Local("~/" + file)
+	}
+	// a here-relative path is normally stored without its leading
+	// "./", except where that would make it look like a parent- or
+	// home-relative path
+	if len(components) > 0 {
+		first := components[0]
+		if first == "." || first == ".." || strings.HasPrefix(first, "~") {
+			return Local("./" + file)
+		}
+	}
+	return Local(file)
+}
+
 func (l Local) Name() string { return string(l) }
 func (Local) Origin() string { return NullOrigin }
 func (l Local) String() string {
-	if l.IsAbs() || l.IsRelativeToHome() || l.IsRelativeToParent() {
-		return string(l)
-	} else {
-		return "./" + string(l)
+	var s strings.Builder
+	switch {
+	case l.IsAbs():
+	case l.IsRelativeToHome():
+		s.WriteString("~")
+	case l.IsRelativeToParent():
+		s.WriteString("..")
+	default:
+		s.WriteString(".")
+	}
+	for _, component := range l.PathComponents() {
+		s.WriteString("/")
+		s.WriteString(quotePathComponent(component))
 	}
+	return s.String()
+}
+
+// quotePathComponent quotes a path component if it contains any
+// characters which aren't allowed in an unquoted path component.
+func quotePathComponent(component string) string {
+	for _, r := range component {
+		switch {
+		case r == '!', r >= '$' && r <= '\'', r == '*', r == '+',
+			r == '-', r == '.', r >= '0' && r <= ';', r == '=',
+			r >= '@' && r <= 'Z', r >= '^' && r <= 'z', r == '|', r == '~':
+		default:
+			return `"` + component + `"`
+		}
+	}
+	return component
 }
 func (l Local) Fetch(origin string) (string, error) {
+	return l.FetchContext(context.Background(), origin)
+}
+func (l Local) FetchContext(ctx context.Context, origin string) (string, error) {
 	if origin != NullOrigin {
 		return "", fmt.Errorf("Can't get %s from remote import at %s", l, origin)
 	}
-	bytes, err := ioutil.ReadFile(string(l))
+	if l.IsRelativeToHome() {
+		// which directory is home is up to the caller, such as
+		// imports.Loader with its HomeDir
+		return "", fmt.Errorf("Can't fetch %s: home-relative paths must be expanded first", l)
+	}
+	bytes, err := readFileContext(ctx, string(l))
 	return string(bytes), err
 }
+
+// readFileContext reads a file like ioutil.ReadFile, but returns
+// early if ctx is done before the read completes.  (The read itself
+// can't be interrupted, for example if the file is a named pipe with
+// no writer, so it carries on in the background.)
+func readFileContext(ctx context.Context, filename string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	type result struct {
+		bytes []byte
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		bytes, err := ioutil.ReadFile(filename)
+		done <- result{bytes, err}
+	}()
+	select {
+	case r := <-done:
+		return r.bytes, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
 func (l Local) ChainOnto(base Fetchable) (Fetchable, error) {
 	switch r := base.(type) {
 	case Local:
 		if l.IsAbs() || l.IsRelativeToHome() {
 			return l, nil
 		}
-		return Local(path.Join(path.Dir(string(r)), string(l))), nil
+		if r.IsRelativeToHome() {
+			// path.Join would treat "~" as an ordinary directory
+			// and cancel it out against a leading "..", so we
+			// join relative to the home directory instead
+			dir := path.Dir(strings.TrimPrefix(string(r), "~/"))
+			return Local("~/" + path.Join(dir, string(l))), nil
+		}
+		joined := path.Join(path.Dir(string(r)), string(l))
+		if strings.HasPrefix(joined, "~") {
+			// don't mistake a file named "~..." for the home directory
+			joined = "./" + joined
+		}
+		return Local(joined), nil
 	case Remote:
 		if l.IsAbs() {
 			return nil, errors.New("Can't get absolute path from remote import")
@@ -94,19 +214,36 @@ func (l Local) ChainOnto(base Fetchable) (Fetchable, error) {
 		if l.IsRelativeToHome() {
 			return nil, errors.New("Can't get home-relative path from remote import")
 		}
-		newURL := r.url.ResolveReference(l.asRelativeRef())
-		return Remote{url: newURL}, nil
+		dir := r.path[:strings.LastIndex(r.path, "/")+1]
+		if dir == "" {
+			dir = "/"
+		}
+		return Remote{
+			scheme:    r.scheme,
+			authority: r.authority,
+			path:      path.Join(dir, l.asRelativeRef()),
+		}, nil
 	default:
 		return l, nil
 	}
 }
 
 func (l Local) IsAbs() bool              { return path.IsAbs(string(l)) }
-func (l Local) IsRelativeToParent() bool { return strings.HasPrefix(string(l), "..") }
-func (l Local) IsRelativeToHome() bool   { return string(l)[0] == '~' }
+func (l Local) IsRelativeToParent() bool { return strings.HasPrefix(string(l), "../") }
+func (l Local) IsRelativeToHome() bool   { return strings.HasPrefix(string(l), "~") }
+
+// ExpandHome returns the path l with a leading "~" replaced by home.
+// If l is not relative to home, it is returned unchanged.
+func (l Local) ExpandHome(home string) Local {
+	if !l.IsRelativeToHome() {
+		return l
+	}
+	return Local(path.Join(home, strings.TrimPrefix(string(l), "~")))
+}
 
-//asRelativeRef converts a local path to a relative reference
-func (l Local) asRelativeRef() *url.URL {
+//asRelativeRef converts a local path to a percent-encoded relative
+//reference
+func (l Local) asRelativeRef() string {
 	if l.IsAbs() || l.IsRelativeToHome() {
 		panic("Can't convert absolute or home-relative path to relative reference")
 	}
@@ -120,15 +257,11 @@ func (l Local) asRelativeRef() *url.URL {
 		s.WriteString("/")
 		s.WriteString(url.PathEscape(segment))
 	}
-	u, err := url.Parse(s.String())
-	if err != nil {
-		panic(err)
-	}
-	return u
+	return s.String()
 }
 
 func (l Local) PathComponents() []string {
-	if l.IsAbs() || l.IsRelativeToHome() || l.IsRelativeToParent() {
+	if l.IsAbs() || l.IsRelativeToHome() || l.IsRelativeToParent() || strings.HasPrefix(string(l), "./") {
 		return strings.Split(string(l), "/")[1:]
 	} else {
 		return strings.Split(string(l), "/")
@@ -137,17 +270,99 @@ func (l Local) PathComponents() []string {
 func (l Local) AsLocation() Term {
 	return Apply(Field{LocationType, "Local"}, TextLitTerm{Suffix: l.String()})
 }
+
+// NewRemote returns the Remote of the URL u.  Unlike ParseRemote, it
+// can only keep the URL as net/url has parsed it.
 func NewRemote(u *url.URL) Remote {
-	return Remote{url: u}
+	r := Remote{scheme: u.Scheme, authority: u.Host, path: u.EscapedPath()}
+	if u.User != nil {
+		r.authority = u.User.String() + "@" + u.Host
+	}
+	if u.RawQuery != "" || u.ForceQuery {
+		r.query, r.hasQuery = u.RawQuery, true
+	}
+	return r
 }
 
-var client http.Client
+// NewRemoteParts returns the Remote with the given parts.  The
+// authority and path components should be percent-encoded as they
+// would appear in a URL, and query should be nil if the URL has no
+// query.  It returns an error if the authority is malformed.
+func NewRemoteParts(scheme, authority string, pathComponents []string, query *string) (Remote, error) {
+	if err := checkAuthority(authority); err != nil {
+		return Remote{}, err
+	}
+	r := Remote{
+		scheme:    scheme,
+		authority: authority,
+		path:      "/" + strings.Join(pathComponents, "/"),
+	}
+	if query != nil {
+		r.query, r.hasQuery = *query, true
+	}
+	return r, nil
+}
 
-func (r Remote) Name() string   { return r.url.String() }
-func (r Remote) Origin() string { return fmt.Sprintf("%s://%s", r.url.Scheme, r.Authority()) }
-func (r Remote) String() string { return fmt.Sprintf("%v", r.url) }
+// ParseRemote parses an http or https URL.  Unlike net/url, it keeps
+// each part of the URL exactly as written: it doesn't decode or
+// re-encode anything, and it splits the authority according to the
+// Dhall grammar rather than RFC 3986's looser rules.
+func ParseRemote(text string) (Remote, error) {
+	var r Remote
+	i := strings.Index(text, "://")
+	if i < 0 {
+		return Remote{}, fmt.Errorf("Malformed URL %s: missing scheme", text)
+	}
+	if text[:i] != "http" && text[:i] != "https" {
+		return Remote{}, fmt.Errorf("Malformed URL %s: unsupported scheme %s", text, text[:i])
+	}
+	r.scheme, text = text[:i], text[i+3:]
+	if i := strings.IndexByte(text, '?'); i >= 0 {
+		text, r.query, r.hasQuery = text[:i], text[i+1:], true
+	}
+	if i := strings.IndexByte(text, '/'); i >= 0 {
+		text, r.path = text[:i], text[i:]
+	}
+	r.authority = text
+	if err := checkAuthority(r.authority); err != nil {
+		return Remote{}, err
+	}
+	return r, nil
+}
+
+// checkAuthority returns an error if authority has an IP literal
+// without its closing bracket.
+func checkAuthority(authority string) error {
+	host := Remote{authority: authority}.hostPort()
+	if strings.HasPrefix(host, "[") && !strings.Contains(host, "]") {
+		return fmt.Errorf("Malformed URL: unterminated IP literal in %s", authority)
+	}
+	return nil
+}
+
+// client gives up on a remote import after 30 seconds, so that a
+// stalled server can't hang an import forever, even if the context it
+// is fetched with has no deadline.
+var client = http.Client{Timeout: 30 * time.Second}
+
+func (r Remote) Name() string   { return r.String() }
+func (r Remote) Origin() string { return fmt.Sprintf("%s://%s", r.scheme, r.authority) }
+func (r Remote) String() string {
+	s := r.Origin() + r.path
+	if r.hasQuery {
+		s += "?" + r.query
+	}
+	return s
+}
 func (r Remote) Fetch(origin string) (string, error) {
-	req, err := http.NewRequest("GET", r.url.String(), nil)
+	return r.FetchContext(context.Background(), origin)
+}
+func (r Remote) FetchContext(ctx context.Context, origin string) (string, error) {
+	u, err := url.Parse(r.String())
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return "", err
 	}
@@ -163,12 +378,12 @@ func (r Remote) Fetch(origin string) (string, error) {
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("Got status %d from URL %s", resp.StatusCode, r.url)
+		return "", fmt.Errorf("Got status %d from URL %s", resp.StatusCode, r)
 	}
 	if corsFlag &&
 		resp.Header.Get("Access-Control-Allow-Origin") != "*" &&
 		resp.Header.Get("Access-Control-Allow-Origin") != origin {
-		return "", fmt.Errorf("URL %s does not permit CORS requests from %s", r.url, origin)
+		return "", fmt.Errorf("URL %s does not permit CORS requests from %s", r, origin)
 	}
 
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
@@ -177,24 +392,69 @@ func (r Remote) Fetch(origin string) (string, error) {
 func (r Remote) ChainOnto(base Fetchable) (Fetchable, error) {
 	return r, nil
 }
-func (r Remote) IsPlainHttp() bool { return r.url.Scheme == "http" }
-func (r Remote) Authority() string {
-	if r.url.User != nil {
-		return fmt.Sprintf("%s@%s", r.url.User.String(), r.url.Host)
+func (r Remote) IsPlainHttp() bool { return r.scheme == "http" }
+func (r Remote) Scheme() string    { return r.scheme }
+
+// Userinfo returns the userinfo of the URL, as written, or "" if none
+// was given.
+func (r Remote) Userinfo() string {
+	if i := strings.IndexByte(r.authority, '@'); i >= 0 {
+		return r.authority[:i]
 	}
-	return r.url.Host
+	return ""
+}
+
+// hostPort returns the authority without any userinfo.
+func (r Remote) hostPort() string {
+	return r.authority[strings.IndexByte(r.authority, '@')+1:]
 }
+
+// Host returns the host of the URL, without any port.  IPv6 literals
+// are returned without their surrounding brackets.
+func (r Remote) Host() string {
+	host := r.hostPort()
+	if strings.HasPrefix(host, "[") {
+		// NewRemoteParts and ParseRemote reject an unterminated literal,
+		// but don't panic if one gets through
+		if end := strings.IndexByte(host, ']'); end >= 0 {
+			return host[1:end]
+		}
+		return host[1:]
+	}
+	if i := strings.IndexByte(host, ':'); i >= 0 {
+		return host[:i]
+	}
+	return host
+}
+
+// Port returns the port of the URL, or "" if none was given.
+func (r Remote) Port() string {
+	host := r.hostPort()
+	if strings.HasPrefix(host, "[") {
+		end := strings.IndexByte(host, ']')
+		if end < 0 {
+			return ""
+		}
+		host = host[end:]
+	}
+	if i := strings.IndexByte(host, ':'); i >= 0 {
+		return host[i+1:]
+	}
+	return ""
+}
+func (r Remote) Authority() string { return r.authority }
 func (r Remote) PathComponents() []string {
-	if r.url.Path == "" || r.url.Path == "/" {
+	if r.path == "" || r.path == "/" {
 		return []string{""}
 	}
-	return strings.Split(r.url.EscapedPath()[1:], "/")
+	return strings.Split(r.path[1:], "/")
 }
 func (r Remote) Query() *string {
-	if r.url.RawQuery == "" && !r.url.ForceQuery {
+	if !r.hasQuery {
 		return nil
 	}
-	return &r.url.RawQuery
+	query := r.query
+	return &query
 }
 func (r Remote) AsLocation() Term {
 	return Apply(Field{LocationType, "Remote"}, TextLitTerm{Suffix: r.String()})
@@ -203,7 +463,10 @@ func (r Remote) AsLocation() Term {
 func (Missing) Name() string   { return "" }
 func (Missing) Origin() string { return NullOrigin }
 func (Missing) String() string { return "missing" }
-func (Missing) Fetch(origin string) (string, error) {
+func (m Missing) Fetch(origin string) (string, error) {
+	return m.FetchContext(context.Background(), origin)
+}
+func (Missing) FetchContext(ctx context.Context, origin string) (string, error) {
 	return "", errors.New("Cannot resolve missing import")
 }
 func (Missing) ChainOnto(base Fetchable) (Fetchable, error) {
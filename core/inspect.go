@@ -0,0 +1,111 @@
+package core
+
+// BuiltinApplication reports whether v is a builtin function, such as
+// Natural/fold, which has been applied to too few arguments to
+// evaluate any further.  If it is, BuiltinApplication returns the
+// Builtin and the arguments applied to it so far, in order.
+//
+// Builtins which have no special evaluation rules, such as Natural
+// or List, are represented directly as Builtin Values and are also
+// reported here, with no arguments.
+func BuiltinApplication(v Value) (Builtin, []Value, bool) {
+	switch v := force(v).(type) {
+	case Builtin:
+		return v, nil, true
+	case naturalBuildVal:
+		return NaturalBuild, nil, true
+	case naturalEvenVal:
+		return NaturalEven, nil, true
+	case naturalFoldVal:
+		return NaturalFold, appliedArgs(v.n, v.typ, v.succ), true
+	case naturalIsZeroVal:
+		return NaturalIsZero, nil, true
+	case naturalOddVal:
+		return NaturalOdd, nil, true
+	case naturalShowVal:
+		return NaturalShow, nil, true
+	case naturalSubtractVal:
+		return NaturalSubtract, appliedArgs(v.a), true
+	case naturalToIntegerVal:
+		return NaturalToInteger, nil, true
+	case integerShowVal:
+		return IntegerShow, nil, true
+	case integerToDoubleVal:
+		return IntegerToDouble, nil, true
+	case doubleShowVal:
+		return DoubleShow, nil, true
+	case optionalBuildVal:
+		return OptionalBuild, appliedArgs(v.typ), true
+	case optionalFoldVal:
+		return OptionalFold, appliedArgs(v.typ1, v.opt, v.typ2, v.some), true
+	case textShowVal:
+		return TextShow, nil, true
+	case listBuildVal:
+		return ListBuild, appliedArgs(v.typ), true
+	case listFoldVal:
+		return ListFold, appliedArgs(v.typ1, v.list, v.typ2, v.cons), true
+	case listHeadVal:
+		return ListHead, appliedArgs(v.typ), true
+	case listIndexedVal:
+		return ListIndexed, appliedArgs(v.typ), true
+	case listLengthVal:
+		return ListLength, appliedArgs(v.typ), true
+	case listLastVal:
+		return ListLast, appliedArgs(v.typ), true
+	case listReverseVal:
+		return ListReverse, appliedArgs(v.typ), true
+	}
+	return "", nil, false
+}
+
+// appliedArgs returns the leading non-nil args; builtin Values
+// store the arguments they have not yet received as nil
+func appliedArgs(args ...Value) []Value {
+	for i, arg := range args {
+		if arg == nil {
+			return args[:i]
+		}
+	}
+	return args
+}
+
+// UnionAlternative reports whether v is an alternative of a union
+// type, such as `< A : Natural | B >.A 1` or `< A : Natural | B >.B`.
+// If it is, UnionAlternative returns the union type, the name of the
+// alternative, and its payload, which is nil for an alternative
+// without one.
+func UnionAlternative(v Value) (union UnionTypeVal, name string, payload Value, ok bool) {
+	v = force(v)
+	if app, isApp := v.(AppValue); isApp {
+		v = app.Fn
+		payload = app.Arg
+	}
+	field, isField := v.(FieldVal)
+	if !isField {
+		return nil, "", nil, false
+	}
+	union, ok = field.Record.(UnionTypeVal)
+	if !ok {
+		return nil, "", nil, false
+	}
+	if union[field.FieldName] == nil && payload != nil {
+		// an alternative without a payload can't be applied
+		return nil, "", nil, false
+	}
+	return union, field.FieldName, payload, true
+}
+
+// OptionalLit reports whether v is an Optional literal: either
+// `Some x` or `None T`.  If it is, OptionalLit returns the payload x,
+// or nil for None.
+func OptionalLit(v Value) (payload Value, ok bool) {
+	switch v := force(v).(type) {
+	case SomeVal:
+		return v.Val, true
+	case AppValue:
+		if v.Fn == None {
+			return nil, true
+		}
+	}
+	return nil, false
+}
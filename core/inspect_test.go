@@ -0,0 +1,69 @@
+package core
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuiltinApplication", func() {
+	It("Finds partially applied builtins", func() {
+		b, args, ok := BuiltinApplication(Eval(Apply(NaturalFold, NaturalLit(3), Natural)))
+		Expect(ok).To(BeTrue())
+		Expect(b).To(Equal(NaturalFold))
+		Expect(args).To(Equal([]Value{NaturalLit(3), Natural}))
+	})
+	It("Finds builtins without arguments", func() {
+		b, args, ok := BuiltinApplication(Eval(ListReverse))
+		Expect(ok).To(BeTrue())
+		Expect(b).To(Equal(ListReverse))
+		Expect(args).To(BeEmpty())
+
+		b, _, ok = BuiltinApplication(Eval(Natural))
+		Expect(ok).To(BeTrue())
+		Expect(b).To(Equal(Natural))
+	})
+	It("Rejects other Values", func() {
+		_, _, ok := BuiltinApplication(NaturalLit(3))
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("UnionAlternative", func() {
+	union := UnionType{"A": Natural, "B": nil}
+	unionVal := UnionTypeVal{"A": Natural, "B": nil}
+	It("Finds alternatives with payloads", func() {
+		u, name, payload, ok := UnionAlternative(Eval(Apply(Field{union, "A"}, NaturalLit(1))))
+		Expect(ok).To(BeTrue())
+		Expect(u).To(Equal(unionVal))
+		Expect(name).To(Equal("A"))
+		Expect(payload).To(Equal(NaturalLit(1)))
+	})
+	It("Finds alternatives without payloads", func() {
+		u, name, payload, ok := UnionAlternative(Eval(Field{union, "B"}))
+		Expect(ok).To(BeTrue())
+		Expect(u).To(Equal(unionVal))
+		Expect(name).To(Equal("B"))
+		Expect(payload).To(BeNil())
+	})
+	It("Rejects stuck field selections", func() {
+		_, _, _, ok := UnionAlternative(Eval(Field{NewVar("r"), "A"}))
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("OptionalLit", func() {
+	It("Finds Some", func() {
+		payload, ok := OptionalLit(Eval(Some{NaturalLit(1)}))
+		Expect(ok).To(BeTrue())
+		Expect(payload).To(Equal(NaturalLit(1)))
+	})
+	It("Finds None", func() {
+		payload, ok := OptionalLit(Eval(Apply(None, Natural)))
+		Expect(ok).To(BeTrue())
+		Expect(payload).To(BeNil())
+	})
+	It("Rejects other Values", func() {
+		_, ok := OptionalLit(NaturalLit(1))
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,139 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Limits bounds the resources that evaluation may use.  A zero field
+// means that resource is unlimited.
+type Limits struct {
+	// Steps limits the number of evaluation steps.  Evaluating
+	// any subexpression is one step, as is each iteration of
+	// Natural/fold or List/fold.
+	Steps int
+	// Depth limits how deeply evaluation and typechecking may
+	// recurse.
+	Depth int
+	// Size limits the length of any List, and the number of bytes
+	// in any Text, built during evaluation.
+	Size int
+}
+
+// ErrResourceLimit is returned, wrapped with details, by
+// EvalWithLimits and TypeOfWithLimits when evaluation exceeds its
+// Limits.  Use errors.Is to check for it.
+var ErrResourceLimit = errors.New("resource limit exceeded")
+
+// EvalWithLimits normalizes Term to a Value, like Eval, but gives up
+// with an ErrResourceLimit error if evaluation exceeds the given
+// limits.
+//
+// The limits only apply during the call: functions in the returned
+// Value are not limited when they are called later.
+func EvalWithLimits(t Term, limits Limits) (v Value, err error) {
+	b := &budget{Limits: limits}
+	defer b.finish(&err)
+	return deepForce(evalWith(t, Env{budget: b}, false)), nil
+}
+
+// TypeOfWithLimits infers the type of Term, like TypeOf, but gives
+// up with an ErrResourceLimit error if evaluating the types involved
+// exceeds the given limits.
+func TypeOfWithLimits(t Term, limits Limits) (v Value, err error) {
+	b := &budget{Limits: limits}
+	defer b.finish(&err)
+	v, err = typeWith(typeContext{types: Env{budget: b}}, t)
+	if err != nil {
+		return nil, err
+	}
+	return deepForce(v), nil
+}
+
+// A budget tracks the resources used by a single call to
+// EvalWithLimits or TypeOfWithLimits.  A nil budget is unlimited.
+type budget struct {
+	Limits
+	steps    int
+	depth    int
+	finished bool
+}
+
+// resourceLimitPanic unwinds the evaluator when a limit is exceeded;
+// it is recovered by budget.finish.
+type resourceLimitPanic struct{ err error }
+
+func (b *budget) exceeded(format string, args ...interface{}) {
+	panic(resourceLimitPanic{
+		fmt.Errorf("%w: "+format, append([]interface{}{ErrResourceLimit}, args...)...),
+	})
+}
+
+// charge records n evaluation steps.
+func (b *budget) charge(n int) {
+	if b == nil || b.finished || b.Steps == 0 {
+		return
+	}
+	b.steps += n
+	if b.steps > b.Steps || b.steps < 0 {
+		b.exceeded("more than %d evaluation steps", b.Steps)
+	}
+}
+
+func (b *budget) enter() {
+	if b == nil || b.finished {
+		return
+	}
+	b.depth++
+	if b.Depth != 0 && b.depth > b.Depth {
+		b.exceeded("expression nested more than %d deep", b.Depth)
+	}
+}
+
+func (b *budget) leave() {
+	if b == nil || b.finished {
+		return
+	}
+	b.depth--
+}
+
+// checkSize checks the size of a List or Text which is about to be
+// built.
+func (b *budget) checkSize(size int) {
+	if b == nil || b.finished || b.Size == 0 {
+		return
+	}
+	if size > b.Size {
+		b.exceeded("List or Text of size %d is larger than %d", size, b.Size)
+	}
+}
+
+// checkValueSize checks the size of v, if it is a List or Text.
+func (b *budget) checkValueSize(v Value) {
+	if b == nil || b.finished || b.Size == 0 {
+		return
+	}
+	switch v := v.(type) {
+	case NonEmptyListVal:
+		b.checkSize(len(v))
+	case TextLitVal:
+		size := len(v.Suffix)
+		for _, chunk := range v.Chunks {
+			size += len(chunk.Prefix)
+		}
+		b.checkSize(size)
+	}
+}
+
+// finish stops b from limiting anything further, and turns a
+// resourceLimitPanic into an error.
+func (b *budget) finish(err *error) {
+	b.finished = true
+	if r := recover(); r != nil {
+		limit, ok := r.(resourceLimitPanic)
+		if !ok {
+			panic(r)
+		}
+		*err = limit.err
+	}
+}
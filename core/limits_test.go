@@ -0,0 +1,165 @@
+package core
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// hugeFold is Natural/fold 1000000000 Natural (λ(n : Natural) → n + 1) 0
+var hugeFold = Apply(NaturalFold,
+	NaturalLit(1000000000),
+	Natural,
+	NewLambda("n", Natural, NaturalPlus(NewVar("n"), NaturalLit(1))),
+	NaturalLit(0),
+)
+
+// doubling returns an expression which appends a list to itself n
+// times, starting from [ 1 ]
+func doubling(n int) Term {
+	var body Term = NonEmptyList{NaturalLit(1)}
+	var bindings []Binding
+	for i := 0; i < n; i++ {
+		bindings = append(bindings, Binding{Variable: "x", Value: body})
+		body = ListAppend(NewVar("x"), NewVar("x"))
+	}
+	return NewLet(body, bindings...)
+}
+
+// nestedPlus returns 1 + (1 + (1 + ... 0)), nested n deep
+func nestedPlus(n int) Term {
+	var t Term = NaturalLit(0)
+	for i := 0; i < n; i++ {
+		t = NaturalPlus(NaturalLit(1), t)
+	}
+	return t
+}
+
+// withBudget calls f with a budget of limits, and returns the error
+// if f exceeds them.
+func withBudget(limits Limits, f func(b *budget)) (err error) {
+	b := &budget{Limits: limits}
+	defer b.finish(&err)
+	f(b)
+	return nil
+}
+
+var _ = Describe("EvalWithLimits", func() {
+	It("Gives up on a huge Natural/fold without running it", func() {
+		_, err := EvalWithLimits(hugeFold, Limits{Steps: 10000})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Gives up on a List which grows too large", func() {
+		_, err := EvalWithLimits(doubling(30), Limits{Size: 1000})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Gives up on a Text which grows too large", func() {
+		var body Term = TextLitTerm{Suffix: "ab"}
+		var bindings []Binding
+		for i := 0; i < 30; i++ {
+			bindings = append(bindings, Binding{Variable: "x", Value: body})
+			body = TextAppend(NewVar("x"), NewVar("x"))
+		}
+		_, err := EvalWithLimits(NewLet(body, bindings...), Limits{Size: 1000})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Gives up on a List built by List/build which grows too large", func() {
+		// List/build Natural (λ(list : Type) → λ(cons : Natural → list → list) → λ(nil : list) → cons 1 (cons 1 … nil))
+		var body Term = NewVar("nil")
+		for i := 0; i < 50; i++ {
+			body = Apply(NewVar("cons"), NaturalLit(1), body)
+		}
+		t := Apply(ListBuild, Natural,
+			NewLambda("list", Type,
+				NewLambda("cons", NewAnonPi(Natural, NewAnonPi(NewVar("list"), NewVar("list"))),
+					NewLambda("nil", NewVar("list"), body))))
+		_, err := EvalWithLimits(t, Limits{Size: 10})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Charges for the Lists built by List/reverse and List/indexed", func() {
+		list := make(NonEmptyList, 50)
+		for i := range list {
+			list[i] = NaturalLit(i)
+		}
+		var t Term = list
+		for i := 0; i < 100; i++ {
+			t = Apply(ListReverse, Natural, t)
+		}
+		_, err := EvalWithLimits(t, Limits{Steps: 2000})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+		_, err = EvalWithLimits(Apply(ListIndexed, Natural, list), Limits{Steps: 100})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Gives up on a Text which Text/show makes too large", func() {
+		t := Apply(TextShow, TextLitTerm{Suffix: `""""""""""`})
+		_, err := EvalWithLimits(t, Limits{Size: 15})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Gives up on deeply nested expressions", func() {
+		_, err := EvalWithLimits(nestedPlus(1000), Limits{Depth: 100})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Matches Eval within the limits", func() {
+		t := doubling(5)
+		v, err := EvalWithLimits(t, Limits{Steps: 10000, Depth: 100, Size: 100})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(v).To(Equal(Eval(t)))
+	})
+	It("Doesn't limit functions in the result after it returns", func() {
+		v, err := EvalWithLimits(
+			NewLambda("x", Natural, nestedPlus(50)),
+			Limits{Depth: 10},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(v.(LambdaValue).Call(NaturalLit(0))).To(Equal(NaturalLit(50)))
+	})
+})
+
+var _ = Describe("TypeOfWithLimits", func() {
+	It("Gives up when a type evaluates a huge Natural/fold", func() {
+		// [] : List (if Natural/isZero (hugeFold) then Bool else Bool)
+		t := EmptyList{Type: IfTerm{
+			Cond: Apply(NaturalIsZero, hugeFold),
+			T:    Bool,
+			F:    Bool,
+		}}
+		_, err := TypeOfWithLimits(t, Limits{Steps: 10000})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Gives up on deeply nested expressions", func() {
+		_, err := TypeOfWithLimits(nestedPlus(1000), Limits{Depth: 100})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Limits how deeply substitution and quoting recurse", func() {
+		limits := Limits{Depth: 100}
+		err := withBudget(limits, func(b *budget) {
+			subst(b, "x", NaturalLit(1), nestedPlus(1000))
+		})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+		err = withBudget(limits, func(b *budget) {
+			rebindLocal(b, localVar{Name: "x"}, nestedPlus(1000))
+		})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+		var nested Value = NaturalLit(1)
+		for i := 0; i < 1000; i++ {
+			nested = NonEmptyListVal{nested}
+		}
+		err = withBudget(limits, func(b *budget) {
+			typeContext{types: Env{budget: b}}.quote(nested)
+		})
+		Expect(errors.Is(err, ErrResourceLimit)).To(BeTrue())
+	})
+	It("Doesn't evaluate let bindings which types don't need", func() {
+		t := NewLet(NaturalLit(1), Binding{Variable: "x", Value: hugeFold})
+		typ, err := TypeOfWithLimits(t, Limits{Steps: 10000})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(typ).To(Equal(Natural))
+	})
+	It("Matches TypeOf within the limits", func() {
+		t := doubling(5)
+		typ, err := TypeOfWithLimits(t, Limits{Steps: 10000, Depth: 100})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(typ).To(Equal(AppValue{List, Natural}))
+	})
+})
@@ -6,133 +6,32 @@ func Quote(v Value) Term {
 }
 
 // a quoteContext records how many binders of each variable name we have passed
-type quoteContext map[string]int
+type quoteContext struct{ binders Env }
 
 func (q quoteContext) extend(name string) quoteContext {
-	newCtx := make(quoteContext, len(q))
-	for k, v := range q {
-		newCtx[k] = v
-	}
-	newCtx[name]++
-	return newCtx
+	return quoteContext{q.binders.extend(name, nil)}
+}
+
+func (q quoteContext) count(name string) int {
+	return q.binders.count(name)
 }
 
 func quoteWith(ctx quoteContext, v Value) Term {
+	if b := ctx.binders.budget; b != nil {
+		b.enter()
+		defer b.leave()
+	}
+	v = force(v)
+	if b, args, ok := BuiltinApplication(v); ok {
+		var result Term = b
+		for _, arg := range args {
+			result = AppTerm{result, quoteWith(ctx, arg)}
+		}
+		return result
+	}
 	switch v := v.(type) {
 	case Universe:
 		return v
-	case Builtin:
-		return v
-	case naturalBuildVal:
-		return NaturalBuild
-	case naturalEvenVal:
-		return NaturalEven
-	case naturalFoldVal:
-		var result Term = NaturalFold
-		if v.n == nil {
-			return result
-		}
-		result = AppTerm{result, quoteWith(ctx, v.n)}
-		if v.typ == nil {
-			return result
-		}
-		result = AppTerm{result, quoteWith(ctx, v.typ)}
-		if v.succ == nil {
-			return result
-		}
-		return AppTerm{result, quoteWith(ctx, v.succ)}
-	case naturalIsZeroVal:
-		return NaturalIsZero
-	case naturalOddVal:
-		return NaturalOdd
-	case naturalShowVal:
-		return NaturalShow
-	case naturalSubtractVal:
-		if v.a != nil {
-			return AppTerm{NaturalSubtract, quoteWith(ctx, v.a)}
-		}
-		return NaturalSubtract
-	case naturalToIntegerVal:
-		return NaturalToInteger
-	case integerShowVal:
-		return IntegerShow
-	case integerToDoubleVal:
-		return IntegerToDouble
-	case doubleShowVal:
-		return DoubleShow
-	case optionalBuildVal:
-		if v.typ != nil {
-			return AppTerm{OptionalBuild, quoteWith(ctx, v.typ)}
-		}
-		return OptionalBuild
-	case optionalFoldVal:
-		var result Term = OptionalFold
-		if v.typ1 == nil {
-			return result
-		}
-		result = AppTerm{result, quoteWith(ctx, v.typ1)}
-		if v.opt == nil {
-			return result
-		}
-		result = AppTerm{result, quoteWith(ctx, v.opt)}
-		if v.typ2 == nil {
-			return result
-		}
-		result = AppTerm{result, quoteWith(ctx, v.typ2)}
-		if v.some == nil {
-			return result
-		}
-		return AppTerm{result, quoteWith(ctx, v.some)}
-	case textShowVal:
-		return TextShow
-	case listBuildVal:
-		if v.typ != nil {
-			return AppTerm{ListBuild, quoteWith(ctx, v.typ)}
-		}
-		return ListBuild
-	case listFoldVal:
-		var result Term = ListFold
-		if v.typ1 == nil {
-			return result
-		}
-		result = AppTerm{result, quoteWith(ctx, v.typ1)}
-		if v.list == nil {
-			return result
-		}
-		result = AppTerm{result, quoteWith(ctx, v.list)}
-		if v.typ2 == nil {
-			return result
-		}
-		result = AppTerm{result, quoteWith(ctx, v.typ2)}
-		if v.cons == nil {
-			return result
-		}
-		return AppTerm{result, quoteWith(ctx, v.cons)}
-	case listHeadVal:
-		if v.typ != nil {
-			return AppTerm{ListHead, quoteWith(ctx, v.typ)}
-		}
-		return ListHead
-	case listIndexedVal:
-		if v.typ != nil {
-			return AppTerm{ListIndexed, quoteWith(ctx, v.typ)}
-		}
-		return ListIndexed
-	case listLengthVal:
-		if v.typ != nil {
-			return AppTerm{ListLength, quoteWith(ctx, v.typ)}
-		}
-		return ListLength
-	case listLastVal:
-		if v.typ != nil {
-			return AppTerm{ListLast, quoteWith(ctx, v.typ)}
-		}
-		return ListLast
-	case listReverseVal:
-		if v.typ != nil {
-			return AppTerm{ListReverse, quoteWith(ctx, v.typ)}
-		}
-		return ListReverse
 	case Var:
 		return v
 	case localVar:
@@ -140,17 +39,17 @@ func quoteWith(ctx quoteContext, v Value) Term {
 	case quoteVar:
 		return Var{
 			Name:  v.Name,
-			Index: ctx[v.Name] - v.Index - 1,
+			Index: ctx.count(v.Name) - v.Index - 1,
 		}
 	case LambdaValue:
-		bodyVal := v.Call(quoteVar{Name: v.Label, Index: ctx[v.Label]})
+		bodyVal := v.Call(quoteVar{Name: v.Label, Index: ctx.count(v.Label)})
 		return LambdaTerm{
 			Label: v.Label,
 			Type:  quoteWith(ctx, v.Domain),
 			Body:  quoteWith(ctx.extend(v.Label), bodyVal),
 		}
 	case PiValue:
-		bodyVal := v.Range(quoteVar{Name: v.Label, Index: ctx[v.Label]})
+		bodyVal := v.Range(quoteVar{Name: v.Label, Index: ctx.count(v.Label)})
 		return PiTerm{
 			Label: v.Label,
 			Type:  quoteWith(ctx, v.Domain),
@@ -161,7 +60,7 @@ func quoteWith(ctx quoteContext, v Value) Term {
 			Fn:  quoteWith(ctx, v.Fn),
 			Arg: quoteWith(ctx, v.Arg),
 		}
-	case opValue:
+	case OpValue:
 		return OpTerm{
 			OpCode: v.OpCode,
 			L:      quoteWith(ctx, v.L),
@@ -195,7 +94,7 @@ func quoteWith(ctx quoteContext, v Value) Term {
 			Chunks: newChunks,
 			Suffix: v.Suffix,
 		}
-	case ifVal:
+	case IfVal:
 		return IfTerm{
 			Cond: quoteWith(ctx, v.Cond),
 			T:    quoteWith(ctx, v.T),
@@ -215,23 +114,23 @@ func quoteWith(ctx quoteContext, v Value) Term {
 			rt[k] = quoteWith(ctx, v)
 		}
 		return rt
-	case toMapVal:
+	case ToMapVal:
 		result := ToMap{Record: quoteWith(ctx, v.Record)}
 		if v.Type != nil {
 			result.Type = quoteWith(ctx, v.Type)
 		}
 		return result
-	case fieldVal:
+	case FieldVal:
 		return Field{
 			Record:    quoteWith(ctx, v.Record),
 			FieldName: v.FieldName,
 		}
-	case projectVal:
+	case ProjectVal:
 		return Project{
 			Record:     quoteWith(ctx, v.Record),
 			FieldNames: v.FieldNames,
 		}
-	case unionTypeVal:
+	case UnionTypeVal:
 		result := UnionType{}
 		for k, v := range v {
 			if v == nil {
@@ -241,7 +140,7 @@ func quoteWith(ctx quoteContext, v Value) Term {
 			result[k] = quoteWith(ctx, v)
 		}
 		return result
-	case mergeVal:
+	case MergeVal:
 		result := Merge{
 			Handler: quoteWith(ctx, v.Handler),
 			Union:   quoteWith(ctx, v.Union),
@@ -250,7 +149,7 @@ func quoteWith(ctx quoteContext, v Value) Term {
 			result.Annotation = quoteWith(ctx, v.Annotation)
 		}
 		return result
-	case assertVal:
+	case AssertVal:
 		return Assert{Annotation: quoteWith(ctx, v.Annotation)}
 	}
 	panic("unknown Value type")
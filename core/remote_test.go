@@ -0,0 +1,17 @@
+package core
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Remote with an unterminated IP literal", func() {
+	// NewRemote and ParseRemote refuse to build one
+	remote := Remote{scheme: "https", authority: "user@[::1", path: "/foo"}
+	It("has the rest of the literal as its host", func() {
+		Expect(remote.Host()).To(Equal("::1"))
+	})
+	It("has no port", func() {
+		Expect(remote.Port()).To(Equal(""))
+	})
+})
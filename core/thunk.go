@@ -0,0 +1,147 @@
+package core
+
+import "sync"
+
+// A thunk is a Value whose evaluation has been deferred until it is
+// needed.  Its result is memoized, so a thunk which is shared (for
+// example, a let-bound variable which is used several times) is
+// evaluated at most once.
+//
+// Thunks only ever appear as let-bound or function-argument values
+// in an Env, and as the fields of a RecordLitVal.  evalWith never
+// returns a thunk, but the Values it returns may contain thunks in
+// their record fields; Eval, AlphaBetaEval and TypeOf remove these
+// with deepForce before returning.
+type thunk struct{ *thunkState }
+
+type thunkState struct {
+	once  sync.Once
+	term  Term
+	env   Env
+	alpha bool
+	value Value
+}
+
+func (thunk) isValue() {}
+
+func (t thunk) force() Value {
+	t.once.Do(func() {
+		t.value = evalWith(t.term, t.env, t.alpha)
+		// let the Term and Env be garbage collected
+		t.term, t.env = nil, Env{}
+	})
+	return t.value
+}
+
+// delay returns a Value which evaluates t in e when forced.  Terms
+// which are cheap to evaluate are evaluated immediately rather than
+// wrapped in a thunk.
+func delay(t Term, e Env, shouldAlphaNormalize bool) Value {
+	switch t := t.(type) {
+	case Var:
+		// share the variable's thunk, if it has one
+		if v, ok := e.lookup(t.Name, t.Index); ok {
+			return v
+		}
+		return evalWith(t, e, shouldAlphaNormalize)
+	case Universe, Builtin, BoolLit, NaturalLit, IntegerLit, DoubleLit:
+		return evalWith(t, e, shouldAlphaNormalize)
+	}
+	return thunk{&thunkState{term: t, env: e, alpha: shouldAlphaNormalize}}
+}
+
+// force evaluates v if it is a thunk.
+func force(v Value) Value {
+	if t, ok := v.(thunk); ok {
+		return t.force()
+	}
+	return v
+}
+
+// deepForce returns v with every thunk it contains forced.  Function
+// values are wrapped so that their results are deep-forced too.
+// Partially-applied builtins are returned as they are: their
+// arguments are only visible through Quote, which forces thunks
+// itself.
+func deepForce(v Value) Value {
+	switch v := force(v).(type) {
+	case LambdaValue:
+		fn := v.Fn
+		return LambdaValue{
+			Label:  v.Label,
+			Domain: deepForce(v.Domain),
+			Fn:     func(x Value) Value { return deepForce(fn(x)) },
+		}
+	case PiValue:
+		rng := v.Range
+		return PiValue{
+			Label:  v.Label,
+			Domain: deepForce(v.Domain),
+			Range:  func(x Value) Value { return deepForce(rng(x)) },
+		}
+	case AppValue:
+		return AppValue{Fn: deepForce(v.Fn), Arg: deepForce(v.Arg)}
+	case OpValue:
+		return OpValue{OpCode: v.OpCode, L: deepForce(v.L), R: deepForce(v.R)}
+	case EmptyListVal:
+		return EmptyListVal{Type: deepForce(v.Type)}
+	case NonEmptyListVal:
+		result := make(NonEmptyListVal, len(v))
+		for i, item := range v {
+			result[i] = deepForce(item)
+		}
+		return result
+	case TextLitVal:
+		result := TextLitVal{Suffix: v.Suffix}
+		for _, chunk := range v.Chunks {
+			result.Chunks = append(result.Chunks,
+				ChunkVal{Prefix: chunk.Prefix, Expr: deepForce(chunk.Expr)})
+		}
+		return result
+	case IfVal:
+		return IfVal{Cond: deepForce(v.Cond), T: deepForce(v.T), F: deepForce(v.F)}
+	case SomeVal:
+		return SomeVal{Val: deepForce(v.Val)}
+	case RecordTypeVal:
+		result := make(RecordTypeVal, len(v))
+		for k, field := range v {
+			result[k] = deepForce(field)
+		}
+		return result
+	case RecordLitVal:
+		result := make(RecordLitVal, len(v))
+		for k, field := range v {
+			result[k] = deepForce(field)
+		}
+		return result
+	case UnionTypeVal:
+		result := make(UnionTypeVal, len(v))
+		for k, alternative := range v {
+			if alternative != nil {
+				alternative = deepForce(alternative)
+			}
+			result[k] = alternative
+		}
+		return result
+	case ToMapVal:
+		result := ToMapVal{Record: deepForce(v.Record)}
+		if v.Type != nil {
+			result.Type = deepForce(v.Type)
+		}
+		return result
+	case FieldVal:
+		return FieldVal{Record: deepForce(v.Record), FieldName: v.FieldName}
+	case ProjectVal:
+		return ProjectVal{Record: deepForce(v.Record), FieldNames: v.FieldNames}
+	case MergeVal:
+		result := MergeVal{Handler: deepForce(v.Handler), Union: deepForce(v.Union)}
+		if v.Annotation != nil {
+			result.Annotation = deepForce(v.Annotation)
+		}
+		return result
+	case AssertVal:
+		return AssertVal{Annotation: deepForce(v.Annotation)}
+	default:
+		return v
+	}
+}
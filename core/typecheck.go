@@ -2,24 +2,52 @@ package core
 
 import (
 	"fmt"
+	"strings"
 )
 
-type context map[string][]Value
+// a typeContext records the types of the variables in scope, and
+// their values
+type typeContext struct {
+	types Env
+	// values holds the value of each variable bound by a let, and
+	// the localVar of each variable bound by a λ or a ∀
+	values Env
+}
 
-func (ctx context) extend(name string, t Value) context {
-	newctx := context{}
-	for k, v := range ctx {
-		newctx[k] = v
-	}
-	newctx[name] = append(newctx[name], t)
-	return newctx
+// extend returns a new typeContext with name bound to a variable of
+// type t, such as the parameter of a λ.
+func (ctx typeContext) extend(name string, t Value) typeContext {
+	return ctx.define(name, t, ctx.freshLocal(name))
+}
+
+// define returns a new typeContext with name bound to v, whose type
+// is t.
+func (ctx typeContext) define(name string, t, v Value) typeContext {
+	return typeContext{ctx.types.extend(name, t), ctx.values.extend(name, v)}
+}
+
+// evalEnv returns the Env to evaluate Terms in, so that the localVars
+// of let-bound variables evaluate to their values, and with the same
+// resource limits as the typechecking.
+func (ctx typeContext) evalEnv() Env {
+	return Env{budget: ctx.types.budget, locals: ctx.values.head}
+}
+
+// eval evaluates t in the Env returned by evalEnv.
+func (ctx typeContext) eval(t Term) Value {
+	return evalWith(t, ctx.evalEnv(), false)
 }
 
-func (ctx context) freshLocal(name string) localVar {
-	return localVar{Name: name, Index: len(ctx[name])}
+// quote quotes v, with the same resource limits as the typechecking.
+func (ctx typeContext) quote(v Value) Term {
+	return quoteWith(quoteContext{Env{budget: ctx.types.budget}}, v)
 }
 
-func assertTypeIs(ctx context, expr Term, expectedType Value, msg typeMessage) error {
+func (ctx typeContext) freshLocal(name string) localVar {
+	return localVar{Name: name, Index: ctx.types.count(name)}
+}
+
+func assertTypeIs(ctx typeContext, expr Term, expectedType Value, msg typeMessage) error {
 	actualType, err := typeWith(ctx, expr)
 	if err != nil {
 		return err
@@ -53,14 +81,18 @@ func functionCheck(input Universe, output Universe) Universe {
 }
 
 func TypeOf(t Term) (Value, error) {
-	v, err := typeWith(context{}, t)
+	v, err := typeWith(typeContext{}, t)
 	if err != nil {
 		return nil, err
 	}
-	return v, nil
+	return deepForce(v), nil
 }
 
-func typeWith(ctx context, t Term) (Value, error) {
+func typeWith(ctx typeContext, t Term) (Value, error) {
+	if b := ctx.types.budget; b != nil {
+		b.enter()
+		defer b.leave()
+	}
 	switch t := t.(type) {
 	case Universe:
 		switch t {
@@ -176,12 +208,14 @@ func typeWith(ctx context, t Term) (Value, error) {
 			return nil, mkTypeError(unhandledTypeCase)
 		}
 	case Var:
-		return nil, mkTypeError(typeCheckBoundVar(t))
+		// binders replace the Vars they bind with localVars before
+		// typechecking their bodies, so any Var left is unbound
+		return nil, mkTypeError(unboundVariable(t))
 	case localVar:
-		if vals, ok := ctx[t.Name]; ok {
-			if t.Index < len(vals) {
-				return vals[t.Index], nil
-			}
+		if typ, ok := ctx.types.lookupLevel(t.Name, t.Index); ok {
+			return typ, nil
+		}
+		if ctx.types.count(t.Name) > 0 {
 			return nil, mkTypeError(unboundVariable(t))
 		}
 		return nil, fmt.Errorf("Unknown variable %s", t.Name)
@@ -201,31 +235,29 @@ func typeWith(ctx context, t Term) (Value, error) {
 		expectedType := piType.Domain
 		actualType := argType
 		if !judgmentallyEqualVals(expectedType, actualType) {
-			return nil, mkTypeError(typeMismatch(Quote(expectedType), Quote(actualType)))
+			return nil, mkTypeError(typeMismatch(ctx.quote(expectedType), ctx.quote(actualType)))
 		}
-		bodyTypeVal := piType.Range(Eval(t.Arg))
+		bodyTypeVal := piType.Range(ctx.eval(t.Arg))
 		return bodyTypeVal, nil
 	case LambdaTerm:
 		_, err := typeWith(ctx, t.Type)
 		if err != nil {
 			return nil, err
 		}
-		argType := Eval(t.Type)
+		argType := ctx.eval(t.Type)
 		pi := PiValue{Label: t.Label, Domain: argType}
 		freshLocal := ctx.freshLocal(t.Label)
 		bt, err := typeWith(
 			ctx.extend(t.Label, argType),
-			subst(t.Label, freshLocal, t.Body))
+			subst(ctx.types.budget, t.Label, freshLocal, t.Body))
 		if err != nil {
 			return nil, err
 		}
 		pi.Range = func(x Value) Value {
-			rebound := rebindLocal(freshLocal, Quote(bt))
-			return evalWith(rebound, Env{
-				t.Label: []Value{x},
-			}, false)
+			rebound := rebindLocal(ctx.types.budget, freshLocal, ctx.quote(bt))
+			return evalWith(rebound, ctx.evalEnv().extend(t.Label, x), false)
 		}
-		_, err = typeWith(ctx, Quote(pi))
+		_, err = typeWith(ctx, ctx.quote(pi))
 		if err != nil {
 			return nil, err
 		}
@@ -241,8 +273,8 @@ func typeWith(ctx context, t Term) (Value, error) {
 		}
 		freshLocal := ctx.freshLocal(t.Label)
 		outUniv, err := typeWith(
-			ctx.extend(t.Label, Eval(t.Type)),
-			subst(t.Label, freshLocal, t.Body))
+			ctx.extend(t.Label, ctx.eval(t.Type)),
+			subst(ctx.types.budget, t.Label, freshLocal, t.Body))
 		if err != nil {
 			return nil, err
 		}
@@ -269,14 +301,16 @@ func typeWith(ctx context, t Term) (Value, error) {
 				if err != nil {
 					return nil, err
 				}
-				if !judgmentallyEqualVals(bindingType, Eval(binding.Annotation)) {
-					return nil, mkTypeError(annotMismatch(binding.Annotation, Quote(bindingType)))
+				if !judgmentallyEqualVals(bindingType, ctx.eval(binding.Annotation)) {
+					return nil, mkTypeError(annotMismatch(binding.Annotation, ctx.quote(bindingType)))
 				}
 			}
 
-			value := Quote(Eval(binding.Value))
-			let = subst(binding.Variable, value, let).(Let)
-			ctx = ctx.extend(binding.Variable, bindingType)
+			// the binding stays unevaluated until the rest of
+			// the let needs its value
+			value := delay(binding.Value, ctx.evalEnv(), false)
+			let = subst(ctx.types.budget, binding.Variable, ctx.freshLocal(binding.Variable), let).(Let)
+			ctx = ctx.define(binding.Variable, bindingType, value)
 		}
 		return typeWith(ctx, let.Body)
 	case Annot:
@@ -292,8 +326,8 @@ func typeWith(ctx context, t Term) (Value, error) {
 			return nil, err
 		}
 		// T₀ ≡ T₁
-		if !judgmentallyEqualVals(Eval(t.Annotation), actualType) {
-			return nil, mkTypeError(annotMismatch(t.Annotation, Quote(actualType)))
+		if !judgmentallyEqualVals(ctx.eval(t.Annotation), actualType) {
+			return nil, mkTypeError(annotMismatch(t.Annotation, ctx.quote(actualType)))
 		}
 		// ─────────────────
 		// Γ ⊢ (t : T₀) : T₀
@@ -324,14 +358,14 @@ func typeWith(ctx context, t Term) (Value, error) {
 			return nil, err
 		}
 		// no need to check for err here
-		if t, _ := typeWith(ctx, Quote(L)); t != Type {
+		if t, _ := typeWith(ctx, ctx.quote(L)); t != Type {
 			return nil, mkTypeError(ifBranchMustBeTerm)
 		}
 		R, err := typeWith(ctx, t.F)
 		if err != nil {
 			return nil, err
 		}
-		if t, _ := typeWith(ctx, Quote(R)); t != Type {
+		if t, _ := typeWith(ctx, ctx.quote(R)); t != Type {
 			return nil, mkTypeError(ifBranchMustBeTerm)
 		}
 		if !judgmentallyEqualVals(L, R) {
@@ -403,11 +437,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 			if err != nil {
 				return nil, err
 			}
-			recordType := OpTerm{L: Quote(lType), R: Quote(rType), OpCode: RecordTypeMergeOp}
+			recordType := OpTerm{L: ctx.quote(lType), R: ctx.quote(rType), OpCode: RecordTypeMergeOp}
 			if _, err = typeWith(ctx, recordType); err != nil {
 				return nil, err
 			}
-			return Eval(recordType), nil
+			return ctx.eval(recordType), nil
 		case RecordTypeMergeOp:
 			lKind, err := typeWith(ctx, t.L)
 			if err != nil {
@@ -417,11 +451,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 			if err != nil {
 				return nil, err
 			}
-			lt, ok := Eval(t.L).(RecordTypeVal)
+			lt, ok := ctx.eval(t.L).(RecordTypeVal)
 			if !ok {
 				return nil, mkTypeError(combineTypesRequiresRecordType)
 			}
-			rt, ok := Eval(t.R).(RecordTypeVal)
+			rt, ok := ctx.eval(t.R).(RecordTypeVal)
 			if !ok {
 				return nil, mkTypeError(combineTypesRequiresRecordType)
 			}
@@ -469,11 +503,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 			if err != nil {
 				return nil, err
 			}
-			err = assertTypeIs(ctx, Quote(lType), Type, incomparableExpression)
+			err = assertTypeIs(ctx, ctx.quote(lType), Type, incomparableExpression)
 			if err != nil {
 				return nil, err
 			}
-			err = assertTypeIs(ctx, Quote(lType), Type, incomparableExpression)
+			err = assertTypeIs(ctx, ctx.quote(lType), Type, incomparableExpression)
 			if err != nil {
 				return nil, err
 			}
@@ -499,7 +533,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 		if err != nil {
 			return nil, err
 		}
-		listType := Eval(t.Type)
+		listType := ctx.eval(t.Type)
 		_, ok := listElementType(listType)
 		if !ok {
 			return nil, mkTypeError(invalidListType)
@@ -510,7 +544,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 		if err != nil {
 			return nil, err
 		}
-		err = assertTypeIs(ctx, Quote(T0), Type, invalidListType)
+		err = assertTypeIs(ctx, ctx.quote(T0), Type, invalidListType)
 		if err != nil {
 			return nil, err
 		}
@@ -520,7 +554,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 				return nil, err
 			}
 			if !judgmentallyEqualVals(T0, T1) {
-				return nil, mkTypeError(mismatchedListElements(Quote(T0), Quote(T1)))
+				return nil, mkTypeError(mismatchedListElements(ctx.quote(T0), ctx.quote(T1)))
 			}
 		}
 		return AppValue{List, T0}, nil
@@ -529,7 +563,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 		if err != nil {
 			return nil, err
 		}
-		if err = assertTypeIs(ctx, Quote(A), Type, invalidSome); err != nil {
+		if err = assertTypeIs(ctx, ctx.quote(A), Type, invalidSome); err != nil {
 			return nil, err
 		}
 		return AppValue{Optional, A}, nil
@@ -554,11 +588,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 		for k, v := range t {
 			fieldType, err := typeWith(ctx, v)
 			if err != nil {
-				return nil, err
+				return nil, inRecordField(k, err)
 			}
 			recordType[k] = fieldType
 		}
-		if _, err := typeWith(ctx, Quote(recordType)); err != nil {
+		if _, err := typeWith(ctx, ctx.quote(recordType)); err != nil {
 			return nil, err
 		}
 		return recordType, nil
@@ -580,14 +614,14 @@ func typeWith(ctx context, t Term) (Value, error) {
 			if err != nil {
 				return nil, err
 			}
-			tVal := Eval(t.Type)
+			tVal := ctx.eval(t.Type)
 			t, ok := listElementType(tVal)
 			if !ok {
-				return nil, mkTypeError(invalidToMapType(Quote(tVal)))
+				return nil, mkTypeError(invalidToMapType(ctx.quote(tVal)))
 			}
 			rt, ok := t.(RecordTypeVal)
 			if !ok || len(rt) != 2 || rt["mapKey"] != Text || rt["mapValue"] == nil {
-				return nil, mkTypeError(invalidToMapType(Quote(tVal)))
+				return nil, mkTypeError(invalidToMapType(ctx.quote(tVal)))
 			}
 			return tVal, nil
 		}
@@ -602,7 +636,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 				}
 			}
 		}
-		if k, _ := typeWith(ctx, Quote(elemType)); k != Type {
+		if k, _ := typeWith(ctx, ctx.quote(elemType)); k != Type {
 			return nil, mkTypeError(invalidToMapRecordKind)
 		}
 		inferred := AppValue{List, RecordTypeVal{"mapKey": Text, "mapValue": elemType}}
@@ -612,9 +646,9 @@ func typeWith(ctx context, t Term) (Value, error) {
 		if _, err = typeWith(ctx, t.Type); err != nil {
 			return nil, err
 		}
-		annot := Eval(t.Type)
+		annot := ctx.eval(t.Type)
 		if !judgmentallyEqualVals(inferred, annot) {
-			return nil, mkTypeError(mapTypeMismatch(Quote(inferred), t.Type))
+			return nil, mkTypeError(mapTypeMismatch(ctx.quote(inferred), t.Type))
 		}
 		return inferred, nil
 	case Field:
@@ -630,8 +664,8 @@ func typeWith(ctx context, t Term) (Value, error) {
 			}
 			return fieldType, nil
 		}
-		unionTypeV := Eval(t.Record)
-		unionType, ok := unionTypeV.(unionTypeVal)
+		unionTypeV := ctx.eval(t.Record)
+		unionType, ok := unionTypeV.(UnionTypeVal)
 		if !ok {
 			return nil, mkTypeError(cantAccess)
 		}
@@ -658,6 +692,9 @@ func typeWith(ctx context, t Term) (Value, error) {
 		}
 		result := make(RecordTypeVal, len(t.FieldNames))
 		for _, name := range t.FieldNames {
+			if _, ok := result[name]; ok {
+				return nil, mkTypeError(duplicateProjectionLabel(name))
+			}
 			var ok bool
 			result[name], ok = recordType[name]
 			if !ok {
@@ -678,7 +715,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 		if err != nil {
 			return nil, err
 		}
-		selectorVal := Eval(t.Selector)
+		selectorVal := ctx.eval(t.Selector)
 		selector, ok := selectorVal.(RecordTypeVal)
 		if !ok {
 			return nil, mkTypeError(cantProjectByExpression)
@@ -690,7 +727,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 				return nil, mkTypeError(missingField)
 			}
 			if !judgmentallyEqualVals(fieldType, typ) {
-				return nil, mkTypeError(projectionTypeMismatch(Quote(typ), Quote(fieldType)))
+				return nil, mkTypeError(projectionTypeMismatch(ctx.quote(typ), ctx.quote(fieldType)))
 			}
 			result[name] = typ
 		}
@@ -722,7 +759,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 				}
 			}
 			if c == Sort {
-				if Eval(typ) != Kind {
+				if ctx.eval(typ) != Kind {
 					return nil, mkTypeError(invalidAlternativeType)
 				}
 			}
@@ -742,7 +779,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 		if !ok {
 			return nil, mkTypeError(mustMergeARecord)
 		}
-		unionType, ok := unionTypeV.(unionTypeVal)
+		unionType, ok := unionTypeV.(UnionTypeVal)
+		if app, isApp := unionTypeV.(AppValue); isApp && app.Fn == Optional {
+			// merge treats Optional T as < None | Some : T >
+			unionType, ok = UnionTypeVal{"None": nil, "Some": app.Arg}, true
+		}
 		if !ok {
 			return nil, mkTypeError(mustMergeUnion)
 		}
@@ -757,7 +798,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 			if _, err := typeWith(ctx, t.Annotation); err != nil {
 				return nil, err
 			}
-			return Eval(t.Annotation), nil
+			return ctx.eval(t.Annotation), nil
 		}
 
 		var result Value
@@ -771,7 +812,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 					result = fieldType
 				} else {
 					if !judgmentallyEqualVals(result, fieldType) {
-						return nil, mkTypeError(handlerOutputTypeMismatch(Quote(result), Quote(fieldType)))
+						return nil, mkTypeError(handlerOutputTypeMismatch(ctx.quote(result), ctx.quote(fieldType)))
 					}
 				}
 			} else {
@@ -780,7 +821,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 					return nil, mkTypeError(handlerNotAFunction)
 				}
 				if !judgmentallyEqualVals(altType, pi.Domain) {
-					return nil, mkTypeError(handlerInputTypeMismatch(Quote(altType), Quote(pi.Domain)))
+					return nil, mkTypeError(handlerInputTypeMismatch(ctx.quote(altType), ctx.quote(pi.Domain)))
 				}
 				outputType := pi.Range(NaturalLit(1))
 				outputType2 := pi.Range(NaturalLit(2))
@@ -792,7 +833,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 					result = outputType
 				} else {
 					if !judgmentallyEqualVals(result, outputType) {
-						return nil, mkTypeError(handlerOutputTypeMismatch(Quote(result), Quote(outputType)))
+						return nil, mkTypeError(handlerOutputTypeMismatch(ctx.quote(result), ctx.quote(outputType)))
 					}
 				}
 			}
@@ -801,8 +842,8 @@ func typeWith(ctx context, t Term) (Value, error) {
 			if _, err := typeWith(ctx, t.Annotation); err != nil {
 				return nil, err
 			}
-			if !judgmentallyEqualVals(result, Eval(t.Annotation)) {
-				return nil, mkTypeError(annotMismatch(t.Annotation, Quote(result)))
+			if !judgmentallyEqualVals(result, ctx.eval(t.Annotation)) {
+				return nil, mkTypeError(annotMismatch(t.Annotation, ctx.quote(result)))
 			}
 		}
 		return result, nil
@@ -811,21 +852,26 @@ func typeWith(ctx context, t Term) (Value, error) {
 		if err != nil {
 			return nil, err
 		}
-		op, ok := Eval(t.Annotation).(opValue)
+		op, ok := ctx.eval(t.Annotation).(OpValue)
 		if !ok || op.OpCode != EquivOp {
 			return nil, mkTypeError(notAnEquivalence)
 		}
 		if !judgmentallyEqualVals(op.L, op.R) {
-			return nil, mkTypeError(assertionFailed(Quote(op.L), Quote(op.R)))
+			return nil, mkTypeError(assertionFailed(ctx.quote(op.L), ctx.quote(op.R)))
 		}
 		return op, nil
+	case DuplicateLabel:
+		return nil, mkTypeError(duplicateLabel(t))
 	}
 	return nil, mkTypeError(unhandledTypeCase)
 }
 
 type typeError struct {
-	ctx     context
+	ctx     typeContext
 	message typeMessage
+	// field is the path through record literal fields to the
+	// subexpression which failed, if any
+	field Path
 }
 
 func mkTypeError(message typeMessage) typeError {
@@ -833,9 +879,25 @@ func mkTypeError(message typeMessage) typeError {
 }
 
 func (t typeError) Error() string {
+	if len(t.field) > 0 {
+		return fmt.Sprintf("%s (in record field ❰%s❱)",
+			t.message, strings.TrimPrefix(t.field.String(), "."))
+	}
 	return t.message.String()
 }
 
+// inRecordField records that err occurred while typechecking the
+// record literal field called name.  This matters most for record
+// puns like `{ x }`, where an unbound variable is the field itself.
+func inRecordField(name string, err error) error {
+	te, ok := err.(typeError)
+	if !ok {
+		return err
+	}
+	te.field = append(Path{{Name: name}}, te.field...)
+	return te
+}
+
 type typeMessage interface {
 	String() string
 }
@@ -859,6 +921,17 @@ func (m twoArgTypeMessage) String() string {
 	return fmt.Sprintf(m.format, m.expr0, m.expr1)
 }
 
+func duplicateLabel(t DuplicateLabel) typeMessage {
+	if _, ok := t.Term.(UnionType); ok {
+		return staticTypeMessage{fmt.Sprintf("Duplicate union alternative ❰%s❱", t.Label)}
+	}
+	return staticTypeMessage{fmt.Sprintf("Duplicate record field ❰%s❱", t.Label)}
+}
+
+func duplicateProjectionLabel(name string) typeMessage {
+	return staticTypeMessage{fmt.Sprintf("Duplicate field ❰%s❱ in projection", name)}
+}
+
 func unboundVariable(e Term) typeMessage {
 	return oneArgTypeMessage{
 		format: "Unbound variable: %v",
@@ -963,13 +1036,6 @@ func assertionFailed(leftTerm, rightTerm Term) typeMessage {
 	}
 }
 
-func typeCheckBoundVar(boundVar Term) typeMessage {
-	return oneArgTypeMessage{
-		format: "Internal error: shouldn't ever see BoundVar in TypeOf(), but saw %s",
-		expr:   boundVar,
-	}
-}
-
 func cantBoolOp(opCode int) typeMessage {
 	var opStr string
 	switch opCode {
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"math"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -54,7 +56,7 @@ var _ = Describe("TypeOf", func() {
 			NewLambda("a", Natural,
 				Assert{OpTerm{EquivOp, NewVar("a"), NewVar("a")}}),
 			NewPiVal("a", Natural, func(a Value) Value {
-				return opValue{EquivOp, a, a}
+				return OpValue{EquivOp, a, a}
 			})),
 	)
 	DescribeTable("Pi",
@@ -69,11 +71,45 @@ var _ = Describe("TypeOf", func() {
 				NewLambda("a", Natural,
 					Assert{OpTerm{EquivOp, NewVar("a"), NewVar("a")}}),
 				NaturalLit(3)),
-			opValue{EquivOp, NaturalLit(3), NaturalLit(3)}),
+			OpValue{EquivOp, NaturalLit(3), NaturalLit(3)}),
+	)
+	DescribeTable("Let",
+		typecheckTest,
+		Entry(`let T = Natural let x : T = 1 in x : Natural`,
+			NewLet(NewVar("x"),
+				Binding{Variable: "T", Value: Natural},
+				Binding{Variable: "x", Annotation: NewVar("T"), Value: NaturalLit(1)}),
+			Natural),
+		Entry(`let x = Natural in λ(x : x) → x : ∀(x : Natural) → Natural -- shadowed binding`,
+			NewLet(NewLambda("x", NewVar("x"), NewVar("x")),
+				Binding{Variable: "x", Value: Natural}),
+			NewPiVal("x", Natural, func(Value) Value { return Natural })),
+		Entry(`λ(a : Type) → let b = a in λ(x : b) → x : ∀(a : Type) → ∀(x : a) → a -- binding of a bound variable`,
+			NewLambda("a", Type, NewLet(NewLambda("x", NewVar("b"), NewVar("x")),
+				Binding{Variable: "b", Value: NewVar("a")})),
+			NewPiVal("a", Type, func(a Value) Value {
+				return NewPiVal("x", a, func(Value) Value { return a })
+			})),
+		Entry(`let n = 1 in assert : n ≡ 1 : 1 ≡ 1`,
+			NewLet(Assert{OpTerm{EquivOp, NewVar("n"), NaturalLit(1)}},
+				Binding{Variable: "n", Value: NaturalLit(1)}),
+			OpValue{EquivOp, NaturalLit(1), NaturalLit(1)}),
 	)
 	DescribeTable("Others",
 		typecheckTest,
 		Entry(`3 : Natural`, NaturalLit(3), Natural),
+		Entry(`merge { Some = λ(x : Natural) → x, None = 0 } (Some 1) : Natural`,
+			Merge{
+				Handler: RecordLit{"Some": NewLambda("x", Natural, NewVar("x")), "None": NaturalLit(0)},
+				Union:   Some{NaturalLit(1)},
+			},
+			Natural),
+		Entry(`merge { Some = λ(x : Natural) → True, None = False } (None Natural) : Bool`,
+			Merge{
+				Handler: RecordLit{"Some": NewLambda("x", Natural, True), "None": False},
+				Union:   Apply(None, Natural),
+			},
+			Bool),
 		Entry(`[] : List Natural : List Natural`,
 			EmptyList{Apply(List, Natural)}, AppValue{List, Natural}),
 	)
@@ -100,5 +136,37 @@ var _ = Describe("TypeOf", func() {
 			Apply(List, NaturalLit(3))),
 		Entry(`Natural Natural -- Fn of AppTerm isn't of function type`,
 			Apply(Natural, Natural)),
+
+		// Merge
+		Entry(`merge { Some = λ(x : Natural) → x } (None Natural) -- missing None handler`,
+			Merge{
+				Handler: RecordLit{"Some": NewLambda("x", Natural, NewVar("x"))},
+				Union:   Apply(None, Natural),
+			}),
+		Entry(`merge { None = 0 } 1 -- not a union or Optional`,
+			Merge{Handler: RecordLit{"None": NaturalLit(0)}, Union: NaturalLit(1)}),
+
+		// record puns
+		Entry(`{ x } -- unbound pun`,
+			RecordLit{"x": NewVar("x")}),
+
+		// duplicate labels
+		Entry(`{ x : Natural, x : Natural } -- duplicate record field`,
+			DuplicateLabel{Term: RecordType{"x": Natural}, Label: "x"}),
+		Entry(`< A | A > -- duplicate union alternative`,
+			DuplicateLabel{Term: UnionType{"A": nil}, Label: "A"}),
+		Entry(`{ x = 1 }.{ x, x } -- duplicate projection label`,
+			Project{RecordLit{"x": NaturalLit(1)}, []string{"x", "x"}}),
+		Entry(`{ x = 1, x = 2 } -- duplicate record literal fields which can't be combined`,
+			RecordLit{"x": OpTerm{OpCode: RecordMergeOp, L: NaturalLit(1), R: NaturalLit(2)}}),
 	)
+	It("Accepts assert : NaN ≡ NaN", func() {
+		// the spec's AssertNaN; Doubles are compared by bit pattern
+		_, err := TypeOf(Assert{OpTerm{EquivOp, DoubleLit(math.NaN()), DoubleLit(math.NaN())}})
+		Expect(err).ToNot(HaveOccurred())
+	})
+	It("Reports which record field failed", func() {
+		_, err := TypeOf(RecordLit{"a": RecordLit{"x": NewVar("x")}})
+		Expect(err).To(MatchError("Unbound variable: x (in record field ❰a.x❱)"))
+	})
 })
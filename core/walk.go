@@ -0,0 +1,194 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Walk traverses t in depth-first order.  It calls visit(t), and if
+// visit returns true, it walks each of the immediate subterms of t
+// in turn.
+//
+// Record fields and union alternatives are walked in order of their
+// names.
+func Walk(t Term, visit func(Term) bool) {
+	if !visit(t) {
+		return
+	}
+	// RewriteChildren is the one place that knows the shape of every
+	// Term, so we use it to find the children without rewriting them
+	RewriteChildren(t, func(child Term) (Term, error) {
+		Walk(child, visit)
+		return child, nil
+	})
+}
+
+// Rewrite returns a copy of t, rebuilt from the bottom up: each
+// subterm of t is rewritten first, and then f is applied to the
+// result.  If f returns an error, Rewrite stops and returns it.
+//
+// Rewrite doesn't know about variable binding, so f sees subterms
+// with the same free variables as in t; if f replaces Vars it is
+// responsible for respecting their binders.
+func Rewrite(t Term, f func(Term) (Term, error)) (Term, error) {
+	t, err := RewriteChildren(t, func(child Term) (Term, error) {
+		return Rewrite(child, f)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f(t)
+}
+
+// RewriteChildren returns a copy of t with f applied to each of its
+// immediate subterms, such as the Fn and Arg of an AppTerm, the
+// values and annotations of a Let's Bindings, or the expressions
+// interpolated into a TextLitTerm.  Optional subterms, such as the
+// Annotation of a Merge, are only passed to f if they are present.
+// Terms with no subterms are returned unchanged.
+//
+// RewriteChildren is the building block for traversals that need to
+// decide for themselves whether and how to recurse, for example to
+// track binders or to stop early.  If f returns an error,
+// RewriteChildren stops and returns it.
+func RewriteChildren(t Term, f func(Term) (Term, error)) (Term, error) {
+	var err error
+	// rewrite applies f to *child, unless an earlier call failed
+	rewrite := func(child *Term) {
+		if err == nil && *child != nil {
+			*child, err = f(*child)
+		}
+	}
+	switch t := t.(type) {
+	case Universe, Builtin, Var, localVar, NaturalLit, DoubleLit,
+		BoolLit, IntegerLit, Import:
+		return t, nil
+	case LambdaTerm:
+		rewrite(&t.Type)
+		rewrite(&t.Body)
+		return returnUnlessErr(t, err)
+	case PiTerm:
+		rewrite(&t.Type)
+		rewrite(&t.Body)
+		return returnUnlessErr(t, err)
+	case AppTerm:
+		rewrite(&t.Fn)
+		rewrite(&t.Arg)
+		return returnUnlessErr(t, err)
+	case Let:
+		bindings := make([]Binding, len(t.Bindings))
+		copy(bindings, t.Bindings)
+		for i := range bindings {
+			rewrite(&bindings[i].Annotation)
+			rewrite(&bindings[i].Value)
+		}
+		rewrite(&t.Body)
+		return returnUnlessErr(Let{Bindings: bindings, Body: t.Body}, err)
+	case Annot:
+		rewrite(&t.Expr)
+		rewrite(&t.Annotation)
+		return returnUnlessErr(t, err)
+	case TextLitTerm:
+		if t.Chunks == nil {
+			return t, nil
+		}
+		chunks := make(Chunks, len(t.Chunks))
+		copy(chunks, t.Chunks)
+		for i := range chunks {
+			rewrite(&chunks[i].Expr)
+		}
+		return returnUnlessErr(TextLitTerm{Chunks: chunks, Suffix: t.Suffix}, err)
+	case IfTerm:
+		rewrite(&t.Cond)
+		rewrite(&t.T)
+		rewrite(&t.F)
+		return returnUnlessErr(t, err)
+	case OpTerm:
+		rewrite(&t.L)
+		rewrite(&t.R)
+		return returnUnlessErr(t, err)
+	case EmptyList:
+		rewrite(&t.Type)
+		return returnUnlessErr(t, err)
+	case NonEmptyList:
+		result := make(NonEmptyList, len(t))
+		copy(result, t)
+		for i := range result {
+			rewrite(&result[i])
+		}
+		return returnUnlessErr(result, err)
+	case Some:
+		rewrite(&t.Val)
+		return returnUnlessErr(t, err)
+	case RecordType:
+		result := make(RecordType, len(t))
+		for _, k := range sortedKeys(t) {
+			child := t[k]
+			rewrite(&child)
+			result[k] = child
+		}
+		return returnUnlessErr(result, err)
+	case RecordLit:
+		result := make(RecordLit, len(t))
+		for _, k := range sortedKeys(t) {
+			child := t[k]
+			rewrite(&child)
+			result[k] = child
+		}
+		return returnUnlessErr(result, err)
+	case ToMap:
+		rewrite(&t.Record)
+		rewrite(&t.Type)
+		return returnUnlessErr(t, err)
+	case Field:
+		rewrite(&t.Record)
+		return returnUnlessErr(t, err)
+	case Project:
+		rewrite(&t.Record)
+		return returnUnlessErr(t, err)
+	case ProjectType:
+		rewrite(&t.Record)
+		rewrite(&t.Selector)
+		return returnUnlessErr(t, err)
+	case UnionType:
+		result := make(UnionType, len(t))
+		for _, k := range sortedKeys(t) {
+			child := t[k]
+			rewrite(&child)
+			result[k] = child
+		}
+		return returnUnlessErr(result, err)
+	case Merge:
+		rewrite(&t.Handler)
+		rewrite(&t.Union)
+		rewrite(&t.Annotation)
+		return returnUnlessErr(t, err)
+	case Assert:
+		rewrite(&t.Annotation)
+		return returnUnlessErr(t, err)
+	case DuplicateLabel:
+		rewrite(&t.Term)
+		return returnUnlessErr(t, err)
+	default:
+		panic(fmt.Sprintf("unknown term type %+v (%v)", t, reflect.ValueOf(t).Type()))
+	}
+}
+
+func returnUnlessErr(t Term, err error) (Term, error) {
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// sortedKeys returns the keys of a RecordType, RecordLit or
+// UnionType in order
+func sortedKeys(m interface{}) []string {
+	keys := []string{}
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
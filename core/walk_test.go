@@ -0,0 +1,137 @@
+package core
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// marker is a Term we can spot in any position in a larger Term
+var marker = Var{Name: "marker"}
+
+// countMarkers counts the markers in t, using Walk
+func countMarkers(t Term) int {
+	count := 0
+	Walk(t, func(t Term) bool {
+		if t == marker {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+// There should be an entry here for every Term type, with a marker in
+// every position that can hold a subterm.
+var _ = DescribeTable("Walk and Rewrite visit every subterm",
+	func(t Term, expected int) {
+		Expect(countMarkers(t)).To(Equal(expected))
+
+		rewritten, err := Rewrite(t, func(t Term) (Term, error) {
+			if t == marker {
+				return NaturalLit(1), nil
+			}
+			return t, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(countMarkers(rewritten)).To(Equal(0))
+		// the original is untouched
+		Expect(countMarkers(t)).To(Equal(expected))
+	},
+	Entry("Universe", Type, 0),
+	Entry("Builtin", Natural, 0),
+	Entry("Var", marker, 1),
+	Entry("localVar", localVar{Name: "x"}, 0),
+	Entry("LambdaTerm", NewLambda("x", marker, marker), 2),
+	Entry("PiTerm", NewPi("x", marker, marker), 2),
+	Entry("AppTerm", Apply(marker, marker), 2),
+	Entry("Let", NewLet(marker,
+		Binding{Variable: "x", Annotation: marker, Value: marker},
+		Binding{Variable: "y", Value: marker},
+	), 4),
+	Entry("Annot", Annot{Expr: marker, Annotation: marker}, 2),
+	Entry("NaturalLit", NaturalLit(3), 0),
+	Entry("DoubleLit", DoubleLit(3), 0),
+	Entry("IntegerLit", IntegerLit(3), 0),
+	Entry("BoolLit", True, 0),
+	Entry("TextLitTerm", TextLitTerm{
+		Chunks: Chunks{{Prefix: "a", Expr: marker}, {Prefix: "b", Expr: marker}},
+		Suffix: "c",
+	}, 2),
+	Entry("IfTerm", IfTerm{Cond: marker, T: marker, F: marker}, 3),
+	Entry("OpTerm", NaturalPlus(marker, marker), 2),
+	Entry("EmptyList", EmptyList{Type: marker}, 1),
+	Entry("NonEmptyList", NewList(marker, marker), 2),
+	Entry("Some", Some{Val: marker}, 1),
+	Entry("RecordType", RecordType{"a": marker, "b": marker}, 2),
+	Entry("RecordLit", RecordLit{"a": marker, "b": marker}, 2),
+	Entry("ToMap", ToMap{Record: marker, Type: marker}, 2),
+	Entry("ToMap without type", ToMap{Record: marker}, 1),
+	Entry("Field", Field{Record: marker, FieldName: "a"}, 1),
+	Entry("Project", Project{Record: marker, FieldNames: []string{"a"}}, 1),
+	Entry("ProjectType", ProjectType{Record: marker, Selector: marker}, 2),
+	Entry("UnionType", UnionType{"A": marker, "B": nil}, 1),
+	Entry("Merge", Merge{Handler: marker, Union: marker, Annotation: marker}, 3),
+	Entry("Merge without annotation", Merge{Handler: marker, Union: marker}, 2),
+	Entry("Assert", Assert{Annotation: marker}, 1),
+	Entry("Import", Import{ImportHashed: ImportHashed{Fetchable: EnvVar("FOO")}}, 0),
+	Entry("DuplicateLabel", DuplicateLabel{Term: RecordType{"a": marker, "b": marker}, Label: "a"}, 2),
+)
+
+var _ = Describe("Walk", func() {
+	It("Visits terms in depth-first order", func() {
+		var visited []Term
+		Walk(Apply(NaturalPlus(NaturalLit(1), NaturalLit(2)), NaturalLit(3)),
+			func(t Term) bool {
+				if n, ok := t.(NaturalLit); ok {
+					visited = append(visited, n)
+				}
+				return true
+			})
+		Expect(visited).To(Equal([]Term{NaturalLit(1), NaturalLit(2), NaturalLit(3)}))
+	})
+	It("Doesn't descend when visit returns false", func() {
+		Expect(countMarkers(Some{Val: marker})).To(Equal(1))
+		count := 0
+		Walk(Some{Val: marker}, func(t Term) bool {
+			count++
+			return false
+		})
+		Expect(count).To(Equal(1))
+	})
+	It("Panics on unknown Terms", func() {
+		Expect(func() { Walk(explodingTerm{}, func(Term) bool { return true }) }).
+			To(Panic())
+	})
+})
+
+var _ = Describe("Rewrite", func() {
+	It("Rewrites from the bottom up", func() {
+		double := func(t Term) (Term, error) {
+			if n, ok := t.(NaturalLit); ok {
+				return n * 2, nil
+			}
+			if op, ok := t.(OpTerm); ok {
+				return Apply(NaturalEven, op), nil
+			}
+			return t, nil
+		}
+		Expect(Rewrite(NaturalPlus(NaturalLit(1), NaturalLit(2)), double)).
+			To(Equal(Apply(NaturalEven, NaturalPlus(NaturalLit(2), NaturalLit(4)))))
+	})
+	It("Stops at the first error", func() {
+		boom := errors.New("boom")
+		calls := 0
+		_, err := Rewrite(NewList(marker, marker), func(t Term) (Term, error) {
+			calls++
+			if t == marker {
+				return nil, boom
+			}
+			return t, nil
+		})
+		Expect(err).To(Equal(boom))
+		Expect(calls).To(Equal(1))
+	})
+})
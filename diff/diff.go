@@ -0,0 +1,236 @@
+package diff
+
+import "github.com/philandstuff/dhall-golang/core"
+
+// A Kind says what sort of Change a Change is.
+type Kind int
+
+const (
+	// Added means a record field, union alternative or List
+	// element is only in the new expression.
+	Added Kind = iota
+	// Removed means a record field, union alternative or List
+	// element is only in the old expression.
+	Removed
+	// Changed means a value is in both expressions, but differs.
+	Changed
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Changed:
+		return "changed"
+	}
+	return "unknown"
+}
+
+// A Change is a single difference between two normal forms.  Path
+// says where it is, and Old and New are the normal forms of the
+// differing values.  Old is nil for an Added Change and New is nil
+// for a Removed one.  A union alternative without a payload is also
+// represented by a nil Old or New.
+type Change struct {
+	Kind Kind
+	Path core.Path
+	Old  core.Term
+	New  core.Term
+}
+
+// A Diff is the set of differences between two expressions.
+type Diff struct {
+	root *node
+}
+
+// Compare typechecks old and new, and finds the differences between
+// their normal forms.  It descends through record literals, record
+// types, union types, union alternatives, Lists and Optionals;
+// anything else which differs is reported as a single Change.
+// Imports must already have been resolved.
+func Compare(old, new core.Term) (*Diff, error) {
+	differences, err := core.Differences(old, new)
+	if err != nil {
+		return nil, err
+	}
+	if len(differences) == 0 {
+		return &Diff{}, nil
+	}
+	return &Diff{
+		root: build(nil, core.Quote(core.Eval(old)), core.Quote(core.Eval(new)), differences),
+	}, nil
+}
+
+// Empty reports whether there are no differences.
+func (d *Diff) Empty() bool {
+	return d.root == nil
+}
+
+// Changes returns every Change in the Diff, in the order they would
+// be rendered.
+func (d *Diff) Changes() []Change {
+	var changes []Change
+	d.root.walk(func(c *Change) {
+		changes = append(changes, *c)
+	})
+	return changes
+}
+
+// The kinds of node in a Diff; each is rendered differently
+const (
+	leaf = iota
+	recordLit
+	recordType
+	unionType
+	list
+	some
+	alternative
+)
+
+// A node is a subtree of a Diff.  It is either a leaf, holding a
+// Change, or a container of the entries which differ.
+type node struct {
+	kind    int
+	change  *Change
+	entries []entry
+	// elided is true if the container has entries which don't
+	// differ
+	elided bool
+	// name is the name of an alternative node
+	name string
+}
+
+// An entry is a record field, union alternative or List element.
+type entry struct {
+	label string
+	node  *node
+}
+
+func (n *node) walk(f func(*Change)) {
+	if n == nil {
+		return
+	}
+	if n.kind == leaf {
+		f(n.change)
+		return
+	}
+	for _, e := range n.entries {
+		e.node.walk(f)
+	}
+}
+
+func newLeaf(kind Kind, path core.Path, old, new core.Term) *node {
+	return &node{
+		kind:   leaf,
+		change: &Change{Kind: kind, Path: path, Old: old, New: new},
+	}
+}
+
+// build returns the node of the differences ds between the normal
+// forms old and new, which are at path.  ds are the differences
+// core.Differences found within path, in its order.
+func build(path core.Path, old, new core.Term, ds []*core.Difference) *node {
+	if old, ok := old.(core.Some); ok {
+		if new, ok := new.(core.Some); ok {
+			return &node{
+				kind:    some,
+				entries: []entry{{node: build(path, old.Val, new.Val, ds)}},
+			}
+		}
+	}
+	if d := ds[0]; len(d.Path) == len(path) {
+		return newLeaf(Changed, d.Path, d.Left, d.Right)
+	}
+	switch old := old.(type) {
+	case core.RecordLit:
+		return buildFields(recordLit, path, old, new.(core.RecordLit), ds)
+	case core.RecordType:
+		return buildFields(recordType, path, old, new.(core.RecordType), ds)
+	case core.UnionType:
+		return buildFields(unionType, path, old, new.(core.UnionType), ds)
+	case core.AppTerm:
+		// a union alternative applied to its payload
+		name := old.Fn.(core.Field).FieldName
+		return &node{
+			kind:    alternative,
+			name:    name,
+			entries: []entry{{node: build(ds[0].Path[:len(path)+1], old.Arg, new.(core.AppTerm).Arg, ds)}},
+		}
+	}
+	return buildList(path, elements(old), elements(new), ds)
+}
+
+// elements returns the elements of t, which is a List literal
+func elements(t core.Term) []core.Term {
+	if t, ok := t.(core.NonEmptyList); ok {
+		return t
+	}
+	return nil
+}
+
+// groups splits ds, the differences within path, into runs whose
+// Paths have the same next step
+func groups(path core.Path, ds []*core.Difference) [][]*core.Difference {
+	var result [][]*core.Difference
+	for i, d := range ds {
+		if i == 0 || d.Path[len(path)] != ds[i-1].Path[len(path)] {
+			result = append(result, nil)
+		}
+		result[len(result)-1] = append(result[len(result)-1], d)
+	}
+	return result
+}
+
+func buildFields(kind int, path core.Path, old, new map[string]core.Term, ds []*core.Difference) *node {
+	n := &node{kind: kind}
+	names := len(old)
+	for name := range new {
+		if _, ok := old[name]; !ok {
+			names++
+		}
+	}
+	for _, group := range groups(path, ds) {
+		d := group[0]
+		name := d.Path[len(path)].Name
+		oldVal, inOld := old[name]
+		newVal, inNew := new[name]
+		var sub *node
+		switch {
+		case !inOld:
+			sub = newLeaf(Added, d.Path, nil, newVal)
+		case !inNew:
+			sub = newLeaf(Removed, d.Path, oldVal, nil)
+		default:
+			sub = build(d.Path[:len(path)+1], oldVal, newVal, group)
+		}
+		n.entries = append(n.entries, entry{label: name, node: sub})
+	}
+	n.elided = len(n.entries) < names
+	return n
+}
+
+func buildList(path core.Path, old, new []core.Term, ds []*core.Difference) *node {
+	n := &node{kind: list}
+	for _, group := range groups(path, ds) {
+		d := group[0]
+		i := d.Path[len(path)].Index
+		var sub *node
+		switch {
+		case i >= len(old):
+			sub = newLeaf(Added, d.Path, nil, new[i])
+		case i >= len(new):
+			sub = newLeaf(Removed, d.Path, old[i], nil)
+		default:
+			sub = build(d.Path[:len(path)+1], old[i], new[i], group)
+		}
+		n.entries = append(n.entries, entry{node: sub})
+	}
+	longest := len(old)
+	if len(new) > longest {
+		longest = len(new)
+	}
+	n.elided = len(n.entries) < longest
+	return n
+}
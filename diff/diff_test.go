@@ -0,0 +1,127 @@
+package diff_test
+
+import (
+	"strings"
+
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/diff"
+	"github.com/philandstuff/dhall-golang/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func parse(source string) Term {
+	term, err := parser.Parse("-", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	return term.(Term)
+}
+
+func compare(old, new string) *diff.Diff {
+	d, err := diff.Compare(parse(old), parse(new))
+	Expect(err).ToNot(HaveOccurred())
+	return d
+}
+
+var _ = Describe("Compare", func() {
+	It("Finds no differences between equivalent expressions", func() {
+		d := compare(`{ a = 1 + 1, b = λ(x : Natural) → x }`, `{ b = λ(y : Natural) → y, a = 2 }`)
+		Expect(d.Empty()).To(BeTrue())
+		Expect(d.Changes()).To(BeEmpty())
+		Expect(d.String()).To(Equal(""))
+	})
+	It("Fails if either expression doesn't typecheck", func() {
+		_, err := diff.Compare(parse(`1`), parse(`1 + True`))
+		Expect(err).To(HaveOccurred())
+	})
+	DescribeTable("Changes",
+		func(old, new string, expected ...diff.Change) {
+			Expect(compare(old, new).Changes()).To(Equal(expected))
+		},
+		Entry("Changed scalar", `1`, `2`,
+			diff.Change{Kind: diff.Changed, Old: NaturalLit(1), New: NaturalLit(2)}),
+		Entry("Record fields",
+			`{ a = 1, b = { c = True, d = "x" }, e = 3 }`,
+			`{ a = 1, b = { c = False, d = "x" }, f = 4 }`,
+			diff.Change{Kind: diff.Changed, Path: Path{{Name: "b"}, {Name: "c"}}, Old: True, New: False},
+			diff.Change{Kind: diff.Removed, Path: Path{{Name: "e"}}, Old: NaturalLit(3)},
+			diff.Change{Kind: diff.Added, Path: Path{{Name: "f"}}, New: NaturalLit(4)},
+		),
+		Entry("List elements",
+			`[ 1, 2, 3 ]`, `[ 1, 5, 3, 4 ]`,
+			diff.Change{Kind: diff.Changed, Path: Path{{Index: 1}}, Old: NaturalLit(2), New: NaturalLit(5)},
+			diff.Change{Kind: diff.Added, Path: Path{{Index: 3}}, New: NaturalLit(4)},
+		),
+		Entry("Emptied List",
+			`[ 1 ]`, `[] : List Natural`,
+			diff.Change{Kind: diff.Removed, Path: Path{{Index: 0}}, Old: NaturalLit(1)},
+		),
+		Entry("Union alternative payload",
+			`< A : { x : Natural } | B >.A { x = 1 }`,
+			`< A : { x : Natural } | B >.A { x = 2 }`,
+			diff.Change{Kind: diff.Changed, Path: Path{{Name: "A"}, {Name: "x"}}, Old: NaturalLit(1), New: NaturalLit(2)},
+		),
+		Entry("Union alternative",
+			`< A | B >.A`, `< A | B >.B`,
+			diff.Change{
+				Kind: diff.Changed,
+				Old:  Field{Record: UnionType{"A": nil, "B": nil}, FieldName: "A"},
+				New:  Field{Record: UnionType{"A": nil, "B": nil}, FieldName: "B"},
+			},
+		),
+		Entry("Union type alternatives",
+			`< A : Natural | B | C >`, `< A : Bool | B : Text | D >`,
+			diff.Change{Kind: diff.Changed, Path: Path{{Name: "A"}}, Old: Natural, New: Bool},
+			diff.Change{Kind: diff.Changed, Path: Path{{Name: "B"}}, New: Text},
+			diff.Change{Kind: diff.Removed, Path: Path{{Name: "C"}}},
+			diff.Change{Kind: diff.Added, Path: Path{{Name: "D"}}},
+		),
+		Entry("Inside Some",
+			`Some { a = 1 }`, `Some { a = 2 }`,
+			diff.Change{Kind: diff.Changed, Path: Path{{Name: "a"}}, Old: NaturalLit(1), New: NaturalLit(2)},
+		),
+	)
+})
+
+func rendered(old, new string) string {
+	return compare(old, new).String()
+}
+
+var _ = Describe("Render", func() {
+	It("Renders changed scalars", func() {
+		Expect(rendered(`1`, `2`)).To(Equal("- 1\n+ 2\n"))
+	})
+	It("Renders nested records, eliding unchanged fields", func() {
+		Expect(rendered(
+			`{ a = 1, b = { c = True, d = "x" }, e = 3 }`,
+			`{ a = 1, b = { c = False, d = "x" }, f = [ -1 ] }`,
+		)).To(Equal(strings.Join([]string{
+			"{ b = { c = - True",
+			"            + False",
+			"      , …",
+			"      }",
+			", e = - 3",
+			", f = + [ -1 ]",
+			", …",
+			"}",
+			"",
+		}, "\n")))
+	})
+	It("Renders Lists", func() {
+		Expect(rendered(`[ "a", "b" ]`, `[ "a", "c\"" ]`)).
+			To(Equal("[ - \"b\"\n  + \"c\\\"\"\n, …\n]\n"))
+	})
+	It("Renders union types and alternatives", func() {
+		Expect(rendered(`< A : Natural | B >`, `< A : Natural | C >`)).
+			To(Equal("< - B\n| + C\n| …\n>\n"))
+		Expect(rendered(`< A : Natural >.A 1`, `< A : Natural >.A 2`)).
+			To(Equal("….A - 1\n    + 2\n"))
+	})
+	It("Colors added and removed values", func() {
+		var out strings.Builder
+		Expect(compare(`{ a = 1 }`, `{ a = 2 }`).Render(&out, true)).To(Succeed())
+		Expect(out.String()).To(Equal(
+			"{ a = \x1b[31m- 1\x1b[0m\n      \x1b[32m+ 2\x1b[0m\n}\n"))
+	})
+})
@@ -0,0 +1,6 @@
+/*
+Package diff finds the semantic differences between two Dhall
+expressions, by comparing their normal forms rather than their
+source text.
+*/
+package diff
@@ -0,0 +1,261 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/philandstuff/dhall-golang/core"
+)
+
+// ANSI escape codes used by Render
+const (
+	red   = "\x1b[31m"
+	green = "\x1b[32m"
+	reset = "\x1b[0m"
+)
+
+// Render writes d to w in Dhall-like syntax, in the style of
+// Haskell's `dhall diff`.  Unchanged parts of records, unions and
+// Lists are shown as …, removed values are marked with - and added
+// values with +.  If color is true, removed and added values are
+// colored red and green with ANSI escape codes.  Render writes
+// nothing for an Empty Diff.
+func (d *Diff) Render(w io.Writer, color bool) error {
+	if d.root == nil {
+		return nil
+	}
+	for _, l := range render(d.root) {
+		body := l.body
+		if color {
+			switch l.mark {
+			case '-':
+				body = red + body + reset
+			case '+':
+				body = green + body + reset
+			}
+		}
+		if _, err := fmt.Fprintln(w, l.prefix+body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Diff) String() string {
+	var str strings.Builder
+	d.Render(&str, false)
+	return str.String()
+}
+
+// A line is one line of rendered output.  mark is '-' or '+' if body
+// is a removed or added value.
+type line struct {
+	prefix string
+	body   string
+	mark   byte
+}
+
+// indent prefixes the first of lines with first, and the rest with
+// enough spaces to line them up
+func indent(first string, lines []line) []line {
+	rest := strings.Repeat(" ", utf8.RuneCountInString(first))
+	result := make([]line, len(lines))
+	for i, l := range lines {
+		if i == 0 {
+			l.prefix = first + l.prefix
+		} else {
+			l.prefix = rest + l.prefix
+		}
+		result[i] = l
+	}
+	return result
+}
+
+func render(n *node) []line {
+	switch n.kind {
+	case leaf:
+		return renderChange(n.change, "")
+	case some:
+		return indent("Some ", render(n.entries[0].node))
+	case alternative:
+		return indent("…."+label(n.name)+" ", render(n.entries[0].node))
+	}
+	open, sep, close, labelSep := "{ ", ", ", "}", " = "
+	switch n.kind {
+	case recordType:
+		labelSep = " : "
+	case unionType:
+		open, sep, close, labelSep = "< ", "| ", ">", " : "
+	case list:
+		open, close, labelSep = "[ ", "]", ""
+	}
+	var lines []line
+	for i, e := range n.entries {
+		start := sep
+		if i == 0 {
+			start = open
+		}
+		var sub []line
+		if n.kind == unionType && e.node.kind == leaf {
+			// union alternatives may not have payloads, so
+			// we mark the whole alternative
+			sub = renderChange(e.node.change, label(e.label))
+		} else {
+			if n.kind != list {
+				start += label(e.label) + labelSep
+			}
+			sub = render(e.node)
+		}
+		lines = append(lines, indent(start, sub)...)
+	}
+	if n.elided {
+		lines = append(lines, line{prefix: sep, body: "…"})
+	}
+	return append(lines, line{body: close})
+}
+
+// renderChange renders c.  If name is not empty, c is a change to the
+// union alternative with that name.
+func renderChange(c *Change, name string) []line {
+	show := func(t core.Term) string {
+		if name == "" {
+			return format(t)
+		}
+		if t == nil {
+			return name
+		}
+		return name + " : " + format(t)
+	}
+	var lines []line
+	if c.Kind != Added {
+		lines = append(lines, line{body: "- " + show(c.Old), mark: '-'})
+	}
+	if c.Kind != Removed {
+		lines = append(lines, line{body: "+ " + show(c.New), mark: '+'})
+	}
+	return lines
+}
+
+var simpleLabel = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_/-]*$`)
+
+func label(name string) string {
+	if simpleLabel.MatchString(name) {
+		return name
+	}
+	return "`" + name + "`"
+}
+
+// format renders a normal form on a single line in Dhall syntax
+func format(t core.Term) string {
+	switch t := t.(type) {
+	case core.BoolLit:
+		if t {
+			return "True"
+		}
+		return "False"
+	case core.IntegerLit:
+		if t >= 0 {
+			return fmt.Sprintf("+%d", int(t))
+		}
+		return fmt.Sprintf("%d", int(t))
+	case core.TextLitTerm:
+		var str strings.Builder
+		str.WriteByte('"')
+		for _, chunk := range t.Chunks {
+			str.WriteString(escapeText(chunk.Prefix))
+			str.WriteString("${")
+			str.WriteString(format(chunk.Expr))
+			str.WriteString("}")
+		}
+		str.WriteString(escapeText(t.Suffix))
+		str.WriteByte('"')
+		return str.String()
+	case core.EmptyList:
+		return "[] : " + format(t.Type)
+	case core.NonEmptyList:
+		elems := make([]string, len(t))
+		for i, elem := range t {
+			elems[i] = format(elem)
+		}
+		return "[ " + strings.Join(elems, ", ") + " ]"
+	case core.Some:
+		return "Some " + formatArg(t.Val)
+	case core.RecordLit:
+		if len(t) == 0 {
+			return "{=}"
+		}
+		return formatFields("{ ", ", ", " }", " = ", t)
+	case core.RecordType:
+		if len(t) == 0 {
+			return "{}"
+		}
+		return formatFields("{ ", ", ", " }", " : ", t)
+	case core.UnionType:
+		if len(t) == 0 {
+			return "<>"
+		}
+		return formatFields("< ", " | ", " >", " : ", t)
+	case core.Field:
+		return formatArg(t.Record) + "." + label(t.FieldName)
+	case core.AppTerm:
+		return format(t.Fn) + " " + formatArg(t.Arg)
+	}
+	return fmt.Sprint(t)
+}
+
+// formatArg is like format, but adds parentheses if t is not atomic
+func formatArg(t core.Term) string {
+	switch t := t.(type) {
+	case core.AppTerm, core.Some, core.EmptyList, core.LambdaTerm,
+		core.PiTerm, core.OpTerm:
+		return "(" + format(t) + ")"
+	case core.IntegerLit, core.DoubleLit:
+		if s := format(t); strings.HasPrefix(s, "-") {
+			return "(" + s + ")"
+		}
+	}
+	return format(t)
+}
+
+func formatFields(open, sep, close, labelSep string, fields map[string]core.Term) string {
+	var names []string
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	items := make([]string, len(names))
+	for i, name := range names {
+		items[i] = label(name)
+		if fields[name] != nil {
+			items[i] += labelSep + format(fields[name])
+		}
+	}
+	return open + strings.Join(items, sep) + close
+}
+
+func escapeText(s string) string {
+	var str strings.Builder
+	for i, r := range s {
+		switch {
+		case r == '"':
+			str.WriteString(`\"`)
+		case r == '\\':
+			str.WriteString(`\\`)
+		case r == '$' && strings.HasPrefix(s[i:], "${"):
+			str.WriteString(`\$`)
+		case r == '\n':
+			str.WriteString(`\n`)
+		case r == '\t':
+			str.WriteString(`\t`)
+		case r < 0x20:
+			fmt.Fprintf(&str, `\u%04X`, r)
+		default:
+			str.WriteRune(r)
+		}
+	}
+	return str.String()
+}
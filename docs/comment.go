@@ -0,0 +1,156 @@
+package docs
+
+import (
+	"strings"
+)
+
+// scanComments returns the comments in header, the source before a
+// file's expression, which may begin with shebang lines.
+func scanComments(header string) []string {
+	i := 0
+	for strings.HasPrefix(header[i:], "#!") {
+		end := strings.IndexByte(header[i:], '\n')
+		if end < 0 {
+			return nil
+		}
+		i += end + 1
+	}
+	comments, _ := scan(header, i)
+	return comments
+}
+
+// commentsBefore returns the comments in gap, the source before the
+// name bound by a let binding, from the end of the previous binding's
+// value or from the binding's `let`, and the offset of the `let`.  If
+// the let is the body of another, only the comments after the other
+// one's `in` are returned.
+func commentsBefore(gap string) ([]string, int) {
+	comments, end := scan(gap, 0)
+	if strings.HasPrefix(gap[end:], "in") {
+		comments, end = scan(gap, end+len("in"))
+	}
+	more, _ := scan(gap, end+len("let"))
+	return append(comments, more...), end
+}
+
+// scan returns the comments in s from offset i up to the first thing
+// which is neither whitespace nor a comment, and the offset of that
+// thing.  Line comments on consecutive lines are returned as one.
+func scan(s string, i int) ([]string, int) {
+	var comments []string
+	// lines counts the line breaks since the last comment, if it was
+	// a line comment
+	lines := -1
+	for i < len(s) {
+		switch {
+		case s[i] == ' ' || s[i] == '\t' || s[i] == '\r':
+			i++
+		case s[i] == '\n':
+			if lines >= 0 {
+				lines++
+			}
+			i++
+		case strings.HasPrefix(s[i:], "--"):
+			end := strings.IndexByte(s[i:], '\n')
+			if end < 0 {
+				end = len(s) - i
+			}
+			comment := strings.TrimRight(s[i:i+end], "\r")
+			if lines == 1 {
+				comments[len(comments)-1] += "\n" + comment
+			} else {
+				comments = append(comments, comment)
+			}
+			lines = 0
+			i += end
+		case strings.HasPrefix(s[i:], "{-"):
+			start := i
+			depth := 0
+			for i < len(s) {
+				if strings.HasPrefix(s[i:], "{-") {
+					depth++
+					i += 2
+				} else if strings.HasPrefix(s[i:], "-}") {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					i++
+				}
+			}
+			comments = append(comments, s[start:i])
+			lines = -1
+		default:
+			return comments, i
+		}
+	}
+	return comments, i
+}
+
+// docComment returns the text of the doc comments among comments:
+// those which begin with `{-|` if there are any, otherwise all of
+// them.
+func docComment(comments []string) string {
+	var docs []string
+	for _, c := range comments {
+		if strings.HasPrefix(c, "{-|") {
+			docs = append(docs, c)
+		}
+	}
+	if len(docs) == 0 {
+		docs = comments
+	}
+	var texts []string
+	for _, c := range docs {
+		if text := commentText(c); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// commentText returns the text of a comment, without its delimiters
+// and the indentation common to its lines.
+func commentText(comment string) string {
+	var lines []string
+	if strings.HasPrefix(comment, "--") {
+		for _, line := range strings.Split(comment, "\n") {
+			line = strings.TrimPrefix(line, "--")
+			lines = append(lines, strings.TrimPrefix(line, " "))
+		}
+	} else {
+		comment = strings.TrimPrefix(comment, "{-")
+		comment = strings.TrimPrefix(comment, "|")
+		comment = strings.TrimSuffix(comment, "-}")
+		lines = strings.Split(comment, "\n")
+		// the first line follows the `{-`, so it isn't indented
+		lines[0] = strings.TrimLeft(lines[0], " \t")
+		indent := -1
+		for _, line := range lines[1:] {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			n := len(line) - len(strings.TrimLeft(line, " \t"))
+			if indent < 0 || n < indent {
+				indent = n
+			}
+		}
+		for k := 1; k < len(lines); k++ {
+			if len(lines[k]) >= indent && indent > 0 {
+				lines[k] = lines[k][indent:]
+			}
+		}
+	}
+	for k, line := range lines {
+		lines[k] = strings.TrimRight(line, " \t\r")
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
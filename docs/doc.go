@@ -0,0 +1,18 @@
+/*
+Package docs generates documentation for a package of Dhall files,
+in the manner of dhall-docs: a static site, in HTML or Markdown, with
+a page for each file.
+
+Load reads the Dhall files in a directory.  For each file, it finds
+the leading doc comment, the type of the file's expression, the
+let bindings which the file begins with, each with its own doc
+comment and type, and the files which it imports.  Package.Write
+writes the site, linking each file to the files it imports and the
+files which import it.
+
+A doc comment is the comments just before the expression or the let
+binding it documents.  If any of them begins with `{-|`, as
+dhall-docs requires, only those are used, so that a licence header
+can be left out of the documentation.
+*/
+package docs
@@ -0,0 +1,255 @@
+package docs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/imports"
+	"github.com/philandstuff/dhall-golang/parser"
+)
+
+// A Package is the documentation of a directory of Dhall files.
+type Package struct {
+	// Files are the Dhall files in the package, sorted by Path.
+	Files []*File
+}
+
+// A File is the documentation of a Dhall file.
+type File struct {
+	// Path is the slash-separated path of the file, relative to the
+	// directory of the package.
+	Path string
+	// Comment is the doc comment at the start of the file, without
+	// the comment delimiters.
+	Comment string
+	// Type is the type of the file's expression, or nil if it
+	// doesn't parse, its imports can't be resolved or it doesn't
+	// typecheck.
+	Type core.Term
+	// Exports are the let bindings which the file's expression
+	// begins with, in order.
+	Exports []Export
+	// Imports are the files, URLs and environment variables which
+	// the file imports, in the order they first appear.
+	Imports []Import
+	// ImportedBy are the Paths of the files in the package which
+	// import this one.
+	ImportedBy []string
+	// Source is the content of the file.
+	Source string
+	// Err is the reason why Type is nil.
+	Err error
+}
+
+// An Export is a let binding which a file's expression begins with.
+type Export struct {
+	Name string
+	// Comment is the doc comment before the binding.
+	Comment string
+	// Type is the type of the bound value, or nil if it can't be
+	// found.
+	Type core.Term
+	// Source is the binding as it is written, from `let` to the end
+	// of the value.
+	Source string
+}
+
+// An Import is something which a file imports.
+type Import struct {
+	// Location is the import as it is written, without any hash or
+	// import mode.
+	Location string
+	// Path is the Path of the imported file, if it is in the
+	// package.
+	Path string
+	// Remote is true if Location is a URL.
+	Remote bool
+
+	// target is the absolute slash-separated path of a local import
+	target string
+}
+
+// Load reads the files with the extension .dhall in the directory
+// root and its subdirectories, other than hidden ones, and returns
+// their documentation.  A file which can't be parsed, resolved or
+// typechecked is still documented, with its Err set; Load only fails
+// if the files can't be read.
+func Load(root string) (*Package, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	p := &Package{}
+	err = filepath.Walk(abs, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if file != abs && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(file) != ".dhall" {
+			return nil
+		}
+		rel, err := filepath.Rel(abs, file)
+		if err != nil {
+			return err
+		}
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		p.Files = append(p.Files, loadFile(filepath.Join(root, rel), file, filepath.ToSlash(rel), src))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.link(filepath.ToSlash(abs))
+	return p, nil
+}
+
+// link sets the Paths of imports of files in p, whose directory is
+// the absolute slash-separated path dir, and the files' ImportedBy.
+func (p *Package) link(dir string) {
+	files := make(map[string]*File)
+	for _, f := range p.Files {
+		files[f.Path] = f
+	}
+	for _, f := range p.Files {
+		for k := range f.Imports {
+			i := &f.Imports[k]
+			if !strings.HasPrefix(i.target, dir+"/") {
+				continue
+			}
+			imported, ok := files[i.target[len(dir)+1:]]
+			if !ok {
+				continue
+			}
+			i.Path = imported.Path
+			// files are visited in order, so a file which imports
+			// another twice is the last to have imported it
+			if n := len(imported.ImportedBy); n == 0 || imported.ImportedBy[n-1] != f.Path {
+				imported.ImportedBy = append(imported.ImportedBy, f.Path)
+			}
+		}
+	}
+}
+
+// loadFile returns the documentation of src, the content of the file
+// with the absolute path file.  name is the file's name in errors.
+func loadFile(name, file, rel string, src []byte) *File {
+	f := &File{Path: rel, Source: string(src)}
+	n, err := parser.ParseNode(name, src)
+	if err != nil {
+		f.Err = fmt.Errorf("Parse error: %w", err)
+		return f
+	}
+	f.Comment = docComment(scanComments(string(src[:n.Start])))
+	here := core.NewLocal("/", strings.Split(strings.TrimPrefix(filepath.ToSlash(file), "/"), "/"))
+	f.Imports = importsOf(n.Term, here)
+	resolved, err := imports.Load(n.Term, here)
+	if err != nil {
+		f.Err = fmt.Errorf("Import resolve error: %w", err)
+	} else if typ, err := core.TypeOf(resolved); err != nil {
+		f.Err = fmt.Errorf("Type error: %w", err)
+	} else {
+		f.Type = core.Quote(typ)
+	}
+	f.Exports = exports(src, n, resolved)
+	return f
+}
+
+// importsOf returns the imports in t, a file's expression which is
+// imported from here.
+func importsOf(t core.Term, here core.Local) []Import {
+	var result []Import
+	seen := make(map[string]bool)
+	core.Walk(t, func(t core.Term) bool {
+		i, ok := t.(core.Import)
+		if !ok {
+			return true
+		}
+		var imp Import
+		switch fetchable := i.Fetchable.(type) {
+		case core.Local:
+			imp.Location = fetchable.String()
+			if target, err := fetchable.ChainOnto(here); err == nil {
+				if local := target.(core.Local); local.IsAbs() {
+					imp.target = string(local)
+				}
+			}
+		case core.Remote:
+			imp.Location = fetchable.String()
+			imp.Remote = true
+		case core.EnvVar:
+			imp.Location = fetchable.String()
+		default:
+			return false
+		}
+		if !seen[imp.Location] {
+			seen[imp.Location] = true
+			result = append(result, imp)
+		}
+		return false
+	})
+	return result
+}
+
+// exports returns the let bindings which the expression of n, parsed
+// from src, begins with.  resolved is the expression with its imports
+// resolved, which is used to find the types of the bindings, or nil
+// if they can't be resolved.
+func exports(src []byte, n *parser.Node, resolved core.Term) []Export {
+	var result []Export
+	// scope are the resolved bindings of the enclosing lets
+	var scope []core.Binding
+	// gap is where the source before the next binding's name
+	// begins; the comments before the first `let` are the file's
+	gap := n.Start
+	for {
+		bindings, body, ok := n.LetBindings()
+		if !ok {
+			return result
+		}
+		let := n.Term.(core.Let)
+		rlet, typed := resolved.(core.Let)
+		typed = typed && len(rlet.Bindings) == len(let.Bindings)
+		for k, b := range let.Bindings {
+			if k > 0 {
+				gap = bindings[k-1].Value.End
+			}
+			comments, start := commentsBefore(string(src[gap:bindings[k].Binder.Start]))
+			start += gap
+			e := Export{
+				Name:    b.Variable,
+				Comment: docComment(comments),
+				Source:  string(src[start:bindings[k].Value.End]),
+			}
+			if typed {
+				t := core.Let{
+					Bindings: append(scope[:len(scope):len(scope)], rlet.Bindings[:k+1]...),
+					Body:     core.Var{Name: b.Variable},
+				}
+				if typ, err := core.TypeOf(t); err == nil {
+					e.Type = core.Quote(typ)
+				}
+			}
+			result = append(result, e)
+		}
+		gap = bindings[len(bindings)-1].Value.End
+		n = body
+		if typed {
+			scope = append(scope, rlet.Bindings...)
+			resolved = rlet.Body
+		} else {
+			resolved = nil
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package docs_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/docs"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// writeFiles writes files, keyed by slash-separated path, to dir.
+func writeFiles(dir string, files map[string]string) {
+	for path, content := range files {
+		file := filepath.Join(dir, filepath.FromSlash(path))
+		Expect(os.MkdirAll(filepath.Dir(file), 0777)).To(Succeed())
+		Expect(ioutil.WriteFile(file, []byte(content), 0666)).To(Succeed())
+	}
+}
+
+// readFile returns the content of the file at the slash-separated
+// path in dir.
+func readFile(dir, path string) string {
+	b, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+	Expect(err).ToNot(HaveOccurred())
+	return string(b)
+}
+
+var _ = Describe("Load", func() {
+	var dir string
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "docs")
+		Expect(err).ToNot(HaveOccurred())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	// load loads a package of a single file with the given source.
+	load := func(source string) *docs.File {
+		writeFiles(dir, map[string]string{"a.dhall": source})
+		p, err := docs.Load(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.Files).To(HaveLen(1))
+		return p.Files[0]
+	}
+
+	DescribeTable("finds the doc comment of a file",
+		func(source, expected string) {
+			Expect(load(source).Comment).To(Equal(expected))
+		},
+		Entry("with none", `1`, ""),
+		Entry("in a line comment", "-- One\n1", "One"),
+		Entry("in consecutive line comments", "-- One\n--   two\n--\n-- three\n1", "One\n  two\n\nthree"),
+		Entry("in separate line comments", "-- One\n\n-- Two\n1", "One\n\nTwo"),
+		Entry("in a block comment", "{- One -}\n1", "One"),
+		Entry("in an indented block comment", "{-|\n    One\n      two\n-}\n1", "One\n  two"),
+		Entry("in a nested block comment", "{- One {- two -} -}\n1", "One {- two -}"),
+		Entry("after a shebang", "#!/usr/bin/env dhall\n-- One\n1", "One"),
+		Entry("preferring {-| comments",
+			"-- Copyright\n{-| One -}\n-- Not this\n{-| Two -}\n1", "One\n\nTwo"),
+	)
+
+	It("finds the type of a file", func() {
+		Expect(load(`{ a = 1 }`).Type).To(Equal(RecordType{"a": Natural}))
+		Expect(load(`Natural`).Type).To(Equal(Type))
+	})
+
+	It("finds the let bindings of a file", func() {
+		f := load(`{-| A package -}
+let a = 1
+
+-- B is a type
+let B
+    : Type
+    = Bool
+
+in  let -- c is a function
+        c = λ(x : B) → x
+
+    in  { a, B, c }
+`)
+		Expect(f.Err).ToNot(HaveOccurred())
+		Expect(f.Comment).To(Equal("A package"))
+		Expect(f.Exports).To(Equal([]docs.Export{
+			{Name: "a", Type: Natural, Source: "let a = 1"},
+			{Name: "B", Comment: "B is a type", Type: Type, Source: "let B\n    : Type\n    = Bool"},
+			{Name: "c", Comment: "c is a function", Type: NewPi("x", Bool, Bool), Source: "let -- c is a function\n        c = λ(x : B) → x"},
+		}))
+	})
+
+	It("documents files which don't typecheck", func() {
+		f := load("let a = 1 + True in a")
+		Expect(f.Err).To(MatchError(HavePrefix("Type error: ")))
+		Expect(f.Type).To(BeNil())
+		Expect(f.Exports).To(Equal([]docs.Export{{Name: "a", Source: "let a = 1 + True"}}))
+	})
+
+	It("documents files which don't parse", func() {
+		f := load("let a = ")
+		Expect(f.Err).To(MatchError(HavePrefix("Parse error: ")))
+		Expect(f.Source).To(Equal("let a = "))
+	})
+
+	It("links the files which import each other", func() {
+		writeFiles(dir, map[string]string{
+			"package.dhall":       "{ A = ./types/A.dhall, B = ./types/B.dhall, home = env:HOME as Text ? ./types/A.dhall }",
+			"types/A.dhall":       "Bool",
+			"types/B.dhall":       "{ a : ./A.dhall, c : ../missing.dhall ? https://example.com/c.dhall }",
+			".hidden/C.dhall":     "./types/A.dhall",
+			"types/not-dhall.txt": "hello",
+		})
+		p, err := docs.Load(dir)
+		Expect(err).ToNot(HaveOccurred())
+		var paths []string
+		for _, f := range p.Files {
+			paths = append(paths, f.Path)
+		}
+		Expect(paths).To(Equal([]string{"package.dhall", "types/A.dhall", "types/B.dhall"}))
+		Expect(p.Files[0].Imports).To(HaveLen(3))
+		Expect(p.Files[0].Imports[0].Location).To(Equal("./types/A.dhall"))
+		Expect(p.Files[0].Imports[0].Path).To(Equal("types/A.dhall"))
+		Expect(p.Files[0].Imports[1].Path).To(Equal("types/B.dhall"))
+		Expect(p.Files[0].Imports[2].Location).To(Equal("env:HOME"))
+		Expect(p.Files[0].Imports[2].Path).To(Equal(""))
+		Expect(p.Files[1].ImportedBy).To(Equal([]string{"package.dhall", "types/B.dhall"}))
+		b := p.Files[2]
+		Expect(b.Imports).To(HaveLen(3))
+		Expect(b.Imports[0].Path).To(Equal("types/A.dhall"))
+		Expect(b.Imports[1].Location).To(Equal("../missing.dhall"))
+		Expect(b.Imports[1].Path).To(Equal(""))
+		Expect(b.Imports[2].Remote).To(BeTrue())
+		Expect(b.ImportedBy).To(Equal([]string{"package.dhall"}))
+	})
+})
+
+var _ = Describe("Write", func() {
+	var dir, site string
+	var p *docs.Package
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "docs")
+		Expect(err).ToNot(HaveOccurred())
+		site = filepath.Join(dir, "site")
+		writeFiles(filepath.Join(dir, "package"), map[string]string{
+			"package.dhall": "{-| The <package> -}\nlet A = ./types/A.dhall in { A }",
+			"types/A.dhall": "-- An A\n{ a : Text }",
+		})
+		p, err = docs.Load(filepath.Join(dir, "package"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("writes an HTML site", func() {
+		Expect(p.Write(site, "Schemas", docs.HTML)).To(Succeed())
+		index := readFile(site, "index.html")
+		Expect(index).To(ContainSubstring("<h1>Schemas</h1>"))
+		Expect(index).To(ContainSubstring(`<a href="package.dhall.html">package.dhall</a> – The &lt;package&gt;`))
+		Expect(index).To(ContainSubstring(`<a href="types/A.dhall.html">types/A.dhall</a> – An A`))
+		pkg := readFile(site, "package.dhall.html")
+		Expect(pkg).To(ContainSubstring("<pre><code>{ A : Type }</code></pre>"))
+		Expect(pkg).To(ContainSubstring("<pre><code>A : Type</code></pre>"))
+		Expect(pkg).To(ContainSubstring(`<a href="types/A.dhall.html">./types/A.dhall</a>`))
+		a := readFile(site, "types/A.dhall.html")
+		Expect(a).To(ContainSubstring(`<a href="../index.html">Schemas</a>`))
+		Expect(a).To(ContainSubstring(`<a href="../package.dhall.html">package.dhall</a>`))
+	})
+
+	It("writes a Markdown site", func() {
+		Expect(p.Write(site, "Schemas", docs.Markdown)).To(Succeed())
+		index := readFile(site, "index.md")
+		Expect(index).To(Equal("# Schemas\n\n- [package.dhall](package.dhall.md): The <package>\n- [types/A.dhall](types/A.dhall.md): An A\n"))
+		pkg := readFile(site, "package.dhall.md")
+		Expect(pkg).To(ContainSubstring("## Type\n\n```dhall\n{ A : Type }\n```\n"))
+		Expect(pkg).To(ContainSubstring("### A\n\n```dhall\nA : Type\n```\n\n```dhall\nlet A = ./types/A.dhall\n```\n"))
+		Expect(pkg).To(ContainSubstring("- [./types/A.dhall](types/A.dhall.md)\n"))
+		a := readFile(site, "types/A.dhall.md")
+		Expect(a).To(HavePrefix("[Schemas](../index.md)\n\n# types/A.dhall\n\nAn A\n"))
+		Expect(a).To(ContainSubstring("## Imported by\n\n- [package.dhall](../package.dhall.md)\n"))
+	})
+})
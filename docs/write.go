@@ -0,0 +1,235 @@
+package docs
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/printer"
+)
+
+// A Format is a kind of documentation site.
+type Format int
+
+// The formats which Package.Write can write.
+const (
+	HTML Format = iota
+	Markdown
+)
+
+// extension returns the extension of pages in format.
+func (format Format) extension() string {
+	if format == Markdown {
+		return ".md"
+	}
+	return ".html"
+}
+
+// A template is an html/template or a text/template.
+type template interface {
+	ExecuteTemplate(w io.Writer, name string, data interface{}) error
+}
+
+// pageData is what a page is made from.
+type pageData struct {
+	Title string
+	*Package
+	// File is the file which the page is about, or nil for the index
+	File *File
+}
+
+// Write writes a site documenting p to the directory dir, creating it
+// if it doesn't exist.  There is an index page listing the files, and
+// for each file, a page with the name of the file and the extension
+// of format added, such as `schemas/Person.dhall.html`.  title is the
+// title of the index page.
+func (p *Package) Write(dir, title string, format Format) error {
+	funcs := map[string]interface{}{
+		"page": func(path string) string {
+			return pageLink(path, format)
+		},
+		"link": func(from, to string) string {
+			return strings.Repeat("../", strings.Count(from, "/")) + pageLink(to, format)
+		},
+		"dhall":     dhall,
+		"signature": signature,
+		"summary":   summary,
+		"code":      code,
+	}
+	var t template
+	if format == Markdown {
+		t = texttemplate.Must(texttemplate.New("").Funcs(funcs).Parse(markdownTemplates))
+	} else {
+		t = htmltemplate.Must(htmltemplate.New("").Funcs(funcs).Parse(htmlTemplates))
+	}
+	if err := writePage(t, filepath.Join(dir, "index"+format.extension()), "index", pageData{title, p, nil}); err != nil {
+		return err
+	}
+	for _, f := range p.Files {
+		file := filepath.Join(dir, filepath.FromSlash(f.Path)+format.extension())
+		if err := writePage(t, file, "file", pageData{title, p, f}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writePage writes the page made by the named template to file.
+func writePage(t template, file, name string, data pageData) error {
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0777); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, buf.Bytes(), 0666)
+}
+
+// pageLink returns the link to the page of the file at path from the
+// index, or to the index if path is "".
+func pageLink(path string, format Format) string {
+	if path == "" {
+		return "index" + format.extension()
+	}
+	segments := strings.Split(path, "/")
+	for k, s := range segments {
+		segments[k] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/") + format.extension()
+}
+
+// dhall returns t as Dhall source, or "" if t is nil.
+func dhall(t core.Term) string {
+	if t == nil {
+		return ""
+	}
+	return printer.Sprint(t)
+}
+
+// signature returns `name : typ` as Dhall source, or "" if typ is
+// nil.
+func signature(name string, typ core.Term) string {
+	if typ == nil {
+		return ""
+	}
+	return printer.Sprint(core.Annot{Expr: core.Var{Name: name}, Annotation: typ})
+}
+
+// summary returns the first paragraph of a doc comment, on one line.
+func summary(comment string) string {
+	paragraph := strings.SplitN(comment, "\n\n", 2)[0]
+	return strings.Join(strings.Fields(paragraph), " ")
+}
+
+// code returns a fenced Markdown code block of source in the given
+// language.
+func code(language, source string) string {
+	fence := "```"
+	for strings.Contains(source, fence) {
+		fence += "`"
+	}
+	return fence + language + "\n" + strings.TrimRight(source, "\n") + "\n" + fence
+}
+
+const htmlTemplates = `
+{{define "head"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.}}</title>
+<style>
+body { font-family: sans-serif; max-width: 60em; margin: auto; padding: 1em; }
+pre { background: #f4f4f4; padding: 0.5em; overflow-x: auto; }
+.comment { white-space: pre-wrap; }
+.error { color: #b00; }
+</style>
+</head>
+<body>
+{{end}}
+
+{{define "index"}}{{template "head" .Title}}<h1>{{.Title}}</h1>
+<ul>
+{{range .Files}}<li><a href="{{page .Path}}">{{.Path}}</a>{{with summary .Comment}} – {{.}}{{end}}</li>
+{{end}}</ul>
+</body>
+</html>
+{{end}}
+
+{{define "file"}}{{template "head" .File.Path}}<p><a href="{{link .File.Path ""}}">{{.Title}}</a></p>
+<h1>{{.File.Path}}</h1>
+{{with .File.Comment}}<div class="comment">{{.}}</div>
+{{end}}{{with .File.Err}}<h2>Error</h2>
+<pre class="error">{{.}}</pre>
+{{end}}{{with dhall .File.Type}}<h2>Type</h2>
+<pre><code>{{.}}</code></pre>
+{{end}}{{with .File.Exports}}<h2>Exports</h2>
+{{range .}}<h3 id="{{.Name}}">{{.Name}}</h3>
+{{with .Comment}}<div class="comment">{{.}}</div>
+{{end}}{{with signature .Name .Type}}<pre><code>{{.}}</code></pre>
+{{end}}<pre><code>{{.Source}}</code></pre>
+{{end}}{{end}}{{with .File.Imports}}<h2>Imports</h2>
+<ul>
+{{range .}}<li>{{if .Path}}<a href="{{link $.File.Path .Path}}">{{.Location}}</a>{{else if .Remote}}<a href="{{.Location}}">{{.Location}}</a>{{else}}<code>{{.Location}}</code>{{end}}</li>
+{{end}}</ul>
+{{end}}{{with .File.ImportedBy}}<h2>Imported by</h2>
+<ul>
+{{range .}}<li><a href="{{link $.File.Path .}}">{{.}}</a></li>
+{{end}}</ul>
+{{end}}<h2>Source</h2>
+<pre><code>{{.File.Source}}</code></pre>
+</body>
+</html>
+{{end}}
+`
+
+const markdownTemplates = `
+{{define "index"}}# {{.Title}}
+
+{{range .Files}}- [{{.Path}}]({{page .Path}}){{with summary .Comment}}: {{.}}{{end}}
+{{end}}{{end}}
+
+{{define "file"}}[{{.Title}}]({{link .File.Path ""}})
+
+# {{.File.Path}}
+{{with .File.Comment}}
+{{.}}
+{{end}}{{with .File.Err}}
+## Error
+
+{{code "" .Error}}
+{{end}}{{with dhall .File.Type}}
+## Type
+
+{{code "dhall" .}}
+{{end}}{{with .File.Exports}}
+## Exports
+{{range .}}
+### {{.Name}}
+{{with .Comment}}
+{{.}}
+{{end}}{{with signature .Name .Type}}
+{{code "dhall" .}}
+{{end}}
+{{code "dhall" .Source}}
+{{end}}{{end}}{{with .File.Imports}}
+## Imports
+
+{{range .}}- {{if .Path}}[{{.Location}}]({{link $.File.Path .Path}}){{else if .Remote}}<{{.Location}}>{{else}}` + "`{{.Location}}`" + `{{end}}
+{{end}}{{end}}{{with .File.ImportedBy}}
+## Imported by
+
+{{range .}}- [{{.}}]({{link $.File.Path .}})
+{{end}}{{end}}
+## Source
+
+{{code "dhall" .File.Source}}
+{{end}}
+`
@@ -1,4 +0,0 @@
-package dhall
-
-// can't think of a better place to put this magic comment for now
-//go:generate pigeon -optimize-grammar -optimize-parser -o parser/dhall.go parser/dhall.peg
@@ -2,7 +2,10 @@ package imports
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/philandstuff/dhall-golang/binary"
 	"github.com/philandstuff/dhall-golang/core"
@@ -18,6 +21,28 @@ func resolveStringAsExpr(name, content string) (Term, error) {
 	return expr.(Term), nil
 }
 
+// A Loader resolves imports.  The zero Loader does no caching, and
+// has no timeout of its own, though it still gives up on a remote
+// import whose server doesn't respond within 30 seconds.
+type Loader struct {
+	// Cache is used for saving and fetching imports which are
+	// protected by a hash.  If nil, no caching is done.
+	Cache DhallCache
+	// Timeout, if nonzero, limits how long fetching any single
+	// import may take.
+	Timeout time.Duration
+	// Policy, if not nil, restricts which imports may be fetched.
+	// Forbidden imports fail with a PolicyViolation.
+	Policy *Policy
+	// HomeDir, if set, is used in place of the user's home
+	// directory when resolving home-relative imports.
+	HomeDir string
+}
+
+// DefaultTimeout is the Timeout of the Loaders which Load, LoadWith
+// and LoadContext use.
+const DefaultTimeout = 30 * time.Second
+
 // Load takes a Term and resolves all imports
 func Load(e Term, ancestors ...Fetchable) (Term, error) {
 	return LoadWith(StandardCache{}, e, ancestors...)
@@ -26,6 +51,65 @@ func Load(e Term, ancestors ...Fetchable) (Term, error) {
 // LoadWith takes a Term and resolves all imports, using cache for
 // saving and fetching imports
 func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
+	return Loader{Cache: cache, Timeout: DefaultTimeout}.Load(context.Background(), e, ancestors...)
+}
+
+// LoadContext takes a Term and resolves all imports, using the
+// standard cache.  It gives up with an error if ctx is cancelled or
+// its deadline passes, or if any single import takes longer than
+// DefaultTimeout.
+func LoadContext(ctx context.Context, e Term, ancestors ...Fetchable) (Term, error) {
+	return Loader{Cache: StandardCache{}, Timeout: DefaultTimeout}.Load(ctx, e, ancestors...)
+}
+
+func (l Loader) cache() DhallCache {
+	if l.Cache == nil {
+		return NoCache{}
+	}
+	return l.Cache
+}
+
+// ExpandHome returns here with any leading "~" replaced by l.HomeDir,
+// or by the user's home directory if HomeDir is not set.  Fetching a
+// Local which is still relative to home fails, so all home-relative
+// paths are expanded here.
+func (l Loader) ExpandHome(here Fetchable) (Fetchable, error) {
+	local, ok := here.(Local)
+	if !ok || !local.IsRelativeToHome() {
+		return here, nil
+	}
+	home := l.HomeDir
+	if home == "" {
+		var err error
+		home, err = os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("Can't resolve %s: %v", local, err)
+		}
+	}
+	return local.ExpandHome(home), nil
+}
+
+// fetch fetches here, applying l.Timeout if set.  A Fetchable which
+// isn't a ContextFetchable can't be interrupted, so ctx is only
+// checked before fetching it.
+func (l Loader) fetch(ctx context.Context, here Fetchable, origin string) (string, error) {
+	if l.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.Timeout)
+		defer cancel()
+	}
+	if fetchable, ok := here.(ContextFetchable); ok {
+		return fetchable.FetchContext(ctx, origin)
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return here.Fetch(origin)
+}
+
+// Load takes a Term and resolves all imports.  It gives up with an
+// error if ctx is cancelled or its deadline passes.
+func (l Loader) Load(ctx context.Context, e Term, ancestors ...Fetchable) (Term, error) {
 	switch e := e.(type) {
 	case Import:
 		here := e.Fetchable
@@ -48,14 +132,24 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 				return nil, fmt.Errorf("Detected import cycle in %s", ancestor)
 			}
 		}
+		target, err := l.ExpandHome(here)
+		if err != nil {
+			return nil, err
+		}
+		if err := l.Policy.Check(target, e.Hash); err != nil {
+			return nil, err
+		}
 		if e.Hash != nil {
 			// fetch from cache if available
-			if expr := cache.Fetch(e.Hash); expr != nil {
+			if expr := l.cache().Fetch(e.Hash); expr != nil {
 				return expr, nil
 			}
 		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		imports := append(ancestors, here)
-		content, err := here.Fetch(origin)
+		content, err := l.fetch(ctx, target, origin)
 		if err != nil {
 			return nil, err
 		}
@@ -70,7 +164,7 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 			}
 
 			// recursively load any more imports
-			expr, err = LoadWith(cache, dynamicExpr, imports...)
+			expr, err = l.Load(ctx, dynamicExpr, imports...)
 			if err != nil {
 				return nil, err
 			}
@@ -91,239 +185,19 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 				return nil, fmt.Errorf("Failed integrity check: expected %x but saw %x", e.Hash, actualHash)
 			}
 			// store in cache
-			cache.Save(actualHash, expr)
+			l.cache().Save(actualHash, expr)
 		}
 		return expr, nil
-	case LambdaTerm:
-		resolvedType, err := LoadWith(cache, e.Type, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		resolvedBody, err := LoadWith(cache, e.Body, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return LambdaTerm{
-			Label: e.Label,
-			Type:  resolvedType,
-			Body:  resolvedBody,
-		}, nil
-	case PiTerm:
-		resolvedType, err := LoadWith(cache, e.Type, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		resolvedBody, err := LoadWith(cache, e.Body, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return PiTerm{
-			Label: e.Label,
-			Type:  resolvedType,
-			Body:  resolvedBody,
-		}, nil
-	case AppTerm:
-		resolvedFn, err := LoadWith(cache, e.Fn, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		resolvedArg, err := LoadWith(cache, e.Arg, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return Apply(
-			resolvedFn,
-			resolvedArg,
-		), nil
-	case Let:
-		newBindings := make([]Binding, len(e.Bindings))
-		for i, binding := range e.Bindings {
-			var err error
-			newBindings[i].Variable = binding.Variable
-			if binding.Annotation != nil {
-				newBindings[i].Annotation, err = LoadWith(cache, binding.Annotation, ancestors...)
-				if err != nil {
-					return nil, err
-				}
-			}
-			newBindings[i].Value, err = LoadWith(cache, binding.Value, ancestors...)
-			if err != nil {
-				return nil, err
-			}
-		}
-		resolvedBody, err := LoadWith(cache, e.Body, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return Let{Bindings: newBindings, Body: resolvedBody}, nil
-	case Annot:
-		resolvedExpr, err := LoadWith(cache, e.Expr, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		resolvedAnnotation, err := LoadWith(cache, e.Annotation, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return Annot{Expr: resolvedExpr, Annotation: resolvedAnnotation}, nil
-	case TextLitTerm:
-		var newChunks Chunks
-		for _, chunk := range e.Chunks {
-			resolvedExpr, err := LoadWith(cache, chunk.Expr, ancestors...)
-			if err != nil {
-				return nil, err
-			}
-			newChunks = append(newChunks, Chunk{
-				Prefix: chunk.Prefix,
-				Expr:   resolvedExpr,
-			})
-		}
-		return TextLitTerm{newChunks, e.Suffix}, nil
-	case IfTerm:
-		resolvedCond, err := LoadWith(cache, e.Cond, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		resolvedT, err := LoadWith(cache, e.T, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		resolvedF, err := LoadWith(cache, e.F, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return IfTerm{
-			Cond: resolvedCond,
-			T:    resolvedT,
-			F:    resolvedF,
-		}, nil
 	case OpTerm:
 		if e.OpCode == ImportAltOp {
-			resolvedL, err := LoadWith(cache, e.L, ancestors...)
+			resolvedL, err := l.Load(ctx, e.L, ancestors...)
 			if err == nil {
 				return resolvedL, nil
 			}
-			resolvedR, err := LoadWith(cache, e.R, ancestors...)
-			if err != nil {
-				return nil, err
-			}
-			return resolvedR, nil
-		}
-		resolvedL, err := LoadWith(cache, e.L, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		resolvedR, err := LoadWith(cache, e.R, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return OpTerm{OpCode: e.OpCode, L: resolvedL, R: resolvedR}, nil
-	case EmptyList:
-		resolvedType, err := LoadWith(cache, e.Type, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return EmptyList{Type: resolvedType}, nil
-	case NonEmptyList:
-		newList := make(NonEmptyList, len(e))
-		for i, item := range e {
-			var err error
-			newList[i], err = LoadWith(cache, item, ancestors...)
-			if err != nil {
-				return nil, err
-			}
-		}
-		return newList, nil
-	case Some:
-		val, err := LoadWith(cache, e.Val, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return Some{val}, nil
-	case RecordType:
-		newRecord := make(RecordType, len(e))
-		for k, v := range e {
-			var err error
-			newRecord[k], err = LoadWith(cache, v, ancestors...)
-			if err != nil {
-				return nil, err
-			}
-		}
-		return newRecord, nil
-	case RecordLit:
-		newRecord := make(RecordLit, len(e))
-		for k, v := range e {
-			var err error
-			newRecord[k], err = LoadWith(cache, v, ancestors...)
-			if err != nil {
-				return nil, err
-			}
-		}
-		return newRecord, nil
-	case ToMap:
-		record, err := LoadWith(cache, e.Record, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		typ, err := LoadWith(cache, e.Type, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return ToMap{Record: record, Type: typ}, nil
-	case Field:
-		newRecord, err := LoadWith(cache, e.Record, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return Field{Record: newRecord, FieldName: e.FieldName}, nil
-	case Project:
-		newRecord, err := LoadWith(cache, e.Record, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return Project{Record: newRecord, FieldNames: e.FieldNames}, nil
-	case ProjectType:
-		record, err := LoadWith(cache, e.Record, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		typ, err := LoadWith(cache, e.Selector, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return ProjectType{Record: record, Selector: typ}, nil
-	case UnionType:
-		result := make(UnionType, len(e))
-		for k, v := range e {
-			var err error
-			if v == nil {
-				result[k] = nil
-				continue
-			}
-			result[k], err = LoadWith(cache, v, ancestors...)
-			if err != nil {
-				return nil, err
-			}
-		}
-		return result, nil
-	case Merge:
-		handler, err := LoadWith(cache, e.Handler, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		union, err := LoadWith(cache, e.Union, ancestors...)
-		if err != nil {
-			return nil, err
-		}
-		return Merge{Handler: handler, Union: union}, nil
-	case Assert:
-		annot, err := LoadWith(cache, e.Annotation, ancestors...)
-		if err != nil {
-			return nil, err
+			return l.Load(ctx, e.R, ancestors...)
 		}
-		return Assert{Annotation: annot}, nil
-	default:
-		// Const, NaturalLit, etc
-		return e, nil
 	}
+	return core.RewriteChildren(e, func(t Term) (Term, error) {
+		return l.Load(ctx, t, ancestors...)
+	})
 }
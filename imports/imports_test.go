@@ -1,9 +1,13 @@
 package imports_test
 
 import (
+	"context"
+	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"time"
 
 	. "github.com/philandstuff/dhall-golang/core"
 	. "github.com/philandstuff/dhall-golang/imports"
@@ -23,6 +27,19 @@ func expectResolves(input, expected Term) {
 	Expect(actual).To(Equal(expected))
 }
 
+// plainFetchable is a Fetchable of the Dhall expression `3` which
+// isn't a ContextFetchable.
+type plainFetchable struct{}
+
+func (plainFetchable) Name() string                        { return "plain" }
+func (plainFetchable) Origin() string                      { return NullOrigin }
+func (plainFetchable) Fetch(origin string) (string, error) { return "3", nil }
+func (f plainFetchable) ChainOnto(base Fetchable) (Fetchable, error) {
+	return f, nil
+}
+func (plainFetchable) String() string   { return "plain" }
+func (plainFetchable) AsLocation() Term { return Missing{}.AsLocation() }
+
 var importFooAsText = NewEnvVarImport("FOO", RawText)
 var resolvedFooAsText = TextLitTerm{Suffix: "abcd"}
 
@@ -178,6 +195,194 @@ var _ = Describe("Import resolution", func() {
 			})
 		})
 	})
+	Describe("cancellation and timeouts", func() {
+		var server *ghttp.Server
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+			server.RouteToHandler("GET", "/slow.dhall",
+				func(w http.ResponseWriter, r *http.Request) {
+					select {
+					case <-time.After(5 * time.Second):
+					case <-r.Context().Done():
+					}
+					io.WriteString(w, "3 : Natural")
+				},
+			)
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+		It("Gives up on a slow import after the Loader's timeout", func() {
+			loader := Loader{Timeout: 50 * time.Millisecond}
+			_, err := loader.Load(context.Background(), NewRemoteImport(server.URL()+"/slow.dhall", Code))
+
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+		It("Gives up on a slow import when the context's deadline passes", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			_, err := LoadContext(ctx, NewRemoteImport(server.URL()+"/slow.dhall", Code))
+
+			Expect(err).To(HaveOccurred())
+		})
+		It("Doesn't fetch anything with an already-cancelled context", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := LoadContext(ctx, NewLocalImport("./testdata/natural.dhall", Code))
+
+			Expect(err).To(Equal(context.Canceled))
+		})
+		Describe("from a server which never responds", func() {
+			var stalled *httptest.Server
+			BeforeEach(func() {
+				stalled = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					<-r.Context().Done()
+				}))
+			})
+			AfterEach(func() {
+				stalled.Close()
+			})
+			It("Gives up after the Loader's timeout", func() {
+				loader := Loader{Timeout: 50 * time.Millisecond}
+				_, err := loader.Load(context.Background(), NewRemoteImport(stalled.URL+"/x.dhall", Code))
+
+				Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			})
+			It("Gives up when the context's deadline passes", func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+				defer cancel()
+				_, err := LoadContext(ctx, NewRemoteImport(stalled.URL+"/x.dhall", Code))
+
+				Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			})
+		})
+		It("Fetches a Fetchable which doesn't take a context", func() {
+			actual, err := Loader{}.Load(context.Background(), Import{
+				ImportHashed: ImportHashed{Fetchable: plainFetchable{}},
+				ImportMode:   Code,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(NaturalLit(3)))
+		})
+		It("Doesn't fetch a Fetchable which doesn't take a context once the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := Loader{}.Load(ctx, Import{
+				ImportHashed: ImportHashed{Fetchable: plainFetchable{}},
+				ImportMode:   Code,
+			})
+
+			Expect(err).To(Equal(context.Canceled))
+		})
+		It("Resolves imports within the timeout", func() {
+			loader := Loader{Timeout: time.Second}
+			actual, err := loader.Load(context.Background(), NewLocalImport("./testdata/natural.dhall", Code))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(Annot{Expr: NaturalLit(3), Annotation: Natural}))
+		})
+	})
+	Describe("import policy", func() {
+		expectViolation := func(policy Policy, input Term) {
+			os.Setenv("FOO", "abcd")
+			_, err := Loader{Policy: &policy}.Load(context.Background(), input)
+
+			Expect(err).To(BeAssignableToTypeOf(PolicyViolation{}))
+		}
+		expectAllowed := func(policy Policy, input Term) {
+			os.Setenv("FOO", "abcd")
+			_, err := Loader{Policy: &policy}.Load(context.Background(), input)
+
+			Expect(err).ToNot(HaveOccurred())
+		}
+		It("Denies env imports", func() {
+			expectViolation(Policy{DenyEnv: true}, importFooAsText)
+		})
+		It("Allows env imports as Location", func() {
+			expectAllowed(Policy{DenyEnv: true}, NewEnvVarImport("FOO", Location))
+		})
+		It("Allows local imports within the root", func() {
+			expectAllowed(Policy{LocalRoot: "testdata"}, NewLocalImport("./testdata/chain1.dhall", Code))
+		})
+		It("Denies local imports outside the root", func() {
+			expectViolation(Policy{LocalRoot: "testdata"}, NewLocalImport("./imports.go", RawText))
+		})
+		It("Denies local imports which escape the root via a parent", func() {
+			expectViolation(Policy{LocalRoot: "testdata"}, NewLocalImport("./testdata/../imports.go", RawText))
+		})
+		It("Checks home-relative imports against the root after expanding them", func() {
+			policy := Policy{LocalRoot: "testdata"}
+			loader := Loader{Policy: &policy, HomeDir: "testdata"}
+			_, err := loader.Load(context.Background(), NewLocalImport("~/natural.dhall", Code))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = loader.Load(context.Background(), NewLocalImport("~/../imports.go", RawText))
+			Expect(err).To(BeAssignableToTypeOf(PolicyViolation{}))
+		})
+		It("Denies remote imports", func() {
+			expectViolation(Policy{DenyRemote: true}, NewRemoteImport("https://example.com/foo.dhall", Code))
+		})
+		It("Denies plain http", func() {
+			expectViolation(Policy{DenyHTTP: true}, NewRemoteImport("http://example.com/foo.dhall", Code))
+		})
+		It("Denies schemes which aren't allowed", func() {
+			expectViolation(Policy{AllowedSchemes: []string{"https"}}, NewRemoteImport("http://example.com/foo.dhall", Code))
+		})
+		It("Denies hosts which aren't allowed", func() {
+			expectViolation(Policy{AllowedHosts: []string{"example.com"}}, NewRemoteImport("https://example.org/foo.dhall", Code))
+		})
+		It("Denies ports which aren't allowed", func() {
+			expectViolation(Policy{AllowedHosts: []string{"example.com:8443"}}, NewRemoteImport("https://example.com/foo.dhall", Code))
+		})
+		DescribeTable("Checks IPv6 hosts", func(entry string, allowed bool) {
+			remote, err := ParseRemote("https://[::1]:8443/foo.dhall")
+			Expect(err).ToNot(HaveOccurred())
+			err = (&Policy{AllowedHosts: []string{entry}}).Check(remote, nil)
+			if allowed {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(PolicyViolation{}))
+			}
+		},
+			Entry("bare address", "::1", true),
+			Entry("bracketed address", "[::1]", true),
+			Entry("bracketed address with the port", "[::1]:8443", true),
+			Entry("bracketed address with another port", "[::1]:443", false),
+			Entry("another address", "::2", false),
+		)
+		It("Denies remote imports without a hash", func() {
+			expectViolation(Policy{RequireHashes: true}, NewRemoteImport("https://example.com/foo.dhall", Code))
+		})
+		It("Names the forbidden import in the error", func() {
+			policy := Policy{DenyEnv: true}
+			_, err := Loader{Policy: &policy}.Load(context.Background(), importFooAsText)
+
+			Expect(err).To(MatchError(ContainSubstring("env:FOO")))
+		})
+		It("Checks imports of imports", func() {
+			policy := Policy{AllowedHosts: []string{"*.example.com"}}
+			_, err := Loader{Policy: &policy}.Load(context.Background(), NewLocalImport("./testdata/remote.dhall", Code))
+
+			Expect(err).To(BeAssignableToTypeOf(PolicyViolation{}))
+		})
+		Context("with a local server", func() {
+			var server *ghttp.Server
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.RouteToHandler("GET", "/foo.dhall",
+					ghttp.RespondWith(http.StatusOK, "3 : Natural"))
+			})
+			AfterEach(func() {
+				server.Close()
+			})
+			It("Allows hosts which are allowed", func() {
+				expectAllowed(Policy{AllowedHosts: []string{"127.0.0.1"}, AllowedSchemes: []string{"http"}},
+					NewRemoteImport(server.URL()+"/foo.dhall", Code))
+			})
+		})
+	})
 	Describe("local imports", func() {
 		It("Resolves as Text", func() {
 			actual, err := Load(NewLocalImport("./testdata/just_text.txt", RawText))
@@ -202,6 +407,24 @@ var _ = Describe("Import resolution", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(actual).To(Equal(NaturalPlus(NaturalLit(2), NaturalLit(2))))
 		})
+		It("Resolves home-relative paths from the Loader's HomeDir", func() {
+			actual, err := Loader{HomeDir: "testdata"}.Load(context.Background(), NewLocalImport("~/natural.dhall", Code))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(Annot{Expr: NaturalLit(3), Annotation: Natural}))
+		})
+		It("Chains imports onto home-relative paths", func() {
+			actual, err := Loader{HomeDir: "testdata"}.Load(context.Background(), NewLocalImport("~/chain1.dhall", Code))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(NaturalPlus(NaturalLit(2), NaturalLit(2))))
+		})
+		It("Resolves home-relative paths as Location", func() {
+			actual, err := Loader{HomeDir: "testdata"}.Load(context.Background(), NewLocalImport("~/foo/bar.dhall", Location))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(Apply(Field{Record: LocationType, FieldName: "Local"}, TextLitTerm{Suffix: "~/foo/bar.dhall"})))
+		})
 		It("Rejects import cycles", func() {
 			result := make(chan error)
 			go func() {
@@ -317,5 +540,21 @@ var _ = Describe("Import resolution", func() {
 			Field{Record: importFooAsText},
 			Field{Record: resolvedFooAsText},
 		),
+		Entry("Import within let binding annotation",
+			NewLet(Natural, Binding{Annotation: importFooAsText, Value: Natural}),
+			NewLet(Natural, Binding{Annotation: resolvedFooAsText, Value: Natural}),
+		),
+		Entry("Import within toMap type",
+			ToMap{Record: RecordLit{}, Type: importFooAsText},
+			ToMap{Record: RecordLit{}, Type: resolvedFooAsText},
+		),
+		Entry("Import within merge annotation",
+			Merge{Handler: RecordLit{}, Union: Natural, Annotation: importFooAsText},
+			Merge{Handler: RecordLit{}, Union: Natural, Annotation: resolvedFooAsText},
+		),
+		Entry("Import within union alternative",
+			UnionType{"Foo": importFooAsText, "Bar": nil},
+			UnionType{"Foo": resolvedFooAsText, "Bar": nil},
+		),
 	)
 })
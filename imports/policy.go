@@ -0,0 +1,173 @@
+package imports
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/core"
+)
+
+// A Policy restricts which imports a Loader may resolve.  It is
+// intended for evaluating untrusted Dhall, which could otherwise
+// read arbitrary local files and environment variables, and make
+// arbitrary network requests.
+//
+// The zero Policy permits everything.  Imports `as Location` are
+// never restricted, since they don't fetch anything.
+type Policy struct {
+	// DenyEnv forbids environment variable imports.
+	DenyEnv bool
+	// LocalRoot, if set, restricts local imports to files within
+	// this directory.
+	LocalRoot string
+	// DenyRemote forbids remote imports.
+	DenyRemote bool
+	// AllowedSchemes, if not empty, lists the URL schemes that
+	// remote imports may use, for example "https".
+	AllowedSchemes []string
+	// DenyHTTP forbids remote imports over plain http.
+	DenyHTTP bool
+	// AllowedHosts, if not empty, lists the hosts that remote
+	// imports may fetch from.  An entry may include a port
+	// ("example.com:8443" or "[::1]:8443"), in which case only that
+	// port is allowed; otherwise any port is allowed.  An IPv6
+	// address without a port may be written with or without
+	// brackets.  An entry of the form "*.example.com" allows any
+	// subdomain of example.com.
+	AllowedHosts []string
+	// RequireHashes requires every remote import to be protected by
+	// an integrity check (a sha256 hash).
+	RequireHashes bool
+}
+
+// A PolicyViolation is the error returned when a Loader's Policy
+// forbids an import.
+type PolicyViolation struct {
+	// Import is the location of the forbidden import, after
+	// chaining onto its parent.
+	Import core.Fetchable
+	// Reason describes which part of the Policy was violated.
+	Reason string
+}
+
+func (v PolicyViolation) Error() string {
+	return fmt.Sprintf("Import of %s forbidden by policy: %s", v.Import, v.Reason)
+}
+
+// Check returns a PolicyViolation if p forbids fetching the import at
+// here with the given hash (which may be nil), and nil otherwise.  A
+// nil Policy permits everything.
+func (p *Policy) Check(here core.Fetchable, hash []byte) error {
+	if p == nil {
+		return nil
+	}
+	deny := func(format string, args ...interface{}) error {
+		return PolicyViolation{Import: here, Reason: fmt.Sprintf(format, args...)}
+	}
+	switch here := here.(type) {
+	case core.EnvVar:
+		if p.DenyEnv {
+			return deny("environment variable imports are not allowed")
+		}
+	case core.Local:
+		if p.LocalRoot != "" {
+			if here.IsRelativeToHome() {
+				// the Loader expands "~" before checking; we
+				// can't tell where an unexpanded path points
+				return deny("home-relative imports can't be checked against %s", p.LocalRoot)
+			}
+			inside, err := isWithin(p.LocalRoot, string(here))
+			if err != nil {
+				return deny("%v", err)
+			}
+			if !inside {
+				return deny("local imports must be within %s", p.LocalRoot)
+			}
+		}
+	case core.Remote:
+		if p.DenyRemote {
+			return deny("remote imports are not allowed")
+		}
+		if p.DenyHTTP && here.IsPlainHttp() {
+			return deny("plain http imports are not allowed")
+		}
+		if len(p.AllowedSchemes) > 0 && !contains(p.AllowedSchemes, here.Scheme()) {
+			return deny("scheme %s is not allowed", here.Scheme())
+		}
+		if len(p.AllowedHosts) > 0 && !hostAllowed(p.AllowedHosts, here.Host(), here.Port()) {
+			return deny("host %s is not allowed", here.Authority())
+		}
+		if p.RequireHashes && hash == nil {
+			return deny("remote imports must be protected by a hash")
+		}
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func hostAllowed(allowed []string, host, port string) bool {
+	host = strings.ToLower(host)
+	for _, entry := range allowed {
+		entry = strings.ToLower(entry)
+		entryHost, entryPort, err := net.SplitHostPort(entry)
+		if err != nil {
+			// there's no port, or the entry is a bare IPv6
+			// address, whose colons aren't a port separator
+			entryHost, entryPort = entry, ""
+		}
+		entryHost = strings.TrimSuffix(strings.TrimPrefix(entryHost, "["), "]")
+		if entryPort != "" && entryPort != port {
+			continue
+		}
+		if strings.HasPrefix(entryHost, "*.") {
+			if strings.HasSuffix(host, entryHost[1:]) {
+				return true
+			}
+			continue
+		}
+		if entryHost == host {
+			return true
+		}
+	}
+	return false
+}
+
+// isWithin reports whether the file at target is within the
+// directory root, following any symlinks which exist.
+func isWithin(root, target string) (bool, error) {
+	root, err := resolvePath(root)
+	if err != nil {
+		return false, err
+	}
+	target, err = resolvePath(target)
+	if err != nil {
+		return false, err
+	}
+	rel, err := filepath.Rel(root, target)
+	if err != nil {
+		return false, nil
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)), nil
+}
+
+func resolvePath(p string) (string, error) {
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return "", err
+	}
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		return resolved, nil
+	}
+	// the file may not exist; that's for Fetch to report
+	return abs, nil
+}
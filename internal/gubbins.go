@@ -8,8 +8,6 @@ warning.
 package internal
 
 import (
-	"net/url"
-
 	"github.com/philandstuff/dhall-golang/core"
 )
 
@@ -31,7 +29,6 @@ func NewLocalImport(path string, mode core.ImportMode) core.Import {
 
 // only for generating test data - discards errors
 func NewRemoteImport(uri string, mode core.ImportMode) core.Import {
-	parsedURI, _ := url.ParseRequestURI(uri)
-	remote := core.NewRemote(parsedURI)
+	remote, _ := core.ParseRemote(uri)
 	return NewImport(remote, mode)
 }
@@ -1,4 +1,4 @@
-package parser
+package internal
 
 import (
 	. "github.com/philandstuff/dhall-golang/core"
@@ -8,9 +8,9 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-var _ = Describe("removeLeadingCommonIndent removes leading common indent", func() {
+var _ = Describe("RemoveLeadingCommonIndent removes leading common indent", func() {
 	DescribeTable("when the TextLitTerm has no interpolations", func(input, expected string) {
-		actual := removeLeadingCommonIndent(TextLitTerm{Suffix: input})
+		actual := RemoveLeadingCommonIndent(TextLitTerm{Suffix: input})
 		Expect(actual).To(Equal(TextLitTerm{Suffix: expected}))
 	},
 		Entry("when every line has a 3-space prefix",
@@ -35,7 +35,7 @@ var _ = Describe("removeLeadingCommonIndent removes leading common indent", func
    bar`, "foo\n\nbar"),
 	)
 	DescribeTable("when the TextLitTerm has interpolations", func(input, expected TextLitTerm) {
-		actual := removeLeadingCommonIndent(input)
+		actual := RemoveLeadingCommonIndent(input)
 		Expect(actual).To(Equal(expected))
 	},
 		Entry("when every line has a 3-space prefix",
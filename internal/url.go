@@ -0,0 +1,33 @@
+package internal
+
+import (
+	"net/url"
+	"strings"
+)
+
+// EscapeQuotedPathComponents replaces each quoted path component in
+// a URL, such as `/"foo bar"`, with its percent-encoded form, such as
+// `/foo%20bar`.  Double quotes can't appear anywhere else in a URL,
+// so every one we see starts or ends a quoted component.  If a quoted
+// component isn't closed, text is returned unchanged.
+func EscapeQuotedPathComponents(text string) string {
+	if !strings.Contains(text, `"`) {
+		return text
+	}
+	var b strings.Builder
+	for rest := text; ; {
+		start := strings.IndexByte(rest, '"')
+		if start < 0 {
+			b.WriteString(rest)
+			return b.String()
+		}
+		length := strings.IndexByte(rest[start+1:], '"')
+		if length < 0 {
+			return text
+		}
+		end := start + 1 + length
+		b.WriteString(rest[:start])
+		b.WriteString(url.PathEscape(rest[start+1 : end]))
+		rest = rest[end+1:]
+	}
+}
@@ -0,0 +1,16 @@
+package internal
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("EscapeQuotedPathComponents", func(input, expected string) {
+	Expect(EscapeQuotedPathComponents(input)).To(Equal(expected))
+},
+	Entry("without quotes", `https://example.com/a/b`, `https://example.com/a/b`),
+	Entry("with quoted components", `https://example.com/"a b"/c/"d#e"`, `https://example.com/a%20b/c/d%23e`),
+	Entry("with an unterminated quote", `https://example.com/"a b`, `https://example.com/"a b`),
+	Entry("with a quote at the end", `https://example.com/"`, `https://example.com/"`),
+	Entry("with a closed and an unterminated quote", `https://example.com/"a"/"b`, `https://example.com/"a"/"b`),
+)
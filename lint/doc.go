@@ -0,0 +1,11 @@
+/*
+Package lint finds problems in Dhall code which is valid but could
+be better: unused let bindings, the deprecated Optional/fold and
+Optional/build builtins, redundant annotations of merge expressions,
+remote imports without integrity hashes, and imports over plain http.
+
+Each Finding has a Severity and a position in the source, and, where
+the problem can be fixed automatically, an Edit which fixes it.  Fix
+applies the Edits.
+*/
+package lint
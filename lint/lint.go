@@ -0,0 +1,207 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/philandstuff/dhall-golang/parser"
+)
+
+// A Severity says how bad a Finding is.
+type Severity int
+
+// The severities, from least to most severe.
+const (
+	// Info is for code which could be simpler
+	Info Severity = iota
+	// Warning is for code which is probably a mistake, or which
+	// will stop working in future
+	Warning
+	// Error is for code which is unsafe
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// A Finding is a problem found by the linter.
+type Finding struct {
+	// Rule names the check which found the problem, such as
+	// "unused-let"
+	Rule     string
+	Severity Severity
+	Message  string
+	// Start and End are the byte offsets of the source which the
+	// problem is about, and Line and Column, counting from 1, are
+	// the position of Start
+	Start, End   int
+	Line, Column int
+	// Fix, if not nil, is an edit to the source which fixes the
+	// problem
+	Fix *Edit
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%d:%d: %v: %s [%s]", f.Line, f.Column, f.Severity, f.Message, f.Rule)
+}
+
+// An Edit replaces the source from byte offset Start to End with
+// NewText.
+type Edit struct {
+	Start, End int
+	NewText    string
+}
+
+// Lint parses Dhall source and returns the problems in it, in source
+// order.  filename is used only in parse errors.
+func Lint(filename string, src []byte) ([]Finding, error) {
+	n, err := parser.ParseNode(filename, src)
+	if err != nil {
+		return nil, err
+	}
+	return LintNode(src, n), nil
+}
+
+// LintNode returns the problems in the Term of n, which was parsed
+// from src, in source order.
+func LintNode(src []byte, n *parser.Node) []Finding {
+	l := &linter{src: src}
+	l.walk(nil, n)
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		return l.findings[i].Start < l.findings[j].Start
+	})
+	return l.findings
+}
+
+// Fix applies the fixes of findings to src, and returns the result
+// and the number of fixes applied.  Where fixes overlap, only the
+// first is applied; linting the result again finds the others.
+func Fix(src []byte, findings []Finding) ([]byte, int) {
+	var edits []Edit
+	for _, f := range findings {
+		if f.Fix != nil {
+			edits = append(edits, *f.Fix)
+		}
+	}
+	sort.SliceStable(edits, func(i, j int) bool { return edits[i].Start < edits[j].Start })
+	var out []byte
+	applied := 0
+	pos := 0
+	for _, e := range edits {
+		if e.Start < pos {
+			continue
+		}
+		out = append(out, src[pos:e.Start]...)
+		out = append(out, e.NewText...)
+		pos = e.End
+		applied++
+	}
+	return append(out, src[pos:]...), applied
+}
+
+// FixAll lints src and applies the fixes, again and again, since
+// fixing one problem can reveal another, such as a let binding which
+// was only used by an unused binding.  It returns the fixed source,
+// the number of fixes applied and the problems which remain.
+func FixAll(filename string, src []byte) ([]byte, int, []Finding, error) {
+	total := 0
+	for {
+		findings, err := Lint(filename, src)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		fixed, applied := Fix(src, findings)
+		if applied == 0 {
+			return src, total, findings, nil
+		}
+		src = fixed
+		total += applied
+	}
+}
+
+// A linter holds the state of linting a single source.
+type linter struct {
+	src      []byte
+	findings []Finding
+}
+
+// report records a Finding about the source from start to end.
+func (l *linter) report(rule string, severity Severity, start, end int, fix *Edit, format string, args ...interface{}) {
+	line, col := 1, 1
+	for _, r := range string(l.src[:start]) {
+		if r == '\n' {
+			line, col = line+1, 1
+		} else {
+			col++
+		}
+	}
+	l.findings = append(l.findings, Finding{
+		Rule:     rule,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+		Start:    start,
+		End:      end,
+		Line:     line,
+		Column:   col,
+		Fix:      fix,
+	})
+}
+
+// text returns the source of n.
+func (l *linter) text(n *parser.Node) string {
+	return string(l.src[n.Start:n.End])
+}
+
+// skipSpace returns the offset of the first byte at or after offset
+// which isn't whitespace or part of a comment.
+func (l *linter) skipSpace(offset int) int {
+	src := l.src
+	for offset < len(src) {
+		switch {
+		case isSpace(src[offset]):
+			offset++
+		case hasPrefix(src[offset:], "--"):
+			for offset < len(src) && src[offset] != '\n' {
+				offset++
+			}
+		case hasPrefix(src[offset:], "{-"):
+			depth := 0
+			for offset < len(src) {
+				if hasPrefix(src[offset:], "{-") {
+					depth++
+					offset += 2
+				} else if hasPrefix(src[offset:], "-}") {
+					depth--
+					offset += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					_, size := utf8.DecodeRune(src[offset:])
+					offset += size
+				}
+			}
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func hasPrefix(b []byte, prefix string) bool {
+	return len(b) >= len(prefix) && string(b[:len(prefix)]) == prefix
+}
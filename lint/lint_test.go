@@ -0,0 +1,160 @@
+package lint_test
+
+import (
+	"fmt"
+
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/lint"
+	"github.com/philandstuff/dhall-golang/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// findings lints source, and describes each finding as
+// "line:column rule", with "(fix)" after those with a fix.
+func findings(source string) []string {
+	found, err := lint.Lint("-", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	var described []string
+	for _, f := range found {
+		d := fmt.Sprintf("%d:%d %s", f.Line, f.Column, f.Rule)
+		if f.Fix != nil {
+			d += " (fix)"
+		}
+		described = append(described, d)
+	}
+	return described
+}
+
+// fix applies all the fixes to source.
+func fix(source string) string {
+	fixed, _, remaining, err := lint.FixAll("-", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	for _, f := range remaining {
+		Expect(f.Fix).To(BeNil())
+	}
+	return string(fixed)
+}
+
+// normalize returns the normal form of source, which has no imports.
+func normalize(source string) Value {
+	term, err := parser.Parse("-", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	_, err = TypeOf(term.(Term))
+	Expect(err).ToNot(HaveOccurred(), source)
+	return AlphaBetaEval(term.(Term))
+}
+
+var _ = Describe("Lint", func() {
+	DescribeTable("finds problems", func(source string, expected ...string) {
+		if len(expected) == 0 {
+			Expect(findings(source)).To(BeEmpty())
+		} else {
+			Expect(findings(source)).To(Equal(expected))
+		}
+	},
+		Entry("nothing in clean code", `let x = 1 in λ(y : Natural) → x + y`),
+		Entry("unused let bindings",
+			"let x = 1\nlet y = 2\nin  y",
+			"1:5 unused-let (fix)"),
+		Entry("unused let bindings whose name is used elsewhere",
+			"let x = 1\nlet x = 2\nin  x@1",
+			"2:5 unused-let"),
+		Entry("no unused assertions or underscores",
+			"let example = assert : 1 ≡ 1\nlet _ = 2\nin  3"),
+		Entry("bindings used only by later bindings",
+			"let x = 1 let y = x in y"),
+		Entry("bindings shadowed by a λ",
+			"let x = 1 in λ(x : Natural) → x",
+			"1:5 unused-let (fix)"),
+		Entry("deprecated Optional builtins",
+			"[ Optional/fold, Optional/build Natural ]",
+			"1:3 deprecated-optional", "1:18 deprecated-optional"),
+		Entry("redundant merge annotations",
+			"[ merge { A = 1 } x : Natural, merge {=} y : Natural, merge h z : Natural ]",
+			"1:3 redundant-merge-annotation (fix)"),
+		Entry("remote imports",
+			"[ https://example.com/a, http://example.com/b sha256:"+
+				"0000000000000000000000000000000000000000000000000000000000000000, "+
+				"https://example.com/c as Location ]",
+			"1:3 unhashed-import", "1:26 plain-http"),
+	)
+	It("reports positions in characters", func() {
+		found, err := lint.Lint("-", []byte("{ a = \"→\", b = let x = 1 in 2 }"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(HaveLen(1))
+		Expect(found[0].String()).To(Equal("1:20: warning: x is bound but never used [unused-let]"))
+	})
+	It("fails on code which doesn't parse", func() {
+		_, err := lint.Lint("-", []byte("let x ="))
+		Expect(err).To(BeAssignableToTypeOf(parser.Error{}))
+	})
+})
+
+var _ = Describe("Fix", func() {
+	DescribeTable("fixes problems", func(source, expected string) {
+		fixed := fix(source)
+		Expect(fixed).To(Equal(expected))
+		Expect(findings(fixed)).To(BeEmpty())
+	},
+		Entry("unused first binding",
+			"let x = 1\nlet y = 2\nin  y", "let y = 2\nin  y"),
+		Entry("unused middle binding",
+			"let x = 1\n-- unused\nlet y = 2\nlet z = x in z", "let x = 1\n-- unused\nlet z = x in z"),
+		Entry("unused last binding",
+			"let x = 1\nlet y = 2\nin  x", "let x = 1\nin  x"),
+		Entry("unused only binding",
+			"{ a = let x = 1 in {- body -} 2 }", "{ a = {- body -} 2 }"),
+		Entry("bindings which become unused",
+			"let x = 1\nlet y = x\nin  2", "2"),
+		Entry("redundant merge annotations",
+			"(merge { A = 1 } < A >.A : Natural) + 1", "(merge { A = 1 } < A >.A) + 1"),
+	)
+	DescribeTable("rewrites deprecated builtins to equivalent code", func(source, expected string) {
+		fixed := fix(source)
+		Expect(fixed).To(Equal(expected))
+		Expect(normalize(fixed)).To(Equal(normalize(source)))
+	},
+		Entry("Optional/fold",
+			"Optional/fold Natural (Some 1) Text (λ(n : Natural) → \"some\") \"none\"",
+			"merge { None = \"none\", `Some` = (λ(n : Natural) → \"some\") } (Some 1)"),
+		Entry("Optional/build",
+			"Optional/build Natural (λ(o : Type) → λ(some : Natural → o) → λ(none : o) → some 1)",
+			"(λ(o : Type) → λ(some : Natural → o) → λ(none : o) → some 1) (Optional Natural) (λ(a : Natural) → Some a) (None Natural)"),
+	)
+	It("applies only the first of overlapping fixes", func() {
+		src := []byte("abcdef")
+		fixed, applied := lint.Fix(src, []lint.Finding{
+			{Fix: &lint.Edit{Start: 1, End: 3, NewText: "X"}},
+			{Fix: &lint.Edit{Start: 2, End: 4, NewText: "Y"}},
+			{},
+			{Fix: &lint.Edit{Start: 5, End: 5, NewText: "Z"}},
+		})
+		Expect(string(fixed)).To(Equal("aXdeZf"))
+		Expect(applied).To(Equal(2))
+	})
+})
+
+var _ = Describe("FixAll", func() {
+	It("leaves plain http imports to be changed by hand", func() {
+		src := "http://example.com/a sha256:0000000000000000000000000000000000000000000000000000000000000000"
+		fixed, applied, remaining, err := lint.FixAll("-", []byte(src))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(fixed)).To(Equal(src))
+		Expect(applied).To(Equal(0))
+		Expect(remaining).To(HaveLen(1))
+		Expect(remaining[0].Rule).To(Equal("plain-http"))
+		Expect(remaining[0].Message).To(ContainSubstring("consider https"))
+	})
+	It("counts the fixes and returns what is left", func() {
+		fixed, applied, remaining, err := lint.FixAll("-", []byte(
+			"let x = 1\nlet y = x\nin  https://example.com/a"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(fixed)).To(Equal("https://example.com/a"))
+		Expect(applied).To(Equal(2))
+		Expect(remaining).To(HaveLen(1))
+		Expect(remaining[0].Rule).To(Equal("unhashed-import"))
+	})
+})
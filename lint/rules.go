@@ -0,0 +1,197 @@
+package lint
+
+import (
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+)
+
+// walk checks n, whose ancestors are given innermost last, and
+// everything inside it.
+func (l *linter) walk(ancestors []*parser.Node, n *parser.Node) {
+	switch t := n.Term.(type) {
+	case core.Let:
+		l.unusedLet(n, t)
+	case core.Builtin:
+		if t == core.OptionalFold || t == core.OptionalBuild {
+			l.deprecatedOptional(ancestors, n, t)
+		}
+	case core.Merge:
+		l.redundantMergeAnnotation(n, t)
+	case core.Import:
+		l.remoteImport(n, t)
+	}
+	ancestors = append(ancestors, n)
+	for _, child := range n.Children {
+		l.walk(ancestors, child)
+	}
+}
+
+// unusedLet reports the bindings of let which nothing refers to.
+// Bindings named `_`, and bindings of assertions, which are tests,
+// are expected to be unused.  A binding can be removed if the rest
+// of the let doesn't mention its name at all; otherwise removing it
+// would change what the other occurrences refer to.
+func (l *linter) unusedLet(n *parser.Node, let core.Let) {
+	bindings, _, ok := n.LetBindings()
+	if !ok {
+		return
+	}
+	for k, b := range let.Bindings {
+		if _, ok := b.Value.(core.Assert); ok || b.Variable == "_" {
+			continue
+		}
+		rest := let.Body
+		if k+1 < len(let.Bindings) {
+			rest = core.Let{Bindings: let.Bindings[k+1:], Body: let.Body}
+		}
+		used, mentioned := false, false
+		freeVars(rest, b.Variable, 0, func(index int) {
+			mentioned = true
+			used = used || index == 0
+		})
+		if used {
+			continue
+		}
+		var fix *Edit
+		if !mentioned {
+			// a binding runs from its `let` to the next `let`,
+			// or to the `in`; an only binding goes with the `in`
+			start := n.Start
+			if k > 0 {
+				start = l.skipSpace(bindings[k-1].Value.End)
+			}
+			end := l.skipSpace(bindings[k].Value.End)
+			if len(let.Bindings) == 1 {
+				// keep any comments between the `in` and the body
+				end += len("in")
+				for end < len(l.src) && isSpace(l.src[end]) {
+					end++
+				}
+			}
+			fix = &Edit{Start: start, End: end}
+		}
+		l.report("unused-let", Warning, bindings[k].Binder.Start, bindings[k].Binder.End, fix,
+			"%s is bound but never used", b.Variable)
+	}
+}
+
+// freeVars calls f with the index of each occurrence of the variable
+// name in t which is free in t, counting from t's scope.  depth is
+// the number of binders of name which have been entered.
+func freeVars(t core.Term, name string, depth int, f func(index int)) {
+	switch t := t.(type) {
+	case core.Var:
+		if t.Name == name && t.Index >= depth {
+			f(t.Index - depth)
+		}
+		return
+	case core.LambdaTerm:
+		freeVars(t.Type, name, depth, f)
+		if t.Label == name {
+			depth++
+		}
+		freeVars(t.Body, name, depth, f)
+		return
+	case core.PiTerm:
+		freeVars(t.Type, name, depth, f)
+		if t.Label == name {
+			depth++
+		}
+		freeVars(t.Body, name, depth, f)
+		return
+	case core.Let:
+		for _, b := range t.Bindings {
+			if b.Annotation != nil {
+				freeVars(b.Annotation, name, depth, f)
+			}
+			freeVars(b.Value, name, depth, f)
+			if b.Variable == name {
+				depth++
+			}
+		}
+		freeVars(t.Body, name, depth, f)
+		return
+	}
+	core.RewriteChildren(t, func(child core.Term) (core.Term, error) {
+		freeVars(child, name, depth, f)
+		return child, nil
+	})
+}
+
+// deprecatedOptional reports uses of Optional/fold and
+// Optional/build, which are deprecated.  Where they are applied to
+// all their arguments, they are rewritten:
+//
+//	Optional/fold A o R s n  ⇒  merge { None = n, `Some` = s } o
+//	Optional/build A f       ⇒  f (Optional A) (λ(a : A) → Some a) (None A)
+func (l *linter) deprecatedOptional(ancestors []*parser.Node, n *parser.Node, builtin core.Builtin) {
+	// the applications of builtin are the ancestors whose function
+	// is the node before
+	var args []*parser.Node
+	var app *parser.Node
+	want := 5
+	if builtin == core.OptionalBuild {
+		want = 2
+	}
+	fn := n
+	for i := len(ancestors) - 1; i >= 0 && len(args) < want; i-- {
+		a := ancestors[i]
+		if _, ok := a.Term.(core.AppTerm); !ok || len(a.Children) != 2 || a.Children[0] != fn {
+			break
+		}
+		args = append(args, a.Children[1])
+		app, fn = a, a
+	}
+	var fix *Edit
+	if len(args) == want {
+		var text string
+		if builtin == core.OptionalFold {
+			text = "merge { None = " + l.text(args[4]) + ", `Some` = " + l.text(args[3]) + " } " + l.text(args[1])
+		} else {
+			text = l.text(args[1]) + " (Optional " + l.text(args[0]) + ") (λ(a : " + l.text(args[0]) + ") → Some a) (None " + l.text(args[0]) + ")"
+		}
+		fix = &Edit{Start: app.Start, End: app.End, NewText: text}
+	}
+	replacement := "merge"
+	if builtin == core.OptionalBuild {
+		replacement = "Some and None"
+	}
+	l.report("deprecated-optional", Warning, n.Start, n.End, fix,
+		"%s is deprecated; use %s instead", builtin, replacement)
+}
+
+// redundantMergeAnnotation reports annotations of merge expressions
+// whose handlers are a non-empty record literal, from which the
+// type of the merge can be inferred.
+func (l *linter) redundantMergeAnnotation(n *parser.Node, merge core.Merge) {
+	if merge.Annotation == nil || len(n.Children) != 2 {
+		return
+	}
+	if handlers, ok := merge.Handler.(core.RecordLit); !ok || len(handlers) == 0 {
+		return
+	}
+	unannotated := n.Children[0]
+	l.report("redundant-merge-annotation", Info, n.Start, n.End,
+		&Edit{Start: n.Start, End: n.End, NewText: l.text(unannotated)},
+		"the annotation of this merge is redundant, since its type can be inferred from the handlers")
+}
+
+// remoteImport reports remote imports which aren't protected by a
+// hash, and those fetched over plain http, whose content could be
+// tampered with.  Plain http imports aren't fixed automatically, since
+// the same URL over https needn't serve the same content, or anything
+// at all.
+func (l *linter) remoteImport(n *parser.Node, i core.Import) {
+	remote, ok := i.Fetchable.(core.Remote)
+	if !ok {
+		return
+	}
+	if remote.IsPlainHttp() {
+		l.report("plain-http", Error, n.Start, n.End, nil,
+			"%s is imported over plain http; consider https, if the server supports it", remote)
+	}
+	if i.Hash == nil && i.ImportMode != core.Location {
+		l.report("unhashed-import", Warning, n.Start, n.End, nil,
+			"%s has no integrity hash, so it may change without notice", remote)
+	}
+}
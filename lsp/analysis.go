@@ -0,0 +1,297 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/imports"
+	"github.com/philandstuff/dhall-golang/parser"
+)
+
+// A binding is a variable in scope at some place in a document: a
+// let binding, or the parameter of a λ or ∀.
+type binding struct {
+	// binder is the Node of the bound name
+	binder *parser.Node
+	// let is the let binding, or nil for a parameter of type typ
+	let *core.Binding
+	typ core.Term
+}
+
+// scope returns the bindings in scope at the last Node of path, which
+// starts at the Node of a whole document, innermost last.  A bound
+// name is in scope at its own binder.
+func scope(path []*parser.Node) []binding {
+	var bindings []binding
+	for i := 0; i+1 < len(path); i++ {
+		bindings = append(bindings, bindingsAt(path[i], path[i+1])...)
+	}
+	return bindings
+}
+
+// bindingsAt returns the bindings which parent makes that are in
+// scope at child.
+func bindingsAt(parent, child *parser.Node) []binding {
+	children := parent.Children
+	switch t := parent.Term.(type) {
+	case core.LambdaTerm:
+		// children are the binder, the type and the body
+		if len(children) == 3 && child != children[1] {
+			return []binding{{binder: children[0], typ: t.Type}}
+		}
+	case core.PiTerm:
+		if len(children) == 3 && child != children[1] {
+			return []binding{{binder: children[0], typ: t.Type}}
+		}
+	case core.Let:
+		nodes, _, ok := parent.LetBindings()
+		if !ok {
+			return nil
+		}
+		var bindings []binding
+		for k := range t.Bindings {
+			b := &t.Bindings[k]
+			switch child {
+			case nodes[k].Binder:
+				return append(bindings, binding{binder: child, let: b})
+			case nodes[k].Annotation, nodes[k].Value:
+				return bindings
+			}
+			bindings = append(bindings, binding{binder: nodes[k].Binder, let: b})
+		}
+		return bindings
+	}
+	return nil
+}
+
+// wrap returns t with bindings, innermost last, around it.
+func wrap(bindings []binding, t core.Term) core.Term {
+	for i := len(bindings) - 1; i >= 0; i-- {
+		b := bindings[i]
+		if b.let != nil {
+			t = core.Let{Bindings: []core.Binding{*b.let}, Body: t}
+		} else {
+			t = core.LambdaTerm{Label: b.binder.Binder, Type: b.typ, Body: t}
+		}
+	}
+	return t
+}
+
+// parameters returns the number of bindings which are parameters.
+func parameters(bindings []binding) int {
+	n := 0
+	for _, b := range bindings {
+		if b.let == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// typeOf returns the type of t, in document d with bindings in scope.
+func (s *Server) typeOf(d *document, bindings []binding, t core.Term) (core.Term, error) {
+	resolved, err := s.resolve(wrap(bindings, t), d.here)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := core.TypeOf(resolved)
+	if err != nil {
+		return nil, err
+	}
+	// each parameter wrapped around t adds a ∀ to its type
+	q := core.Quote(typ)
+	for i := parameters(bindings); i > 0; i-- {
+		pi, ok := q.(core.PiTerm)
+		if !ok {
+			return nil, errors.New("unexpected type of function")
+		}
+		q = pi.Body
+	}
+	return q, nil
+}
+
+// normalize returns the normal form of t, in document d with
+// bindings in scope.
+func (s *Server) normalize(d *document, bindings []binding, t core.Term) (core.Term, error) {
+	resolved, err := s.resolve(wrap(bindings, t), d.here)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := core.TypeOf(resolved); err != nil {
+		return nil, err
+	}
+	q := core.Quote(core.Eval(resolved))
+	for i := parameters(bindings); i > 0; i-- {
+		lambda, ok := q.(core.LambdaTerm)
+		if !ok {
+			return nil, errors.New("unexpected normal form of function")
+		}
+		q = lambda.Body
+	}
+	return q, nil
+}
+
+// resolve resolves the imports in t, relative to here.  The server
+// remembers each import it resolves, so that typechecking parts of a
+// document doesn't fetch the same imports again.
+func (s *Server) resolve(t core.Term, here []core.Fetchable) (core.Term, error) {
+	switch t := t.(type) {
+	case core.Import:
+		return s.resolveImport(t, here)
+	case core.OpTerm:
+		if t.OpCode == core.ImportAltOp {
+			if l, err := s.resolve(t.L, here); err == nil {
+				return l, nil
+			}
+			return s.resolve(t.R, here)
+		}
+	}
+	return core.RewriteChildren(t, func(child core.Term) (core.Term, error) {
+		return s.resolve(child, here)
+	})
+}
+
+// loader returns the Loader which resolves imports for the server.
+func (s *Server) loader() imports.Loader {
+	return imports.Loader{Cache: s.Cache}
+}
+
+func (s *Server) resolveImport(i core.Import, here []core.Fetchable) (core.Term, error) {
+	target := i.Fetchable
+	if len(here) > 0 {
+		var err error
+		if target, err = target.ChainOnto(here[len(here)-1]); err != nil {
+			return nil, err
+		}
+	}
+	key := fmt.Sprintf("%v %v %x", target, i.ImportMode, i.Hash)
+	if resolved, ok := s.imports[key]; ok {
+		return resolved, nil
+	}
+	resolved, err := s.loader().Load(context.Background(), i, here...)
+	if err != nil {
+		return nil, err
+	}
+	if s.imports == nil {
+		s.imports = make(map[string]core.Term)
+	}
+	s.imports[key] = resolved
+	return resolved, nil
+}
+
+// diagnose returns the problems in d: the parse error if it doesn't
+// parse, otherwise any imports which can't be resolved, otherwise
+// the innermost part of it which doesn't typecheck.
+func (s *Server) diagnose(d *document) []Diagnostic {
+	diagnostics := []Diagnostic{}
+	if d.err != nil {
+		offset := len(d.text)
+		message := d.err.Error()
+		var perr parser.Error
+		if errors.As(d.err, &perr) {
+			offset = perr.Offset
+			message = perr.Message
+			if perr.Hint != "" {
+				message += "\n" + perr.Hint
+			}
+		}
+		return append(diagnostics, Diagnostic{
+			Range:    d.rangeOf(offset, offset),
+			Severity: SeverityError,
+			Source:   "dhall",
+			Message:  message,
+		})
+	}
+	for _, n := range s.importErrors(d, d.node) {
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    d.nodeRange(n.node),
+			Severity: SeverityError,
+			Source:   "dhall",
+			Message:  "Import resolve error: " + n.err.Error(),
+		})
+	}
+	if len(diagnostics) > 0 {
+		return diagnostics
+	}
+	if _, err := s.typeOf(d, nil, d.node.Term); err != nil {
+		n, err := s.typeError(d, nil, d.node, err)
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    d.nodeRange(n),
+			Severity: SeverityError,
+			Source:   "dhall",
+			Message:  "Type error: " + err.Error(),
+		})
+	}
+	return diagnostics
+}
+
+// A nodeError is an error in the Term of a Node.
+type nodeError struct {
+	node *parser.Node
+	err  error
+}
+
+// importErrors returns the imports within n which can't be resolved.
+// An import alternative only fails if both sides fail.
+func (s *Server) importErrors(d *document, n *parser.Node) []nodeError {
+	switch t := n.Term.(type) {
+	case core.Import:
+		if _, err := s.resolveImport(t, d.here); err != nil {
+			return []nodeError{{n, err}}
+		}
+		return nil
+	case core.OpTerm:
+		if t.OpCode == core.ImportAltOp && len(n.Children) == 2 {
+			errs := s.importErrors(d, n.Children[0])
+			if len(errs) == 0 {
+				return nil
+			}
+			if right := s.importErrors(d, n.Children[1]); len(right) > 0 {
+				return append(errs, right...)
+			}
+			return nil
+		}
+	}
+	var errs []nodeError
+	for _, child := range n.Children {
+		errs = append(errs, s.importErrors(d, child)...)
+	}
+	return errs
+}
+
+// typeError finds the innermost Node within n which has a type
+// error, given that n has the type error err with bindings in scope.
+// A child of n is blamed only if its bindings typecheck, so that an
+// ill-typed let binding isn't blamed on the let's body.
+func (s *Server) typeError(d *document, bindings []binding, n *parser.Node, err error) (*parser.Node, error) {
+	for _, child := range n.Children {
+		if child.Term == nil {
+			continue
+		}
+		inner := append(bindings[:len(bindings):len(bindings)], bindingsAt(n, child)...)
+		if _, childErr := s.typeOf(d, inner, annotated(n, child)); childErr != nil {
+			if _, scopeErr := s.typeOf(d, inner, core.RecordLit{}); scopeErr == nil {
+				return s.typeError(d, inner, child, childErr)
+			}
+		}
+	}
+	return n, err
+}
+
+// annotated returns the Term of child, with its annotation if it is
+// the value of an annotated let binding in parent, so that a value
+// which doesn't match its annotation is blamed for it.
+func annotated(parent, child *parser.Node) core.Term {
+	nodes, _, ok := parent.LetBindings()
+	if !ok {
+		return child.Term
+	}
+	for k, b := range parent.Term.(core.Let).Bindings {
+		if b.Annotation != nil && nodes[k].Value == child {
+			return core.Annot{Expr: child.Term, Annotation: b.Annotation}
+		}
+	}
+	return child.Term
+}
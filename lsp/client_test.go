@@ -0,0 +1,125 @@
+package lsp_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/textproto"
+	"strconv"
+
+	"github.com/philandstuff/dhall-golang/lsp"
+
+	. "github.com/onsi/gomega"
+)
+
+// A client talks to a Server running in the same process, as an
+// editor would.
+type client struct {
+	toServer   *io.PipeWriter
+	fromServer *bufio.Reader
+	// done receives what Serve returns
+	done   chan error
+	nextID int
+}
+
+// A message is a JSON-RPC message sent by the server.
+type message struct {
+	ID     *int               `json:"id"`
+	Method string             `json:"method"`
+	Params json.RawMessage    `json:"params"`
+	Result json.RawMessage    `json:"result"`
+	Error  *lsp.ResponseError `json:"error"`
+}
+
+func newClient(s *lsp.Server) *client {
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	c := &client{toServer: inW, fromServer: bufio.NewReader(outR), done: make(chan error, 1)}
+	go func() {
+		err := s.Serve(inR, outW)
+		outW.Close()
+		c.done <- err
+	}()
+	return c
+}
+
+func (c *client) write(msg map[string]interface{}) {
+	msg["jsonrpc"] = "2.0"
+	body, err := json.Marshal(msg)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = fmt.Fprintf(c.toServer, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func (c *client) read() message {
+	header, err := textproto.NewReader(c.fromServer).ReadMIMEHeader()
+	Expect(err).ToNot(HaveOccurred())
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	Expect(err).ToNot(HaveOccurred())
+	body := make([]byte, length)
+	_, err = io.ReadFull(c.fromServer, body)
+	Expect(err).ToNot(HaveOccurred())
+	var msg message
+	Expect(json.Unmarshal(body, &msg)).To(Succeed())
+	return msg
+}
+
+// call sends a request, and unmarshals the result of its response
+// into result.  It returns the error in the response, if any.
+func (c *client) call(method string, params, result interface{}) *lsp.ResponseError {
+	c.nextID++
+	c.write(map[string]interface{}{"id": c.nextID, "method": method, "params": params})
+	msg := c.read()
+	Expect(msg.ID).ToNot(BeNil())
+	Expect(*msg.ID).To(Equal(c.nextID))
+	if msg.Error != nil {
+		return msg.Error
+	}
+	if result != nil {
+		Expect(json.Unmarshal(msg.Result, result)).To(Succeed())
+	}
+	return nil
+}
+
+func (c *client) notify(method string, params interface{}) {
+	c.write(map[string]interface{}{"method": method, "params": params})
+}
+
+// diagnostics reads the next message, which must publish
+// diagnostics.
+func (c *client) diagnostics() lsp.PublishDiagnosticsParams {
+	msg := c.read()
+	Expect(msg.Method).To(Equal("textDocument/publishDiagnostics"))
+	var params lsp.PublishDiagnosticsParams
+	Expect(json.Unmarshal(msg.Params, &params)).To(Succeed())
+	return params
+}
+
+// open opens a document with the given text, and returns its
+// diagnostics.
+func (c *client) open(uri, text string) []lsp.Diagnostic {
+	c.notify("textDocument/didOpen", map[string]interface{}{
+		"textDocument": lsp.TextDocumentItem{URI: uri, LanguageID: "dhall", Version: 1, Text: text},
+	})
+	return c.diagnostics().Diagnostics
+}
+
+// close shuts the server down and returns what Serve returned.
+func (c *client) close() error {
+	Expect(c.call("shutdown", nil, nil)).To(BeNil())
+	c.notify("exit", nil)
+	// drain anything left, so that the server isn't blocked
+	go ioutil.ReadAll(c.fromServer)
+	return <-c.done
+}
+
+// at returns the parameters of a request about a position in the
+// document at uri.
+func at(uri string, line, character int) lsp.TextDocumentPositionParams {
+	return lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+		Position:     lsp.Position{Line: line, Character: character},
+	}
+}
@@ -0,0 +1,12 @@
+/*
+Package lsp implements a language server for Dhall, which editors can
+talk to with the Language Server Protocol: JSON-RPC messages over a
+stream such as stdin and stdout.
+
+The server reports parse errors, unresolvable imports and type errors
+as diagnostics whenever a document changes.  It shows the types of
+expressions on hover, goes to the definition of variables and local
+imports, completes record fields and union alternatives after a `.`,
+and formats documents with package printer.
+*/
+package lsp
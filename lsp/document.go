@@ -0,0 +1,123 @@
+package lsp
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+)
+
+// A document is a Dhall file which the client has open.
+type document struct {
+	uri  string
+	text string
+	// lineStarts are the offsets at which each line starts
+	lineStarts []int
+	// node is the parsed text, or nil if it doesn't parse, in which
+	// case err is the parse error
+	node *parser.Node
+	err  error
+	// here is where imports in the document are resolved from: the
+	// document's file, or nothing for a document which isn't a
+	// file, whose imports are resolved from the current directory
+	here []core.Fetchable
+}
+
+func newDocument(uri, text string) *document {
+	d := &document{uri: uri, text: text, lineStarts: []int{0}}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			d.lineStarts = append(d.lineStarts, i+1)
+		}
+	}
+	d.node, d.err = parser.ParseNode(d.filename(), []byte(text))
+	if path, ok := filePath(uri); ok {
+		d.here = []core.Fetchable{localPath(path)}
+	}
+	return d
+}
+
+// filename is the name of the document in error messages.
+func (d *document) filename() string {
+	if path, ok := filePath(d.uri); ok {
+		return path
+	}
+	return d.uri
+}
+
+// offset returns the byte offset of pos.  Positions past the end of
+// a line or of the document are clamped to the end.
+func (d *document) offset(pos Position) int {
+	if pos.Line < 0 {
+		return 0
+	}
+	if pos.Line >= len(d.lineStarts) {
+		return len(d.text)
+	}
+	offset := d.lineStarts[pos.Line]
+	for units := 0; units < pos.Character && offset < len(d.text); {
+		r, size := utf8.DecodeRuneInString(d.text[offset:])
+		if r == '\n' {
+			break
+		}
+		units += utf16Len(r)
+		offset += size
+	}
+	return offset
+}
+
+// position returns the Position of offset.
+func (d *document) position(offset int) Position {
+	line := len(d.lineStarts) - 1
+	for line > 0 && d.lineStarts[line] > offset {
+		line--
+	}
+	character := 0
+	for _, r := range d.text[d.lineStarts[line]:offset] {
+		character += utf16Len(r)
+	}
+	return Position{Line: line, Character: character}
+}
+
+// rangeOf returns the Range from start to end.
+func (d *document) rangeOf(start, end int) Range {
+	return Range{Start: d.position(start), End: d.position(end)}
+}
+
+// nodeRange returns the Range of n.
+func (d *document) nodeRange(n *parser.Node) Range {
+	return d.rangeOf(n.Start, n.End)
+}
+
+// utf16Len returns the number of UTF-16 code units which encode r.
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// filePath returns the path of the file which uri names, if it is a
+// file URI.
+func filePath(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	return filepath.FromSlash(u.Path), true
+}
+
+// fileURI returns the URI of the file at path, which is absolute.
+func fileURI(path string) string {
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(path)}
+	return u.String()
+}
+
+// localPath returns the Local import of the file at path, which is
+// absolute.
+func localPath(path string) core.Local {
+	return core.NewLocal("/", strings.Split(strings.TrimPrefix(filepath.ToSlash(path), "/"), "/"))
+}
@@ -0,0 +1,183 @@
+package lsp
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+	"github.com/philandstuff/dhall-golang/printer"
+)
+
+// hover returns the type of the innermost expression at pos, or nil
+// if there isn't one or it doesn't typecheck.  Variables and bound
+// names are shown with their type, as in `x : Natural`.
+func (s *Server) hover(d *document, pos Position) *Hover {
+	if d.node == nil {
+		return nil
+	}
+	path := d.node.Path(d.offset(pos))
+	if path == nil {
+		return nil
+	}
+	n := path[len(path)-1]
+	t := n.Term
+	name := ""
+	if t == nil {
+		t = core.Var{Name: n.Binder}
+		name = n.Binder
+	} else if v, ok := t.(core.Var); ok {
+		name = printer.Sprint(v)
+	}
+	typ, err := s.typeOf(d, scope(path), t)
+	if err != nil {
+		return nil
+	}
+	text := printer.Sprint(typ)
+	if name != "" {
+		text = name + " : " + text
+	}
+	r := d.nodeRange(n)
+	return &Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: "```dhall\n" + text + "\n```"},
+		Range:    &r,
+	}
+}
+
+// definition returns where the variable or import at pos is defined:
+// the name of its let binding, λ or ∀, or the imported file.
+func (s *Server) definition(d *document, pos Position) []Location {
+	if d.node == nil {
+		return nil
+	}
+	path := d.node.Path(d.offset(pos))
+	if path == nil {
+		return nil
+	}
+	switch t := path[len(path)-1].Term.(type) {
+	case core.Var:
+		bindings := scope(path)
+		index := t.Index
+		for i := len(bindings) - 1; i >= 0; i-- {
+			binder := bindings[i].binder
+			if binder.Binder != t.Name {
+				continue
+			}
+			if index == 0 {
+				return []Location{{URI: d.uri, Range: d.nodeRange(binder)}}
+			}
+			index--
+		}
+	case core.Import:
+		if path, ok := s.importedFile(d, t); ok {
+			return []Location{{URI: fileURI(path)}}
+		}
+	}
+	return nil
+}
+
+// importedFile returns the path of the file which i imports, if it
+// is a local import.
+func (s *Server) importedFile(d *document, i core.Import) (string, bool) {
+	target := i.Fetchable
+	var err error
+	if len(d.here) > 0 {
+		if target, err = target.ChainOnto(d.here[0]); err != nil {
+			return "", false
+		}
+	}
+	if target, err = s.loader().ExpandHome(target); err != nil {
+		return "", false
+	}
+	local, ok := target.(core.Local)
+	if !ok {
+		return "", false
+	}
+	path, err := filepath.Abs(filepath.FromSlash(string(local)))
+	return path, err == nil
+}
+
+// completion returns the fields which could follow the `.` before
+// pos: those of a record, or the alternatives of a union type.  The
+// text after the `.` up to the end of the label at pos is ignored,
+// so that a partly typed label doesn't stop the document parsing.
+func (s *Server) completion(d *document, pos Position) []CompletionItem {
+	items := []CompletionItem{}
+	offset := d.offset(pos)
+	dot := offset
+	for dot > 0 && isLabelChar(d.text[dot-1]) {
+		dot--
+	}
+	if dot == 0 || d.text[dot-1] != '.' {
+		return items
+	}
+	dot--
+	end := offset
+	for end < len(d.text) && isLabelChar(d.text[end]) {
+		end++
+	}
+	exprEnd := len(strings.TrimRightFunc(d.text[:dot], unicode.IsSpace))
+	root, err := parser.ParseNode(d.filename(), []byte(d.text[:dot]+d.text[end:]))
+	if err != nil {
+		return items
+	}
+	// the record is the innermost expression which ends before the
+	// dot
+	path := root.Path(exprEnd)
+	for len(path) > 0 && path[len(path)-1].End != exprEnd {
+		path = path[:len(path)-1]
+	}
+	if len(path) == 0 || path[len(path)-1].Term == nil {
+		return items
+	}
+	bindings := scope(path)
+	record := path[len(path)-1].Term
+	typ, err := s.typeOf(d, bindings, record)
+	if err != nil {
+		return items
+	}
+	if fields, ok := typ.(core.RecordType); ok {
+		for name, t := range fields {
+			items = append(items, CompletionItem{Label: name, Kind: CompletionField, Detail: printer.Sprint(t)})
+		}
+	} else if value, err := s.normalize(d, bindings, record); err == nil {
+		if alternatives, ok := value.(core.UnionType); ok {
+			for name, t := range alternatives {
+				item := CompletionItem{Label: name, Kind: CompletionEnumMember}
+				if t != nil {
+					item.Detail = printer.Sprint(t)
+				}
+				items = append(items, item)
+			}
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+func isLabelChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '_' || c == '-' || c == '/'
+}
+
+// format returns the edits which format d with the printer.  Comments
+// before the expression, such as a licence header, are kept, but
+// comments inside it are lost.
+func (s *Server) format(d *document) ([]TextEdit, error) {
+	if d.node == nil {
+		return nil, &ResponseError{Code: codeRequestFailed, Message: "can't format a document which doesn't parse: " + d.err.Error()}
+	}
+	header := d.text[:d.node.Start]
+	if strings.TrimSpace(header) == "" {
+		header = ""
+	} else {
+		header = strings.TrimRightFunc(header, unicode.IsSpace) + "\n"
+	}
+	formatted := header + printer.Sprint(d.node.Term) + "\n"
+	if formatted == d.text {
+		return []TextEdit{}, nil
+	}
+	return []TextEdit{{Range: d.rangeOf(0, len(d.text)), NewText: formatted}}, nil
+}
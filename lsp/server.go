@@ -0,0 +1,227 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/imports"
+)
+
+// ErrNoShutdown is returned by Serve if the client sends exit without
+// asking the server to shut down first.
+var ErrNoShutdown = errors.New("exit without shutdown")
+
+// A Server is a Dhall language server.  The zero Server is ready to
+// use.
+type Server struct {
+	// Cache is used for saving and fetching imports which are
+	// protected by a hash.  If nil, no caching is done.
+	Cache imports.DhallCache
+
+	documents map[string]*document
+	// imports are the imports resolved so far, keyed by their
+	// location, mode and hash.  They are forgotten whenever a
+	// document is saved, since it may be imported.
+	imports  map[string]core.Term
+	out      io.Writer
+	shutdown bool
+}
+
+// Serve reads requests and notifications from in, and writes
+// responses and notifications to out, until the client sends exit or
+// in is exhausted.  Messages are handled one at a time, in order.
+func (s *Server) Serve(in io.Reader, out io.Writer) error {
+	s.out = out
+	r := bufio.NewReader(in)
+	for {
+		body, err := readMessage(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var msg message
+		if err := json.Unmarshal(body, &msg); err != nil {
+			if err := s.respond(nil, nil, &ResponseError{Code: codeParseError, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return ErrNoShutdown
+			}
+			return nil
+		}
+		result, err := s.handle(msg.Method, msg.Params, msg.ID != nil)
+		if msg.ID == nil {
+			// a notification has no response, but if sending
+			// diagnostics failed, so will everything else
+			var rerr *ResponseError
+			if err != nil && !errors.As(err, &rerr) {
+				return err
+			}
+			continue
+		}
+		if err := s.respond(msg.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+// handle handles a request or a notification.  It returns the result
+// of a request.
+func (s *Server) handle(method string, params json.RawMessage, request bool) (interface{}, error) {
+	if s.documents == nil {
+		s.documents = make(map[string]*document)
+	}
+	switch method {
+	case "initialize":
+		var result InitializeResult
+		result.Capabilities.TextDocumentSync = 1
+		result.Capabilities.HoverProvider = true
+		result.Capabilities.DefinitionProvider = true
+		result.Capabilities.DocumentFormattingProvider = true
+		result.Capabilities.CompletionProvider.TriggerCharacters = []string{"."}
+		result.ServerInfo.Name = "dhall-golang"
+		return result, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var p DidOpenTextDocumentParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		return nil, s.open(p.TextDocument.URI, p.TextDocument.Text)
+	case "textDocument/didChange":
+		var p DidChangeTextDocumentParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		if len(p.ContentChanges) == 0 {
+			return nil, nil
+		}
+		return nil, s.open(p.TextDocument.URI, p.ContentChanges[len(p.ContentChanges)-1].Text)
+	case "textDocument/didSave":
+		s.imports = nil
+		uris := make([]string, 0, len(s.documents))
+		for uri := range s.documents {
+			uris = append(uris, uri)
+		}
+		sort.Strings(uris)
+		for _, uri := range uris {
+			if err := s.open(uri, s.documents[uri].text); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		var p DidCloseTextDocumentParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		delete(s.documents, p.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+			URI:         p.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+	case "textDocument/hover", "textDocument/definition", "textDocument/completion":
+		var p TextDocumentPositionParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		d, err := s.document(p.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		switch method {
+		case "textDocument/hover":
+			if h := s.hover(d, p.Position); h != nil {
+				return h, nil
+			}
+			return nil, nil
+		case "textDocument/definition":
+			return s.definition(d, p.Position), nil
+		}
+		return s.completion(d, p.Position), nil
+	case "textDocument/formatting":
+		var p DocumentFormattingParams
+		if err := unmarshalParams(params, &p); err != nil {
+			return nil, err
+		}
+		d, err := s.document(p.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return s.format(d)
+	}
+	if request {
+		return nil, &ResponseError{Code: codeMethodNotFound, Message: "unknown method " + method}
+	}
+	return nil, nil
+}
+
+// open records the text of the document at uri, and publishes its
+// diagnostics.
+func (s *Server) open(uri, text string) error {
+	d := newDocument(uri, text)
+	s.documents[uri] = d
+	return s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: s.diagnose(d),
+	})
+}
+
+// document returns the open document at uri.
+func (s *Server) document(uri string) (*document, error) {
+	d, ok := s.documents[uri]
+	if !ok {
+		return nil, &ResponseError{Code: codeInvalidParams, Message: "document not open: " + uri}
+	}
+	return d, nil
+}
+
+func unmarshalParams(params json.RawMessage, v interface{}) error {
+	if err := json.Unmarshal(params, v); err != nil {
+		return &ResponseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+// respond sends the response to the request with the given id.
+func (s *Server) respond(id *json.RawMessage, result interface{}, err error) error {
+	msg := &message{ID: id}
+	if id == nil {
+		null := json.RawMessage("null")
+		msg.ID = &null
+	}
+	if err != nil {
+		var rerr *ResponseError
+		if !errors.As(err, &rerr) {
+			rerr = &ResponseError{Code: codeInternalError, Message: err.Error()}
+		}
+		msg.Error = rerr
+		return writeMessage(s.out, msg)
+	}
+	b, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	msg.Result = b
+	return writeMessage(s.out, msg)
+}
+
+// notify sends a notification to the client.
+func (s *Server) notify(method string, params interface{}) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return writeMessage(s.out, &message{Method: method, Params: b})
+}
@@ -0,0 +1,215 @@
+package lsp_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/lsp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const uri = "untitled:test.dhall"
+
+func span(startLine, startChar, endLine, endChar int) lsp.Range {
+	return lsp.Range{
+		Start: lsp.Position{Line: startLine, Character: startChar},
+		End:   lsp.Position{Line: endLine, Character: endChar},
+	}
+}
+
+var _ = Describe("Server", func() {
+	var c *client
+	BeforeEach(func() {
+		c = newClient(&lsp.Server{})
+		var result lsp.InitializeResult
+		Expect(c.call("initialize", map[string]interface{}{}, &result)).To(BeNil())
+		Expect(result.Capabilities.HoverProvider).To(BeTrue())
+		c.notify("initialized", map[string]interface{}{})
+	})
+	AfterEach(func() {
+		Expect(c.close()).To(Succeed())
+	})
+
+	Describe("diagnostics", func() {
+		It("reports parse errors where they are", func() {
+			diagnostics := c.open(uri, "let x = 1\nin  x )")
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(diagnostics[0].Range).To(Equal(span(1, 6, 1, 6)))
+			Expect(diagnostics[0].Message).To(HavePrefix("expected "))
+			Expect(diagnostics[0].Severity).To(Equal(lsp.SeverityError))
+		})
+		It("reports type errors at the innermost expression which has one", func() {
+			diagnostics := c.open(uri, "λ(x : Natural) →\n  [ x + 1, x && True ]")
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(diagnostics[0].Range).To(Equal(span(1, 11, 1, 20)))
+			Expect(diagnostics[0].Message).To(HavePrefix("Type error: "))
+		})
+		It("blames a let binding's value for not matching its annotation", func() {
+			diagnostics := c.open(uri, "let x : Bool = 1 in x")
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(diagnostics[0].Range).To(Equal(span(0, 15, 0, 16)))
+		})
+		It("reports imports which can't be resolved", func() {
+			diagnostics := c.open(uri, "[ /no/such/file.dhall, /no/such/file ? 1 ]")
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(diagnostics[0].Range).To(Equal(span(0, 2, 0, 21)))
+			Expect(diagnostics[0].Message).To(HavePrefix("Import resolve error: "))
+		})
+		It("clears diagnostics once the document is fixed", func() {
+			Expect(c.open(uri, "1 + True")).To(HaveLen(1))
+			c.notify("textDocument/didChange", map[string]interface{}{
+				"textDocument":   map[string]interface{}{"uri": uri, "version": 2},
+				"contentChanges": []map[string]string{{"text": "1 + 1"}},
+			})
+			Expect(c.diagnostics().Diagnostics).To(BeEmpty())
+			c.notify("textDocument/didClose", map[string]interface{}{
+				"textDocument": map[string]string{"uri": uri},
+			})
+			Expect(c.diagnostics().Diagnostics).To(BeEmpty())
+		})
+	})
+
+	Describe("hover", func() {
+		hover := func(line, character int) string {
+			var result *lsp.Hover
+			Expect(c.call("textDocument/hover", at(uri, line, character), &result)).To(BeNil())
+			if result == nil {
+				return ""
+			}
+			return strings.TrimSuffix(strings.TrimPrefix(result.Contents.Value, "```dhall\n"), "\n```")
+		}
+		BeforeEach(func() {
+			Expect(c.open(uri, "let double = λ(n : Natural) → n * 2\nin  { a = double 1, c = \"🙂\", b = True }")).To(BeEmpty())
+		})
+		It("shows the types of variables in scope", func() {
+			Expect(hover(0, 30)).To(Equal("n : Natural"))
+			Expect(hover(1, 11)).To(Equal("double : ∀(n : Natural) → Natural"))
+		})
+		It("shows the types of bound names", func() {
+			Expect(hover(0, 5)).To(Equal("double : ∀(n : Natural) → Natural"))
+			Expect(hover(0, 15)).To(Equal("n : Natural"))
+		})
+		It("shows the types of expressions", func() {
+			Expect(hover(0, 33)).To(Equal("Natural"))
+			Expect(hover(1, 4)).To(Equal("{ a : Natural, b : Bool, c : Text }"))
+		})
+		It("counts positions in UTF-16 code units", func() {
+			Expect(hover(1, 34)).To(Equal("Bool"))
+		})
+		It("shows nothing outside the expression", func() {
+			Expect(c.open(uri, "-- comment\n1")).To(BeEmpty())
+			Expect(hover(0, 3)).To(Equal(""))
+			Expect(hover(1, 0)).To(Equal("Natural"))
+		})
+	})
+
+	Describe("definition", func() {
+		It("goes to the name bound by a let or λ", func() {
+			Expect(c.open(uri, "let x = 1\nlet f = λ(x : Natural) → x + x@1\nin  f x")).To(BeEmpty())
+			definition := func(line, character int) []lsp.Location {
+				var result []lsp.Location
+				Expect(c.call("textDocument/definition", at(uri, line, character), &result)).To(BeNil())
+				return result
+			}
+			Expect(definition(1, 26)).To(Equal([]lsp.Location{{URI: uri, Range: span(1, 10, 1, 11)}}))
+			Expect(definition(1, 30)).To(Equal([]lsp.Location{{URI: uri, Range: span(0, 4, 0, 5)}}))
+			Expect(definition(2, 6)).To(Equal([]lsp.Location{{URI: uri, Range: span(0, 4, 0, 5)}}))
+			Expect(definition(2, 4)).To(Equal([]lsp.Location{{URI: uri, Range: span(1, 4, 1, 5)}}))
+		})
+		Describe("of imports", func() {
+			var dir string
+			BeforeEach(func() {
+				var err error
+				dir, err = ioutil.TempDir("", "lsp")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "a.dhall"), []byte(`{ name = "a" }`), 0666)).To(Succeed())
+				Expect(os.Mkdir(filepath.Join(dir, "sub"), 0777)).To(Succeed())
+			})
+			AfterEach(func() {
+				os.RemoveAll(dir)
+			})
+			It("goes to the imported file, relative to the document", func() {
+				doc := "file://" + filepath.ToSlash(filepath.Join(dir, "sub", "main.dhall"))
+				Expect(c.open(doc, "(../a.dhall).name")).To(BeEmpty())
+				var result []lsp.Location
+				Expect(c.call("textDocument/definition", at(doc, 0, 3), &result)).To(BeNil())
+				Expect(result).To(Equal([]lsp.Location{{URI: "file://" + filepath.ToSlash(filepath.Join(dir, "a.dhall"))}}))
+			})
+			It("notices changes to imported files once a document is saved", func() {
+				doc := "file://" + filepath.ToSlash(filepath.Join(dir, "main.dhall"))
+				Expect(c.open(doc, "./a.dhall : { name : Text }")).To(BeEmpty())
+				Expect(ioutil.WriteFile(filepath.Join(dir, "a.dhall"), []byte(`{ name = 1 }`), 0666)).To(Succeed())
+				c.notify("textDocument/didSave", map[string]interface{}{
+					"textDocument": map[string]string{"uri": doc},
+				})
+				Expect(c.diagnostics().Diagnostics).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("completion", func() {
+		complete := func(text string) []string {
+			lines := strings.Split(text, "\n")
+			last := lines[len(lines)-1]
+			c.open(uri, text)
+			var result []lsp.CompletionItem
+			Expect(c.call("textDocument/completion", at(uri, len(lines)-1, len(last)), &result)).To(BeNil())
+			var labels []string
+			for _, item := range result {
+				labels = append(labels, item.Label+" "+item.Detail)
+			}
+			return labels
+		}
+		It("completes the fields of a record", func() {
+			Expect(complete("let r = { b = True, a = 1 }\nin  r.")).To(Equal([]string{"a Natural", "b Bool"}))
+		})
+		It("completes a partly typed field", func() {
+			Expect(complete("λ(r : { foo : Text, bar : Bool }) → r.fo")).To(Equal([]string{"bar Bool", "foo Text"}))
+		})
+		It("completes the alternatives of a union", func() {
+			Expect(complete("let U = < A | B : Natural > in U.")).To(Equal([]string{"A ", "B Natural"}))
+		})
+		It("completes nothing elsewhere", func() {
+			Expect(complete("let r = { a = 1 } in r")).To(BeEmpty())
+		})
+	})
+
+	Describe("formatting", func() {
+		It("formats the document, keeping its header", func() {
+			Expect(c.open(uri, "-- config\n\nlet x=1 in {a=x}")).To(BeEmpty())
+			var edits []lsp.TextEdit
+			Expect(c.call("textDocument/formatting", map[string]interface{}{
+				"textDocument": map[string]string{"uri": uri},
+			}, &edits)).To(BeNil())
+			Expect(edits).To(Equal([]lsp.TextEdit{{
+				Range:   span(0, 0, 2, 16),
+				NewText: "-- config\nlet x = 1\n\nin  { a = x }\n",
+			}}))
+		})
+		It("fails on a document which doesn't parse", func() {
+			c.open(uri, "let x =")
+			err := c.call("textDocument/formatting", map[string]interface{}{
+				"textDocument": map[string]string{"uri": uri},
+			}, nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	It("rejects unknown requests", func() {
+		err := c.call("workspace/symbol", map[string]interface{}{}, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Code).To(Equal(-32601))
+	})
+})
+
+var _ = Describe("Serve", func() {
+	It("fails if the client exits without shutting it down", func() {
+		c := newClient(&lsp.Server{})
+		c.notify("exit", nil)
+		Expect(<-c.done).To(Equal(lsp.ErrNoShutdown))
+	})
+})
@@ -0,0 +1,35 @@
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/philandstuff/dhall-golang/parser"
+)
+
+// largeExpression returns a long list of records, like a large
+// generated configuration file.
+func largeExpression() []byte {
+	var b strings.Builder
+	b.WriteString("let f = \\(x : Natural) -> { a = x, b = \"${Natural/show x}\" }\nin [\n")
+	for i := 0; i < 100; i++ {
+		if i > 0 {
+			b.WriteString(",\n")
+		}
+		b.WriteString(`  { name = "item", value = f 1 // { c = [ 1, 2, 3 ] }, ok = True && False, ` +
+			`kind = < A | B : Natural >.B 2, path = ./foo/bar.dhall }`)
+	}
+	b.WriteString("\n]\n")
+	return []byte(b.String())
+}
+
+func BenchmarkParse(b *testing.B) {
+	input := largeExpression()
+	b.ReportAllocs()
+	b.SetBytes(int64(len(input)))
+	for n := 0; n < b.N; n++ {
+		if _, err := parser.Parse("bench", input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
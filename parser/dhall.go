@@ -1,19632 +0,0 @@
-// Code generated by pigeon; DO NOT EDIT.
-
-package parser
-
-import (
-	"bytes"
-	"crypto/sha256"
-	"encoding/hex"
-	"errors"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"math"
-	"net"
-	"net/url"
-	"os"
-	"path"
-	"sort"
-	"strconv"
-	"strings"
-	"unicode"
-	"unicode/utf8"
-
-	. "github.com/philandstuff/dhall-golang/core"
-)
-
-// Helper function for parsing all the operator parsing blocks
-// see OrExpression for an example of how this is used
-func parseOperator(opcode int, first, rest interface{}) Term {
-	out := first.(Term)
-	if rest == nil {
-		return out
-	}
-	for _, b := range rest.([]interface{}) {
-		nextTerm := b.([]interface{})[3].(Term)
-		out = OpTerm{OpCode: opcode, L: out, R: nextTerm}
-	}
-	return out
-}
-
-func isNonCharacter(r rune) bool {
-	return r&0xfffe == 0xfffe
-}
-
-func validCodepoint(r rune) bool {
-	return utf8.ValidRune(r) && !isNonCharacter(r)
-}
-
-// Helper for parsing unicode code points
-func parseCodepoint(codepointText string) ([]byte, error) {
-	i, err := strconv.ParseInt(codepointText, 16, 32)
-	if err != nil {
-		return nil, err
-	}
-	r := rune(i)
-	if !validCodepoint(r) {
-		return nil, fmt.Errorf("%s is not a valid unicode code point", codepointText)
-	}
-	return []byte(string([]rune{r})), nil
-}
-
-var g = &grammar{
-	rules: []*rule{
-		{
-			name: "DhallFile",
-			pos:  position{line: 57, col: 1, offset: 1188},
-			expr: &actionExpr{
-				pos: position{line: 57, col: 13, offset: 1202},
-				run: (*parser).callonDhallFile1,
-				expr: &seqExpr{
-					pos: position{line: 57, col: 13, offset: 1202},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 57, col: 13, offset: 1202},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 15, offset: 1204},
-								name: "CompleteExpression",
-							},
-						},
-						&notExpr{
-							pos: position{line: 725, col: 7, offset: 23100},
-							expr: &anyMatcher{
-								line: 725, col: 8, offset: 23101,
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "CompleteExpression",
-			pos:  position{line: 59, col: 1, offset: 1246},
-			expr: &actionExpr{
-				pos: position{line: 59, col: 22, offset: 1269},
-				run: (*parser).callonCompleteExpression1,
-				expr: &seqExpr{
-					pos: position{line: 59, col: 22, offset: 1269},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 59, col: 22, offset: 1269},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 59, col: 24, offset: 1271},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 59, col: 26, offset: 1273},
-								name: "Expression",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 59, col: 37, offset: 1284},
-							name: "_",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "BlockComment",
-			pos:  position{line: 83, col: 1, offset: 1866},
-			expr: &seqExpr{
-				pos: position{line: 83, col: 16, offset: 1883},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 83, col: 16, offset: 1883},
-						val:        "{-",
-						ignoreCase: false,
-					},
-					&ruleRefExpr{
-						pos:  position{line: 83, col: 21, offset: 1888},
-						name: "BlockCommentContinue",
-					},
-				},
-			},
-		},
-		{
-			name: "BlockCommentContinue",
-			pos:  position{line: 91, col: 1, offset: 1983},
-			expr: &choiceExpr{
-				pos: position{line: 92, col: 7, offset: 2014},
-				alternatives: []interface{}{
-					&litMatcher{
-						pos:        position{line: 92, col: 7, offset: 2014},
-						val:        "-}",
-						ignoreCase: false,
-					},
-					&seqExpr{
-						pos: position{line: 93, col: 7, offset: 2025},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 93, col: 7, offset: 2025},
-								name: "BlockComment",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 93, col: 20, offset: 2038},
-								name: "BlockCommentContinue",
-							},
-						},
-					},
-					&seqExpr{
-						pos: position{line: 94, col: 7, offset: 2065},
-						exprs: []interface{}{
-							&choiceExpr{
-								pos: position{line: 86, col: 5, offset: 1935},
-								alternatives: []interface{}{
-									&charClassMatcher{
-										pos:        position{line: 86, col: 5, offset: 1935},
-										val:        "[𐀀D\\t\\n -\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-										chars:      []rune{'𐀀', 'D', '\t', '\n'},
-										ranges:     []rune{' ', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-									&actionExpr{
-										pos: position{line: 61, col: 14, offset: 1320},
-										run: (*parser).callonBlockCommentContinue9,
-										expr: &litMatcher{
-											pos:        position{line: 61, col: 14, offset: 1320},
-											val:        "\r\n",
-											ignoreCase: false,
-										},
-									},
-								},
-							},
-							&ruleRefExpr{
-								pos:  position{line: 94, col: 24, offset: 2082},
-								name: "BlockCommentContinue",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "WhitespaceChunk",
-			pos:  position{line: 100, col: 1, offset: 2249},
-			expr: &choiceExpr{
-				pos: position{line: 100, col: 19, offset: 2269},
-				alternatives: []interface{}{
-					&charClassMatcher{
-						pos:        position{line: 100, col: 19, offset: 2269},
-						val:        "[ \\t\\n]",
-						chars:      []rune{' ', '\t', '\n'},
-						ignoreCase: false,
-						inverted:   false,
-					},
-					&actionExpr{
-						pos: position{line: 61, col: 14, offset: 1320},
-						run: (*parser).callonWhitespaceChunk3,
-						expr: &litMatcher{
-							pos:        position{line: 61, col: 14, offset: 1320},
-							val:        "\r\n",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 98, col: 15, offset: 2167},
-						run: (*parser).callonWhitespaceChunk5,
-						expr: &seqExpr{
-							pos: position{line: 98, col: 15, offset: 2167},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 98, col: 15, offset: 2167},
-									val:        "--",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 98, col: 20, offset: 2172},
-									label: "content",
-									expr: &actionExpr{
-										pos: position{line: 98, col: 29, offset: 2181},
-										run: (*parser).callonWhitespaceChunk9,
-										expr: &zeroOrMoreExpr{
-											pos: position{line: 98, col: 29, offset: 2181},
-											expr: &charClassMatcher{
-												pos:        position{line: 96, col: 10, offset: 2115},
-												val:        "[𐀀D\\t -\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-												chars:      []rune{'𐀀', 'D', '\t'},
-												ranges:     []rune{' ', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-										},
-									},
-								},
-								&choiceExpr{
-									pos: position{line: 61, col: 7, offset: 1313},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 61, col: 7, offset: 1313},
-											val:        "\n",
-											ignoreCase: false,
-										},
-										&actionExpr{
-											pos: position{line: 61, col: 14, offset: 1320},
-											run: (*parser).callonWhitespaceChunk14,
-											expr: &litMatcher{
-												pos:        position{line: 61, col: 14, offset: 1320},
-												val:        "\r\n",
-												ignoreCase: false,
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 100, col: 52, offset: 2302},
-						name: "BlockComment",
-					},
-				},
-			},
-		},
-		{
-			name: "_",
-			pos:  position{line: 102, col: 1, offset: 2316},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 102, col: 5, offset: 2322},
-				expr: &ruleRefExpr{
-					pos:  position{line: 102, col: 5, offset: 2322},
-					name: "WhitespaceChunk",
-				},
-			},
-		},
-		{
-			name: "_1",
-			pos:  position{line: 104, col: 1, offset: 2340},
-			expr: &oneOrMoreExpr{
-				pos: position{line: 104, col: 6, offset: 2347},
-				expr: &ruleRefExpr{
-					pos:  position{line: 104, col: 6, offset: 2347},
-					name: "WhitespaceChunk",
-				},
-			},
-		},
-		{
-			name: "DoubleQuoteChunk",
-			pos:  position{line: 130, col: 1, offset: 3070},
-			expr: &choiceExpr{
-				pos: position{line: 131, col: 6, offset: 3096},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 131, col: 6, offset: 3096},
-						name: "Interpolation",
-					},
-					&actionExpr{
-						pos: position{line: 132, col: 6, offset: 3115},
-						run: (*parser).callonDoubleQuoteChunk3,
-						expr: &seqExpr{
-							pos: position{line: 132, col: 6, offset: 3115},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 132, col: 6, offset: 3115},
-									val:        "\\",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 132, col: 11, offset: 3120},
-									label: "e",
-									expr: &choiceExpr{
-										pos: position{line: 136, col: 8, offset: 3211},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 136, col: 8, offset: 3211},
-												val:        "[\"$\\\\/]",
-												chars:      []rune{'"', '$', '\\', '/'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-											&actionExpr{
-												pos: position{line: 140, col: 8, offset: 3256},
-												run: (*parser).callonDoubleQuoteChunk9,
-												expr: &litMatcher{
-													pos:        position{line: 140, col: 8, offset: 3256},
-													val:        "b",
-													ignoreCase: false,
-												},
-											},
-											&actionExpr{
-												pos: position{line: 141, col: 8, offset: 3296},
-												run: (*parser).callonDoubleQuoteChunk11,
-												expr: &litMatcher{
-													pos:        position{line: 141, col: 8, offset: 3296},
-													val:        "f",
-													ignoreCase: false,
-												},
-											},
-											&actionExpr{
-												pos: position{line: 142, col: 8, offset: 3336},
-												run: (*parser).callonDoubleQuoteChunk13,
-												expr: &litMatcher{
-													pos:        position{line: 142, col: 8, offset: 3336},
-													val:        "n",
-													ignoreCase: false,
-												},
-											},
-											&actionExpr{
-												pos: position{line: 143, col: 8, offset: 3376},
-												run: (*parser).callonDoubleQuoteChunk15,
-												expr: &litMatcher{
-													pos:        position{line: 143, col: 8, offset: 3376},
-													val:        "r",
-													ignoreCase: false,
-												},
-											},
-											&actionExpr{
-												pos: position{line: 144, col: 8, offset: 3416},
-												run: (*parser).callonDoubleQuoteChunk17,
-												expr: &litMatcher{
-													pos:        position{line: 144, col: 8, offset: 3416},
-													val:        "t",
-													ignoreCase: false,
-												},
-											},
-											&actionExpr{
-												pos: position{line: 145, col: 8, offset: 3456},
-												run: (*parser).callonDoubleQuoteChunk19,
-												expr: &seqExpr{
-													pos: position{line: 145, col: 8, offset: 3456},
-													exprs: []interface{}{
-														&litMatcher{
-															pos:        position{line: 145, col: 8, offset: 3456},
-															val:        "u",
-															ignoreCase: false,
-														},
-														&labeledExpr{
-															pos:   position{line: 145, col: 12, offset: 3460},
-															label: "u",
-															expr: &choiceExpr{
-																pos: position{line: 148, col: 9, offset: 3521},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 148, col: 9, offset: 3521},
-																		run: (*parser).callonDoubleQuoteChunk24,
-																		expr: &seqExpr{
-																			pos: position{line: 148, col: 9, offset: 3521},
-																			exprs: []interface{}{
-																				&choiceExpr{
-																					pos: position{line: 108, col: 10, offset: 2393},
-																					alternatives: []interface{}{
-																						&charClassMatcher{
-																							pos:        position{line: 106, col: 9, offset: 2375},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 108, col: 18, offset: 2401},
-																							val:        "[a-f]i",
-																							ranges:     []rune{'a', 'f'},
-																							ignoreCase: true,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																				&choiceExpr{
-																					pos: position{line: 108, col: 10, offset: 2393},
-																					alternatives: []interface{}{
-																						&charClassMatcher{
-																							pos:        position{line: 106, col: 9, offset: 2375},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 108, col: 18, offset: 2401},
-																							val:        "[a-f]i",
-																							ranges:     []rune{'a', 'f'},
-																							ignoreCase: true,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																				&choiceExpr{
-																					pos: position{line: 108, col: 10, offset: 2393},
-																					alternatives: []interface{}{
-																						&charClassMatcher{
-																							pos:        position{line: 106, col: 9, offset: 2375},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 108, col: 18, offset: 2401},
-																							val:        "[a-f]i",
-																							ranges:     []rune{'a', 'f'},
-																							ignoreCase: true,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																				&choiceExpr{
-																					pos: position{line: 108, col: 10, offset: 2393},
-																					alternatives: []interface{}{
-																						&charClassMatcher{
-																							pos:        position{line: 106, col: 9, offset: 2375},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 108, col: 18, offset: 2401},
-																							val:        "[a-f]i",
-																							ranges:     []rune{'a', 'f'},
-																							ignoreCase: true,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 151, col: 9, offset: 3619},
-																		run: (*parser).callonDoubleQuoteChunk38,
-																		expr: &seqExpr{
-																			pos: position{line: 151, col: 9, offset: 3619},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 151, col: 9, offset: 3619},
-																					val:        "{",
-																					ignoreCase: false,
-																				},
-																				&oneOrMoreExpr{
-																					pos: position{line: 151, col: 13, offset: 3623},
-																					expr: &choiceExpr{
-																						pos: position{line: 108, col: 10, offset: 2393},
-																						alternatives: []interface{}{
-																							&charClassMatcher{
-																								pos:        position{line: 106, col: 9, offset: 2375},
-																								val:        "[0-9]",
-																								ranges:     []rune{'0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																							&charClassMatcher{
-																								pos:        position{line: 108, col: 18, offset: 2401},
-																								val:        "[a-f]i",
-																								ranges:     []rune{'a', 'f'},
-																								ignoreCase: true,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																				&litMatcher{
-																					pos:        position{line: 151, col: 21, offset: 3631},
-																					val:        "}",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&charClassMatcher{
-						pos:        position{line: 156, col: 6, offset: 3740},
-						val:        "[𐀀D -!#-[]-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-						chars:      []rune{'𐀀', 'D'},
-						ranges:     []rune{' ', '!', '#', '[', ']', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
-						ignoreCase: false,
-						inverted:   false,
-					},
-				},
-			},
-		},
-		{
-			name: "DoubleQuoteLiteral",
-			pos:  position{line: 161, col: 1, offset: 3806},
-			expr: &actionExpr{
-				pos: position{line: 161, col: 22, offset: 3829},
-				run: (*parser).callonDoubleQuoteLiteral1,
-				expr: &seqExpr{
-					pos: position{line: 161, col: 22, offset: 3829},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 161, col: 22, offset: 3829},
-							val:        "\"",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 161, col: 26, offset: 3833},
-							label: "chunks",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 161, col: 33, offset: 3840},
-								expr: &ruleRefExpr{
-									pos:  position{line: 161, col: 33, offset: 3840},
-									name: "DoubleQuoteChunk",
-								},
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 161, col: 51, offset: 3858},
-							val:        "\"",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "SingleQuoteContinue",
-			pos:  position{line: 178, col: 1, offset: 4330},
-			expr: &choiceExpr{
-				pos: position{line: 179, col: 7, offset: 4360},
-				alternatives: []interface{}{
-					&seqExpr{
-						pos: position{line: 179, col: 7, offset: 4360},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 179, col: 7, offset: 4360},
-								name: "Interpolation",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 179, col: 21, offset: 4374},
-								name: "SingleQuoteContinue",
-							},
-						},
-					},
-					&seqExpr{
-						pos: position{line: 180, col: 7, offset: 4400},
-						exprs: []interface{}{
-							&actionExpr{
-								pos: position{line: 185, col: 20, offset: 4559},
-								run: (*parser).callonSingleQuoteContinue6,
-								expr: &litMatcher{
-									pos:        position{line: 185, col: 20, offset: 4559},
-									val:        "'''",
-									ignoreCase: false,
-								},
-							},
-							&ruleRefExpr{
-								pos:  position{line: 180, col: 24, offset: 4417},
-								name: "SingleQuoteContinue",
-							},
-						},
-					},
-					&seqExpr{
-						pos: position{line: 181, col: 7, offset: 4443},
-						exprs: []interface{}{
-							&actionExpr{
-								pos: position{line: 189, col: 24, offset: 4719},
-								run: (*parser).callonSingleQuoteContinue10,
-								expr: &litMatcher{
-									pos:        position{line: 189, col: 24, offset: 4719},
-									val:        "''${",
-									ignoreCase: false,
-								},
-							},
-							&ruleRefExpr{
-								pos:  position{line: 181, col: 28, offset: 4464},
-								name: "SingleQuoteContinue",
-							},
-						},
-					},
-					&seqExpr{
-						pos: position{line: 182, col: 7, offset: 4490},
-						exprs: []interface{}{
-							&choiceExpr{
-								pos: position{line: 192, col: 6, offset: 4786},
-								alternatives: []interface{}{
-									&charClassMatcher{
-										pos:        position{line: 192, col: 6, offset: 4786},
-										val:        "[𐀀D\\t\\n -\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-										chars:      []rune{'𐀀', 'D', '\t', '\n'},
-										ranges:     []rune{' ', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-									&actionExpr{
-										pos: position{line: 61, col: 14, offset: 1320},
-										run: (*parser).callonSingleQuoteContinue16,
-										expr: &litMatcher{
-											pos:        position{line: 61, col: 14, offset: 1320},
-											val:        "\r\n",
-											ignoreCase: false,
-										},
-									},
-								},
-							},
-							&ruleRefExpr{
-								pos:  position{line: 182, col: 23, offset: 4506},
-								name: "SingleQuoteContinue",
-							},
-						},
-					},
-					&litMatcher{
-						pos:        position{line: 183, col: 7, offset: 4532},
-						val:        "''",
-						ignoreCase: false,
-					},
-				},
-			},
-		},
-		{
-			name: "SingleQuoteLiteral",
-			pos:  position{line: 197, col: 1, offset: 4837},
-			expr: &actionExpr{
-				pos: position{line: 197, col: 22, offset: 4860},
-				run: (*parser).callonSingleQuoteLiteral1,
-				expr: &seqExpr{
-					pos: position{line: 197, col: 22, offset: 4860},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 197, col: 22, offset: 4860},
-							val:        "''",
-							ignoreCase: false,
-						},
-						&choiceExpr{
-							pos: position{line: 61, col: 7, offset: 1313},
-							alternatives: []interface{}{
-								&litMatcher{
-									pos:        position{line: 61, col: 7, offset: 1313},
-									val:        "\n",
-									ignoreCase: false,
-								},
-								&actionExpr{
-									pos: position{line: 61, col: 14, offset: 1320},
-									run: (*parser).callonSingleQuoteLiteral6,
-									expr: &litMatcher{
-										pos:        position{line: 61, col: 14, offset: 1320},
-										val:        "\r\n",
-										ignoreCase: false,
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 197, col: 31, offset: 4869},
-							label: "content",
-							expr: &ruleRefExpr{
-								pos:  position{line: 197, col: 39, offset: 4877},
-								name: "SingleQuoteContinue",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Interpolation",
-			pos:  position{line: 215, col: 1, offset: 5431},
-			expr: &actionExpr{
-				pos: position{line: 215, col: 17, offset: 5449},
-				run: (*parser).callonInterpolation1,
-				expr: &seqExpr{
-					pos: position{line: 215, col: 17, offset: 5449},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 215, col: 17, offset: 5449},
-							val:        "${",
-							ignoreCase: false,
-						},
-						&labeledExpr{
-							pos:   position{line: 215, col: 22, offset: 5454},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 215, col: 24, offset: 5456},
-								name: "CompleteExpression",
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 215, col: 43, offset: 5475},
-							val:        "}",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "TextLiteral",
-			pos:  position{line: 217, col: 1, offset: 5498},
-			expr: &choiceExpr{
-				pos: position{line: 217, col: 15, offset: 5514},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 217, col: 15, offset: 5514},
-						name: "DoubleQuoteLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 217, col: 36, offset: 5535},
-						name: "SingleQuoteLiteral",
-					},
-				},
-			},
-		},
-		{
-			name: "DeBruijn",
-			pos:  position{line: 324, col: 1, offset: 8542},
-			expr: &actionExpr{
-				pos: position{line: 324, col: 12, offset: 8555},
-				run: (*parser).callonDeBruijn1,
-				expr: &seqExpr{
-					pos: position{line: 324, col: 12, offset: 8555},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 324, col: 12, offset: 8555},
-							name: "_",
-						},
-						&litMatcher{
-							pos:        position{line: 324, col: 14, offset: 8557},
-							val:        "@",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 324, col: 18, offset: 8561},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 324, col: 20, offset: 8563},
-							label: "index",
-							expr: &actionExpr{
-								pos: position{line: 311, col: 18, offset: 8278},
-								run: (*parser).callonDeBruijn7,
-								expr: &oneOrMoreExpr{
-									pos: position{line: 311, col: 18, offset: 8278},
-									expr: &charClassMatcher{
-										pos:        position{line: 106, col: 9, offset: 2375},
-										val:        "[0-9]",
-										ranges:     []rune{'0', '9'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Variable",
-			pos:  position{line: 326, col: 1, offset: 8625},
-			expr: &actionExpr{
-				pos: position{line: 326, col: 12, offset: 8638},
-				run: (*parser).callonVariable1,
-				expr: &seqExpr{
-					pos: position{line: 326, col: 12, offset: 8638},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 326, col: 12, offset: 8638},
-							label: "name",
-							expr: &choiceExpr{
-								pos: position{line: 124, col: 20, offset: 2920},
-								alternatives: []interface{}{
-									&actionExpr{
-										pos: position{line: 124, col: 20, offset: 2920},
-										run: (*parser).callonVariable5,
-										expr: &seqExpr{
-											pos: position{line: 124, col: 20, offset: 2920},
-											exprs: []interface{}{
-												&andExpr{
-													pos: position{line: 124, col: 20, offset: 2920},
-													expr: &seqExpr{
-														pos: position{line: 124, col: 22, offset: 2922},
-														exprs: []interface{}{
-															&choiceExpr{
-																pos: position{line: 221, col: 5, offset: 5657},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		run: (*parser).callonVariable10,
-																		expr: &litMatcher{
-																			pos:        position{line: 221, col: 5, offset: 5657},
-																			val:        "Natural/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 222, col: 5, offset: 5706},
-																		run: (*parser).callonVariable12,
-																		expr: &litMatcher{
-																			pos:        position{line: 222, col: 5, offset: 5706},
-																			val:        "Natural/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 223, col: 5, offset: 5753},
-																		run: (*parser).callonVariable14,
-																		expr: &litMatcher{
-																			pos:        position{line: 223, col: 5, offset: 5753},
-																			val:        "Natural/isZero",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 224, col: 5, offset: 5804},
-																		run: (*parser).callonVariable16,
-																		expr: &litMatcher{
-																			pos:        position{line: 224, col: 5, offset: 5804},
-																			val:        "Natural/even",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 225, col: 5, offset: 5851},
-																		run: (*parser).callonVariable18,
-																		expr: &litMatcher{
-																			pos:        position{line: 225, col: 5, offset: 5851},
-																			val:        "Natural/odd",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 226, col: 5, offset: 5896},
-																		run: (*parser).callonVariable20,
-																		expr: &litMatcher{
-																			pos:        position{line: 226, col: 5, offset: 5896},
-																			val:        "Natural/toInteger",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 227, col: 5, offset: 5953},
-																		run: (*parser).callonVariable22,
-																		expr: &litMatcher{
-																			pos:        position{line: 227, col: 5, offset: 5953},
-																			val:        "Natural/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 6000},
-																		run: (*parser).callonVariable24,
-																		expr: &litMatcher{
-																			pos:        position{line: 228, col: 5, offset: 6000},
-																			val:        "Natural/subtract",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 229, col: 5, offset: 6055},
-																		run: (*parser).callonVariable26,
-																		expr: &litMatcher{
-																			pos:        position{line: 229, col: 5, offset: 6055},
-																			val:        "Integer/toDouble",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 230, col: 5, offset: 6110},
-																		run: (*parser).callonVariable28,
-																		expr: &litMatcher{
-																			pos:        position{line: 230, col: 5, offset: 6110},
-																			val:        "Integer/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 231, col: 5, offset: 6157},
-																		run: (*parser).callonVariable30,
-																		expr: &litMatcher{
-																			pos:        position{line: 231, col: 5, offset: 6157},
-																			val:        "Double/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 232, col: 5, offset: 6202},
-																		run: (*parser).callonVariable32,
-																		expr: &litMatcher{
-																			pos:        position{line: 232, col: 5, offset: 6202},
-																			val:        "List/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 233, col: 5, offset: 6245},
-																		run: (*parser).callonVariable34,
-																		expr: &litMatcher{
-																			pos:        position{line: 233, col: 5, offset: 6245},
-																			val:        "List/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 234, col: 5, offset: 6286},
-																		run: (*parser).callonVariable36,
-																		expr: &litMatcher{
-																			pos:        position{line: 234, col: 5, offset: 6286},
-																			val:        "List/length",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 235, col: 5, offset: 6331},
-																		run: (*parser).callonVariable38,
-																		expr: &litMatcher{
-																			pos:        position{line: 235, col: 5, offset: 6331},
-																			val:        "List/head",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 236, col: 5, offset: 6372},
-																		run: (*parser).callonVariable40,
-																		expr: &litMatcher{
-																			pos:        position{line: 236, col: 5, offset: 6372},
-																			val:        "List/last",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 237, col: 5, offset: 6413},
-																		run: (*parser).callonVariable42,
-																		expr: &litMatcher{
-																			pos:        position{line: 237, col: 5, offset: 6413},
-																			val:        "List/indexed",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 238, col: 5, offset: 6460},
-																		run: (*parser).callonVariable44,
-																		expr: &litMatcher{
-																			pos:        position{line: 238, col: 5, offset: 6460},
-																			val:        "List/reverse",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 239, col: 5, offset: 6507},
-																		run: (*parser).callonVariable46,
-																		expr: &litMatcher{
-																			pos:        position{line: 239, col: 5, offset: 6507},
-																			val:        "Optional/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 240, col: 5, offset: 6558},
-																		run: (*parser).callonVariable48,
-																		expr: &litMatcher{
-																			pos:        position{line: 240, col: 5, offset: 6558},
-																			val:        "Optional/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 241, col: 5, offset: 6607},
-																		run: (*parser).callonVariable50,
-																		expr: &litMatcher{
-																			pos:        position{line: 241, col: 5, offset: 6607},
-																			val:        "Text/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 242, col: 5, offset: 6648},
-																		run: (*parser).callonVariable52,
-																		expr: &litMatcher{
-																			pos:        position{line: 242, col: 5, offset: 6648},
-																			val:        "Bool",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 243, col: 5, offset: 6680},
-																		run: (*parser).callonVariable54,
-																		expr: &litMatcher{
-																			pos:        position{line: 243, col: 5, offset: 6680},
-																			val:        "True",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 244, col: 5, offset: 6712},
-																		run: (*parser).callonVariable56,
-																		expr: &litMatcher{
-																			pos:        position{line: 244, col: 5, offset: 6712},
-																			val:        "False",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 245, col: 5, offset: 6746},
-																		run: (*parser).callonVariable58,
-																		expr: &litMatcher{
-																			pos:        position{line: 245, col: 5, offset: 6746},
-																			val:        "Optional",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 246, col: 5, offset: 6786},
-																		run: (*parser).callonVariable60,
-																		expr: &litMatcher{
-																			pos:        position{line: 246, col: 5, offset: 6786},
-																			val:        "Natural",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 247, col: 5, offset: 6824},
-																		run: (*parser).callonVariable62,
-																		expr: &litMatcher{
-																			pos:        position{line: 247, col: 5, offset: 6824},
-																			val:        "Integer",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 248, col: 5, offset: 6862},
-																		run: (*parser).callonVariable64,
-																		expr: &litMatcher{
-																			pos:        position{line: 248, col: 5, offset: 6862},
-																			val:        "Double",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 249, col: 5, offset: 6898},
-																		run: (*parser).callonVariable66,
-																		expr: &litMatcher{
-																			pos:        position{line: 249, col: 5, offset: 6898},
-																			val:        "Text",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 250, col: 5, offset: 6930},
-																		run: (*parser).callonVariable68,
-																		expr: &litMatcher{
-																			pos:        position{line: 250, col: 5, offset: 6930},
-																			val:        "List",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 251, col: 5, offset: 6962},
-																		run: (*parser).callonVariable70,
-																		expr: &litMatcher{
-																			pos:        position{line: 251, col: 5, offset: 6962},
-																			val:        "None",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 252, col: 5, offset: 6994},
-																		run: (*parser).callonVariable72,
-																		expr: &litMatcher{
-																			pos:        position{line: 252, col: 5, offset: 6994},
-																			val:        "Type",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 253, col: 5, offset: 7026},
-																		run: (*parser).callonVariable74,
-																		expr: &litMatcher{
-																			pos:        position{line: 253, col: 5, offset: 7026},
-																			val:        "Kind",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 254, col: 5, offset: 7058},
-																		run: (*parser).callonVariable76,
-																		expr: &litMatcher{
-																			pos:        position{line: 254, col: 5, offset: 7058},
-																			val:        "Sort",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-															&charClassMatcher{
-																pos:        position{line: 111, col: 23, offset: 2468},
-																val:        "[_/-A-Za-z0-9]",
-																chars:      []rune{'_', '/', '-'},
-																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																ignoreCase: false,
-																inverted:   false,
-															},
-														},
-													},
-												},
-												&labeledExpr{
-													pos:   position{line: 124, col: 52, offset: 2952},
-													label: "label",
-													expr: &choiceExpr{
-														pos: position{line: 121, col: 9, offset: 2802},
-														alternatives: []interface{}{
-															&actionExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																run: (*parser).callonVariable81,
-																expr: &seqExpr{
-																	pos: position{line: 121, col: 9, offset: 2802},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 121, col: 9, offset: 2802},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																		&labeledExpr{
-																			pos:   position{line: 121, col: 13, offset: 2806},
-																			label: "label",
-																			expr: &actionExpr{
-																				pos: position{line: 119, col: 15, offset: 2743},
-																				run: (*parser).callonVariable85,
-																				expr: &oneOrMoreExpr{
-																					pos: position{line: 119, col: 15, offset: 2743},
-																					expr: &charClassMatcher{
-																						pos:        position{line: 118, col: 19, offset: 2706},
-																						val:        "[ -_a-~]",
-																						ranges:     []rune{' ', '_', 'a', '~'},
-																						ignoreCase: false,
-																						inverted:   false,
-																					},
-																				},
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 121, col: 31, offset: 2824},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-															&actionExpr{
-																pos: position{line: 122, col: 9, offset: 2858},
-																run: (*parser).callonVariable89,
-																expr: &labeledExpr{
-																	pos:   position{line: 122, col: 9, offset: 2858},
-																	label: "label",
-																	expr: &choiceExpr{
-																		pos: position{line: 112, col: 15, offset: 2499},
-																		alternatives: []interface{}{
-																			&actionExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				run: (*parser).callonVariable92,
-																				expr: &seqExpr{
-																					pos: position{line: 112, col: 15, offset: 2499},
-																					exprs: []interface{}{
-																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
-																							alternatives: []interface{}{
-																								&litMatcher{
-																									pos:        position{line: 256, col: 6, offset: 7094},
-																									val:        "if",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 257, col: 8, offset: 7108},
-																									val:        "then",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 258, col: 8, offset: 7124},
-																									val:        "else",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 259, col: 7, offset: 7139},
-																									val:        "let",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 260, col: 6, offset: 7152},
-																									val:        "in",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 262, col: 9, offset: 7179},
-																									val:        "using",
-																									ignoreCase: false,
-																								},
-																								&actionExpr{
-																									pos: position{line: 264, col: 11, offset: 7217},
-																									run: (*parser).callonVariable101,
-																									expr: &litMatcher{
-																										pos:        position{line: 264, col: 11, offset: 7217},
-																										val:        "missing",
-																										ignoreCase: false,
-																									},
-																								},
-																								&litMatcher{
-																									pos:        position{line: 261, col: 6, offset: 7164},
-																									val:        "as",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 265, col: 8, offset: 7262},
-																									val:        "True",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 266, col: 9, offset: 7279},
-																									val:        "False",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 267, col: 12, offset: 7300},
-																									val:        "Infinity",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 268, col: 7, offset: 7319},
-																									val:        "NaN",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 263, col: 9, offset: 7197},
-																									val:        "merge",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 269, col: 8, offset: 7334},
-																									val:        "Some",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 270, col: 9, offset: 7351},
-																									val:        "toMap",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
-																								},
-																							},
-																						},
-																						&oneOrMoreExpr{
-																							pos: position{line: 112, col: 23, offset: 2507},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 113, col: 13, offset: 2571},
-																				run: (*parser).callonVariable114,
-																				expr: &seqExpr{
-																					pos: position{line: 113, col: 13, offset: 2571},
-																					exprs: []interface{}{
-																						&notExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
-																								alternatives: []interface{}{
-																									&litMatcher{
-																										pos:        position{line: 256, col: 6, offset: 7094},
-																										val:        "if",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 257, col: 8, offset: 7108},
-																										val:        "then",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 258, col: 8, offset: 7124},
-																										val:        "else",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 259, col: 7, offset: 7139},
-																										val:        "let",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 260, col: 6, offset: 7152},
-																										val:        "in",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 262, col: 9, offset: 7179},
-																										val:        "using",
-																										ignoreCase: false,
-																									},
-																									&actionExpr{
-																										pos: position{line: 264, col: 11, offset: 7217},
-																										run: (*parser).callonVariable124,
-																										expr: &litMatcher{
-																											pos:        position{line: 264, col: 11, offset: 7217},
-																											val:        "missing",
-																											ignoreCase: false,
-																										},
-																									},
-																									&litMatcher{
-																										pos:        position{line: 261, col: 6, offset: 7164},
-																										val:        "as",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 265, col: 8, offset: 7262},
-																										val:        "True",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 266, col: 9, offset: 7279},
-																										val:        "False",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 267, col: 12, offset: 7300},
-																										val:        "Infinity",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 268, col: 7, offset: 7319},
-																										val:        "NaN",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 263, col: 9, offset: 7197},
-																										val:        "merge",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 269, col: 8, offset: 7334},
-																										val:        "Some",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 270, col: 9, offset: 7351},
-																										val:        "toMap",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
-																									},
-																								},
-																							},
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 110, col: 24, offset: 2434},
-																							val:        "[_A-Za-z]",
-																							chars:      []rune{'_'},
-																							ranges:     []rune{'A', 'Z', 'a', 'z'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&zeroOrMoreExpr{
-																							pos: position{line: 113, col: 43, offset: 2601},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									&actionExpr{
-										pos: position{line: 125, col: 19, offset: 3004},
-										run: (*parser).callonVariable138,
-										expr: &seqExpr{
-											pos: position{line: 125, col: 19, offset: 3004},
-											exprs: []interface{}{
-												&notExpr{
-													pos: position{line: 125, col: 19, offset: 3004},
-													expr: &choiceExpr{
-														pos: position{line: 221, col: 5, offset: 5657},
-														alternatives: []interface{}{
-															&actionExpr{
-																pos: position{line: 221, col: 5, offset: 5657},
-																run: (*parser).callonVariable142,
-																expr: &litMatcher{
-																	pos:        position{line: 221, col: 5, offset: 5657},
-																	val:        "Natural/build",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 222, col: 5, offset: 5706},
-																run: (*parser).callonVariable144,
-																expr: &litMatcher{
-																	pos:        position{line: 222, col: 5, offset: 5706},
-																	val:        "Natural/fold",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 223, col: 5, offset: 5753},
-																run: (*parser).callonVariable146,
-																expr: &litMatcher{
-																	pos:        position{line: 223, col: 5, offset: 5753},
-																	val:        "Natural/isZero",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 224, col: 5, offset: 5804},
-																run: (*parser).callonVariable148,
-																expr: &litMatcher{
-																	pos:        position{line: 224, col: 5, offset: 5804},
-																	val:        "Natural/even",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 225, col: 5, offset: 5851},
-																run: (*parser).callonVariable150,
-																expr: &litMatcher{
-																	pos:        position{line: 225, col: 5, offset: 5851},
-																	val:        "Natural/odd",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 226, col: 5, offset: 5896},
-																run: (*parser).callonVariable152,
-																expr: &litMatcher{
-																	pos:        position{line: 226, col: 5, offset: 5896},
-																	val:        "Natural/toInteger",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 227, col: 5, offset: 5953},
-																run: (*parser).callonVariable154,
-																expr: &litMatcher{
-																	pos:        position{line: 227, col: 5, offset: 5953},
-																	val:        "Natural/show",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 228, col: 5, offset: 6000},
-																run: (*parser).callonVariable156,
-																expr: &litMatcher{
-																	pos:        position{line: 228, col: 5, offset: 6000},
-																	val:        "Natural/subtract",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 229, col: 5, offset: 6055},
-																run: (*parser).callonVariable158,
-																expr: &litMatcher{
-																	pos:        position{line: 229, col: 5, offset: 6055},
-																	val:        "Integer/toDouble",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 230, col: 5, offset: 6110},
-																run: (*parser).callonVariable160,
-																expr: &litMatcher{
-																	pos:        position{line: 230, col: 5, offset: 6110},
-																	val:        "Integer/show",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 231, col: 5, offset: 6157},
-																run: (*parser).callonVariable162,
-																expr: &litMatcher{
-																	pos:        position{line: 231, col: 5, offset: 6157},
-																	val:        "Double/show",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 232, col: 5, offset: 6202},
-																run: (*parser).callonVariable164,
-																expr: &litMatcher{
-																	pos:        position{line: 232, col: 5, offset: 6202},
-																	val:        "List/build",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 233, col: 5, offset: 6245},
-																run: (*parser).callonVariable166,
-																expr: &litMatcher{
-																	pos:        position{line: 233, col: 5, offset: 6245},
-																	val:        "List/fold",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 234, col: 5, offset: 6286},
-																run: (*parser).callonVariable168,
-																expr: &litMatcher{
-																	pos:        position{line: 234, col: 5, offset: 6286},
-																	val:        "List/length",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 235, col: 5, offset: 6331},
-																run: (*parser).callonVariable170,
-																expr: &litMatcher{
-																	pos:        position{line: 235, col: 5, offset: 6331},
-																	val:        "List/head",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 236, col: 5, offset: 6372},
-																run: (*parser).callonVariable172,
-																expr: &litMatcher{
-																	pos:        position{line: 236, col: 5, offset: 6372},
-																	val:        "List/last",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 237, col: 5, offset: 6413},
-																run: (*parser).callonVariable174,
-																expr: &litMatcher{
-																	pos:        position{line: 237, col: 5, offset: 6413},
-																	val:        "List/indexed",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 238, col: 5, offset: 6460},
-																run: (*parser).callonVariable176,
-																expr: &litMatcher{
-																	pos:        position{line: 238, col: 5, offset: 6460},
-																	val:        "List/reverse",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 239, col: 5, offset: 6507},
-																run: (*parser).callonVariable178,
-																expr: &litMatcher{
-																	pos:        position{line: 239, col: 5, offset: 6507},
-																	val:        "Optional/build",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 240, col: 5, offset: 6558},
-																run: (*parser).callonVariable180,
-																expr: &litMatcher{
-																	pos:        position{line: 240, col: 5, offset: 6558},
-																	val:        "Optional/fold",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 241, col: 5, offset: 6607},
-																run: (*parser).callonVariable182,
-																expr: &litMatcher{
-																	pos:        position{line: 241, col: 5, offset: 6607},
-																	val:        "Text/show",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 242, col: 5, offset: 6648},
-																run: (*parser).callonVariable184,
-																expr: &litMatcher{
-																	pos:        position{line: 242, col: 5, offset: 6648},
-																	val:        "Bool",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 243, col: 5, offset: 6680},
-																run: (*parser).callonVariable186,
-																expr: &litMatcher{
-																	pos:        position{line: 243, col: 5, offset: 6680},
-																	val:        "True",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 244, col: 5, offset: 6712},
-																run: (*parser).callonVariable188,
-																expr: &litMatcher{
-																	pos:        position{line: 244, col: 5, offset: 6712},
-																	val:        "False",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 245, col: 5, offset: 6746},
-																run: (*parser).callonVariable190,
-																expr: &litMatcher{
-																	pos:        position{line: 245, col: 5, offset: 6746},
-																	val:        "Optional",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 246, col: 5, offset: 6786},
-																run: (*parser).callonVariable192,
-																expr: &litMatcher{
-																	pos:        position{line: 246, col: 5, offset: 6786},
-																	val:        "Natural",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 247, col: 5, offset: 6824},
-																run: (*parser).callonVariable194,
-																expr: &litMatcher{
-																	pos:        position{line: 247, col: 5, offset: 6824},
-																	val:        "Integer",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 248, col: 5, offset: 6862},
-																run: (*parser).callonVariable196,
-																expr: &litMatcher{
-																	pos:        position{line: 248, col: 5, offset: 6862},
-																	val:        "Double",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 249, col: 5, offset: 6898},
-																run: (*parser).callonVariable198,
-																expr: &litMatcher{
-																	pos:        position{line: 249, col: 5, offset: 6898},
-																	val:        "Text",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 250, col: 5, offset: 6930},
-																run: (*parser).callonVariable200,
-																expr: &litMatcher{
-																	pos:        position{line: 250, col: 5, offset: 6930},
-																	val:        "List",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 251, col: 5, offset: 6962},
-																run: (*parser).callonVariable202,
-																expr: &litMatcher{
-																	pos:        position{line: 251, col: 5, offset: 6962},
-																	val:        "None",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 252, col: 5, offset: 6994},
-																run: (*parser).callonVariable204,
-																expr: &litMatcher{
-																	pos:        position{line: 252, col: 5, offset: 6994},
-																	val:        "Type",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 253, col: 5, offset: 7026},
-																run: (*parser).callonVariable206,
-																expr: &litMatcher{
-																	pos:        position{line: 253, col: 5, offset: 7026},
-																	val:        "Kind",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 254, col: 5, offset: 7058},
-																run: (*parser).callonVariable208,
-																expr: &litMatcher{
-																	pos:        position{line: 254, col: 5, offset: 7058},
-																	val:        "Sort",
-																	ignoreCase: false,
-																},
-															},
-														},
-													},
-												},
-												&labeledExpr{
-													pos:   position{line: 125, col: 29, offset: 3014},
-													label: "label",
-													expr: &choiceExpr{
-														pos: position{line: 121, col: 9, offset: 2802},
-														alternatives: []interface{}{
-															&actionExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																run: (*parser).callonVariable212,
-																expr: &seqExpr{
-																	pos: position{line: 121, col: 9, offset: 2802},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 121, col: 9, offset: 2802},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																		&labeledExpr{
-																			pos:   position{line: 121, col: 13, offset: 2806},
-																			label: "label",
-																			expr: &actionExpr{
-																				pos: position{line: 119, col: 15, offset: 2743},
-																				run: (*parser).callonVariable216,
-																				expr: &oneOrMoreExpr{
-																					pos: position{line: 119, col: 15, offset: 2743},
-																					expr: &charClassMatcher{
-																						pos:        position{line: 118, col: 19, offset: 2706},
-																						val:        "[ -_a-~]",
-																						ranges:     []rune{' ', '_', 'a', '~'},
-																						ignoreCase: false,
-																						inverted:   false,
-																					},
-																				},
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 121, col: 31, offset: 2824},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-															&actionExpr{
-																pos: position{line: 122, col: 9, offset: 2858},
-																run: (*parser).callonVariable220,
-																expr: &labeledExpr{
-																	pos:   position{line: 122, col: 9, offset: 2858},
-																	label: "label",
-																	expr: &choiceExpr{
-																		pos: position{line: 112, col: 15, offset: 2499},
-																		alternatives: []interface{}{
-																			&actionExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				run: (*parser).callonVariable223,
-																				expr: &seqExpr{
-																					pos: position{line: 112, col: 15, offset: 2499},
-																					exprs: []interface{}{
-																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
-																							alternatives: []interface{}{
-																								&litMatcher{
-																									pos:        position{line: 256, col: 6, offset: 7094},
-																									val:        "if",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 257, col: 8, offset: 7108},
-																									val:        "then",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 258, col: 8, offset: 7124},
-																									val:        "else",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 259, col: 7, offset: 7139},
-																									val:        "let",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 260, col: 6, offset: 7152},
-																									val:        "in",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 262, col: 9, offset: 7179},
-																									val:        "using",
-																									ignoreCase: false,
-																								},
-																								&actionExpr{
-																									pos: position{line: 264, col: 11, offset: 7217},
-																									run: (*parser).callonVariable232,
-																									expr: &litMatcher{
-																										pos:        position{line: 264, col: 11, offset: 7217},
-																										val:        "missing",
-																										ignoreCase: false,
-																									},
-																								},
-																								&litMatcher{
-																									pos:        position{line: 261, col: 6, offset: 7164},
-																									val:        "as",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 265, col: 8, offset: 7262},
-																									val:        "True",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 266, col: 9, offset: 7279},
-																									val:        "False",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 267, col: 12, offset: 7300},
-																									val:        "Infinity",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 268, col: 7, offset: 7319},
-																									val:        "NaN",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 263, col: 9, offset: 7197},
-																									val:        "merge",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 269, col: 8, offset: 7334},
-																									val:        "Some",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 270, col: 9, offset: 7351},
-																									val:        "toMap",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
-																								},
-																							},
-																						},
-																						&oneOrMoreExpr{
-																							pos: position{line: 112, col: 23, offset: 2507},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 113, col: 13, offset: 2571},
-																				run: (*parser).callonVariable245,
-																				expr: &seqExpr{
-																					pos: position{line: 113, col: 13, offset: 2571},
-																					exprs: []interface{}{
-																						&notExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
-																								alternatives: []interface{}{
-																									&litMatcher{
-																										pos:        position{line: 256, col: 6, offset: 7094},
-																										val:        "if",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 257, col: 8, offset: 7108},
-																										val:        "then",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 258, col: 8, offset: 7124},
-																										val:        "else",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 259, col: 7, offset: 7139},
-																										val:        "let",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 260, col: 6, offset: 7152},
-																										val:        "in",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 262, col: 9, offset: 7179},
-																										val:        "using",
-																										ignoreCase: false,
-																									},
-																									&actionExpr{
-																										pos: position{line: 264, col: 11, offset: 7217},
-																										run: (*parser).callonVariable255,
-																										expr: &litMatcher{
-																											pos:        position{line: 264, col: 11, offset: 7217},
-																											val:        "missing",
-																											ignoreCase: false,
-																										},
-																									},
-																									&litMatcher{
-																										pos:        position{line: 261, col: 6, offset: 7164},
-																										val:        "as",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 265, col: 8, offset: 7262},
-																										val:        "True",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 266, col: 9, offset: 7279},
-																										val:        "False",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 267, col: 12, offset: 7300},
-																										val:        "Infinity",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 268, col: 7, offset: 7319},
-																										val:        "NaN",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 263, col: 9, offset: 7197},
-																										val:        "merge",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 269, col: 8, offset: 7334},
-																										val:        "Some",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 270, col: 9, offset: 7351},
-																										val:        "toMap",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
-																									},
-																								},
-																							},
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 110, col: 24, offset: 2434},
-																							val:        "[_A-Za-z]",
-																							chars:      []rune{'_'},
-																							ranges:     []rune{'A', 'Z', 'a', 'z'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&zeroOrMoreExpr{
-																							pos: position{line: 113, col: 43, offset: 2601},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 326, col: 34, offset: 8660},
-							label: "index",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 326, col: 40, offset: 8666},
-								expr: &ruleRefExpr{
-									pos:  position{line: 326, col: 40, offset: 8666},
-									name: "DeBruijn",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Identifier",
-			pos:  position{line: 334, col: 1, offset: 8829},
-			expr: &choiceExpr{
-				pos: position{line: 334, col: 14, offset: 8844},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 334, col: 14, offset: 8844},
-						name: "Variable",
-					},
-					&actionExpr{
-						pos: position{line: 221, col: 5, offset: 5657},
-						run: (*parser).callonIdentifier3,
-						expr: &litMatcher{
-							pos:        position{line: 221, col: 5, offset: 5657},
-							val:        "Natural/build",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 222, col: 5, offset: 5706},
-						run: (*parser).callonIdentifier5,
-						expr: &litMatcher{
-							pos:        position{line: 222, col: 5, offset: 5706},
-							val:        "Natural/fold",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 223, col: 5, offset: 5753},
-						run: (*parser).callonIdentifier7,
-						expr: &litMatcher{
-							pos:        position{line: 223, col: 5, offset: 5753},
-							val:        "Natural/isZero",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 224, col: 5, offset: 5804},
-						run: (*parser).callonIdentifier9,
-						expr: &litMatcher{
-							pos:        position{line: 224, col: 5, offset: 5804},
-							val:        "Natural/even",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 225, col: 5, offset: 5851},
-						run: (*parser).callonIdentifier11,
-						expr: &litMatcher{
-							pos:        position{line: 225, col: 5, offset: 5851},
-							val:        "Natural/odd",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 226, col: 5, offset: 5896},
-						run: (*parser).callonIdentifier13,
-						expr: &litMatcher{
-							pos:        position{line: 226, col: 5, offset: 5896},
-							val:        "Natural/toInteger",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 227, col: 5, offset: 5953},
-						run: (*parser).callonIdentifier15,
-						expr: &litMatcher{
-							pos:        position{line: 227, col: 5, offset: 5953},
-							val:        "Natural/show",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 228, col: 5, offset: 6000},
-						run: (*parser).callonIdentifier17,
-						expr: &litMatcher{
-							pos:        position{line: 228, col: 5, offset: 6000},
-							val:        "Natural/subtract",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 229, col: 5, offset: 6055},
-						run: (*parser).callonIdentifier19,
-						expr: &litMatcher{
-							pos:        position{line: 229, col: 5, offset: 6055},
-							val:        "Integer/toDouble",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 230, col: 5, offset: 6110},
-						run: (*parser).callonIdentifier21,
-						expr: &litMatcher{
-							pos:        position{line: 230, col: 5, offset: 6110},
-							val:        "Integer/show",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 231, col: 5, offset: 6157},
-						run: (*parser).callonIdentifier23,
-						expr: &litMatcher{
-							pos:        position{line: 231, col: 5, offset: 6157},
-							val:        "Double/show",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 232, col: 5, offset: 6202},
-						run: (*parser).callonIdentifier25,
-						expr: &litMatcher{
-							pos:        position{line: 232, col: 5, offset: 6202},
-							val:        "List/build",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 233, col: 5, offset: 6245},
-						run: (*parser).callonIdentifier27,
-						expr: &litMatcher{
-							pos:        position{line: 233, col: 5, offset: 6245},
-							val:        "List/fold",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 234, col: 5, offset: 6286},
-						run: (*parser).callonIdentifier29,
-						expr: &litMatcher{
-							pos:        position{line: 234, col: 5, offset: 6286},
-							val:        "List/length",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 235, col: 5, offset: 6331},
-						run: (*parser).callonIdentifier31,
-						expr: &litMatcher{
-							pos:        position{line: 235, col: 5, offset: 6331},
-							val:        "List/head",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 236, col: 5, offset: 6372},
-						run: (*parser).callonIdentifier33,
-						expr: &litMatcher{
-							pos:        position{line: 236, col: 5, offset: 6372},
-							val:        "List/last",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 237, col: 5, offset: 6413},
-						run: (*parser).callonIdentifier35,
-						expr: &litMatcher{
-							pos:        position{line: 237, col: 5, offset: 6413},
-							val:        "List/indexed",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 238, col: 5, offset: 6460},
-						run: (*parser).callonIdentifier37,
-						expr: &litMatcher{
-							pos:        position{line: 238, col: 5, offset: 6460},
-							val:        "List/reverse",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 239, col: 5, offset: 6507},
-						run: (*parser).callonIdentifier39,
-						expr: &litMatcher{
-							pos:        position{line: 239, col: 5, offset: 6507},
-							val:        "Optional/build",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 240, col: 5, offset: 6558},
-						run: (*parser).callonIdentifier41,
-						expr: &litMatcher{
-							pos:        position{line: 240, col: 5, offset: 6558},
-							val:        "Optional/fold",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 241, col: 5, offset: 6607},
-						run: (*parser).callonIdentifier43,
-						expr: &litMatcher{
-							pos:        position{line: 241, col: 5, offset: 6607},
-							val:        "Text/show",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 242, col: 5, offset: 6648},
-						run: (*parser).callonIdentifier45,
-						expr: &litMatcher{
-							pos:        position{line: 242, col: 5, offset: 6648},
-							val:        "Bool",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 243, col: 5, offset: 6680},
-						run: (*parser).callonIdentifier47,
-						expr: &litMatcher{
-							pos:        position{line: 243, col: 5, offset: 6680},
-							val:        "True",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 244, col: 5, offset: 6712},
-						run: (*parser).callonIdentifier49,
-						expr: &litMatcher{
-							pos:        position{line: 244, col: 5, offset: 6712},
-							val:        "False",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 245, col: 5, offset: 6746},
-						run: (*parser).callonIdentifier51,
-						expr: &litMatcher{
-							pos:        position{line: 245, col: 5, offset: 6746},
-							val:        "Optional",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 246, col: 5, offset: 6786},
-						run: (*parser).callonIdentifier53,
-						expr: &litMatcher{
-							pos:        position{line: 246, col: 5, offset: 6786},
-							val:        "Natural",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 247, col: 5, offset: 6824},
-						run: (*parser).callonIdentifier55,
-						expr: &litMatcher{
-							pos:        position{line: 247, col: 5, offset: 6824},
-							val:        "Integer",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 248, col: 5, offset: 6862},
-						run: (*parser).callonIdentifier57,
-						expr: &litMatcher{
-							pos:        position{line: 248, col: 5, offset: 6862},
-							val:        "Double",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 249, col: 5, offset: 6898},
-						run: (*parser).callonIdentifier59,
-						expr: &litMatcher{
-							pos:        position{line: 249, col: 5, offset: 6898},
-							val:        "Text",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 250, col: 5, offset: 6930},
-						run: (*parser).callonIdentifier61,
-						expr: &litMatcher{
-							pos:        position{line: 250, col: 5, offset: 6930},
-							val:        "List",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 251, col: 5, offset: 6962},
-						run: (*parser).callonIdentifier63,
-						expr: &litMatcher{
-							pos:        position{line: 251, col: 5, offset: 6962},
-							val:        "None",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 252, col: 5, offset: 6994},
-						run: (*parser).callonIdentifier65,
-						expr: &litMatcher{
-							pos:        position{line: 252, col: 5, offset: 6994},
-							val:        "Type",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 253, col: 5, offset: 7026},
-						run: (*parser).callonIdentifier67,
-						expr: &litMatcher{
-							pos:        position{line: 253, col: 5, offset: 7026},
-							val:        "Kind",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 254, col: 5, offset: 7058},
-						run: (*parser).callonIdentifier69,
-						expr: &litMatcher{
-							pos:        position{line: 254, col: 5, offset: 7058},
-							val:        "Sort",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Http",
-			pos:  position{line: 412, col: 1, offset: 10886},
-			expr: &actionExpr{
-				pos: position{line: 412, col: 8, offset: 10895},
-				run: (*parser).callonHttp1,
-				expr: &seqExpr{
-					pos: position{line: 412, col: 8, offset: 10895},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 412, col: 8, offset: 10895},
-							label: "u",
-							expr: &actionExpr{
-								pos: position{line: 378, col: 11, offset: 10077},
-								run: (*parser).callonHttp4,
-								expr: &seqExpr{
-									pos: position{line: 378, col: 11, offset: 10077},
-									exprs: []interface{}{
-										&litMatcher{
-											pos:        position{line: 376, col: 10, offset: 10052},
-											val:        "http",
-											ignoreCase: false,
-										},
-										&zeroOrOneExpr{
-											pos: position{line: 376, col: 17, offset: 10059},
-											expr: &litMatcher{
-												pos:        position{line: 376, col: 17, offset: 10059},
-												val:        "s",
-												ignoreCase: false,
-											},
-										},
-										&litMatcher{
-											pos:        position{line: 378, col: 18, offset: 10084},
-											val:        "://",
-											ignoreCase: false,
-										},
-										&zeroOrOneExpr{
-											pos: position{line: 382, col: 13, offset: 10229},
-											expr: &seqExpr{
-												pos: position{line: 382, col: 14, offset: 10230},
-												exprs: []interface{}{
-													&zeroOrMoreExpr{
-														pos: position{line: 384, col: 12, offset: 10276},
-														expr: &choiceExpr{
-															pos: position{line: 384, col: 14, offset: 10278},
-															alternatives: []interface{}{
-																&charClassMatcher{
-																	pos:        position{line: 408, col: 14, offset: 10808},
-																	val:        "[._~-A-Za-z0-9]",
-																	chars:      []rune{'.', '_', '~', '-'},
-																	ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
-																&seqExpr{
-																	pos: position{line: 406, col: 14, offset: 10774},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 406, col: 14, offset: 10774},
-																			val:        "%",
-																			ignoreCase: false,
-																		},
-																		&choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																		&choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-																&charClassMatcher{
-																	pos:        position{line: 410, col: 13, offset: 10839},
-																	val:        "[!$&\\*+;=:]",
-																	chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
-															},
-														},
-													},
-													&litMatcher{
-														pos:        position{line: 382, col: 23, offset: 10239},
-														val:        "@",
-														ignoreCase: false,
-													},
-												},
-											},
-										},
-										&choiceExpr{
-											pos: position{line: 386, col: 8, offset: 10333},
-											alternatives: []interface{}{
-												&seqExpr{
-													pos: position{line: 390, col: 13, offset: 10385},
-													exprs: []interface{}{
-														&litMatcher{
-															pos:        position{line: 390, col: 13, offset: 10385},
-															val:        "[",
-															ignoreCase: false,
-														},
-														&actionExpr{
-															pos: position{line: 392, col: 15, offset: 10422},
-															run: (*parser).callonHttp28,
-															expr: &seqExpr{
-																pos: position{line: 392, col: 15, offset: 10422},
-																exprs: []interface{}{
-																	&zeroOrMoreExpr{
-																		pos: position{line: 392, col: 15, offset: 10422},
-																		expr: &choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																	&litMatcher{
-																		pos:        position{line: 392, col: 25, offset: 10432},
-																		val:        ":",
-																		ignoreCase: false,
-																	},
-																	&zeroOrMoreExpr{
-																		pos: position{line: 392, col: 29, offset: 10436},
-																		expr: &choiceExpr{
-																			pos: position{line: 392, col: 30, offset: 10437},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 392, col: 39, offset: 10446},
-																					val:        "[:.]",
-																					chars:      []rune{':', '.'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-														&litMatcher{
-															pos:        position{line: 390, col: 29, offset: 10401},
-															val:        "]",
-															ignoreCase: false,
-														},
-													},
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 398, col: 11, offset: 10618},
-													expr: &choiceExpr{
-														pos: position{line: 398, col: 12, offset: 10619},
-														alternatives: []interface{}{
-															&charClassMatcher{
-																pos:        position{line: 408, col: 14, offset: 10808},
-																val:        "[._~-A-Za-z0-9]",
-																chars:      []rune{'.', '_', '~', '-'},
-																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																ignoreCase: false,
-																inverted:   false,
-															},
-															&seqExpr{
-																pos: position{line: 406, col: 14, offset: 10774},
-																exprs: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 406, col: 14, offset: 10774},
-																		val:        "%",
-																		ignoreCase: false,
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																},
-															},
-															&charClassMatcher{
-																pos:        position{line: 410, col: 13, offset: 10839},
-																val:        "[!$&\\*+;=]",
-																chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '='},
-																ignoreCase: false,
-																inverted:   false,
-															},
-														},
-													},
-												},
-											},
-										},
-										&zeroOrOneExpr{
-											pos: position{line: 382, col: 34, offset: 10250},
-											expr: &seqExpr{
-												pos: position{line: 382, col: 35, offset: 10251},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 382, col: 35, offset: 10251},
-														val:        ":",
-														ignoreCase: false,
-													},
-													&zeroOrMoreExpr{
-														pos: position{line: 388, col: 8, offset: 10363},
-														expr: &charClassMatcher{
-															pos:        position{line: 106, col: 9, offset: 2375},
-															val:        "[0-9]",
-															ranges:     []rune{'0', '9'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-													},
-												},
-											},
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 380, col: 11, offset: 10183},
-											expr: &choiceExpr{
-												pos: position{line: 380, col: 12, offset: 10184},
-												alternatives: []interface{}{
-													&actionExpr{
-														pos: position{line: 357, col: 17, offset: 9296},
-														run: (*parser).callonHttp60,
-														expr: &seqExpr{
-															pos: position{line: 357, col: 17, offset: 9296},
-															exprs: []interface{}{
-																&litMatcher{
-																	pos:        position{line: 357, col: 17, offset: 9296},
-																	val:        "/",
-																	ignoreCase: false,
-																},
-																&labeledExpr{
-																	pos:   position{line: 357, col: 21, offset: 9300},
-																	label: "u",
-																	expr: &actionExpr{
-																		pos: position{line: 354, col: 25, offset: 9155},
-																		run: (*parser).callonHttp64,
-																		expr: &oneOrMoreExpr{
-																			pos: position{line: 354, col: 25, offset: 9155},
-																			expr: &charClassMatcher{
-																				pos:        position{line: 338, col: 6, offset: 8900},
-																				val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
-																				chars:      []rune{'!', '=', '|', '~'},
-																				ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-													&actionExpr{
-														pos: position{line: 358, col: 17, offset: 9358},
-														run: (*parser).callonHttp67,
-														expr: &seqExpr{
-															pos: position{line: 358, col: 17, offset: 9358},
-															exprs: []interface{}{
-																&litMatcher{
-																	pos:        position{line: 358, col: 17, offset: 9358},
-																	val:        "/\"",
-																	ignoreCase: false,
-																},
-																&labeledExpr{
-																	pos:   position{line: 358, col: 25, offset: 9366},
-																	label: "q",
-																	expr: &actionExpr{
-																		pos: position{line: 355, col: 23, offset: 9225},
-																		run: (*parser).callonHttp71,
-																		expr: &oneOrMoreExpr{
-																			pos: position{line: 355, col: 23, offset: 9225},
-																			expr: &charClassMatcher{
-																				pos:        position{line: 349, col: 6, offset: 9063},
-																				val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-																				chars:      []rune{'𐀀', 'D'},
-																				ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																},
-																&litMatcher{
-																	pos:        position{line: 358, col: 47, offset: 9388},
-																	val:        "\"",
-																	ignoreCase: false,
-																},
-															},
-														},
-													},
-													&seqExpr{
-														pos: position{line: 380, col: 28, offset: 10200},
-														exprs: []interface{}{
-															&litMatcher{
-																pos:        position{line: 380, col: 28, offset: 10200},
-																val:        "/",
-																ignoreCase: false,
-															},
-															&zeroOrMoreExpr{
-																pos: position{line: 400, col: 11, offset: 10670},
-																expr: &choiceExpr{
-																	pos: position{line: 402, col: 9, offset: 10688},
-																	alternatives: []interface{}{
-																		&charClassMatcher{
-																			pos:        position{line: 408, col: 14, offset: 10808},
-																			val:        "[._~-A-Za-z0-9]",
-																			chars:      []rune{'.', '_', '~', '-'},
-																			ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																		&seqExpr{
-																			pos: position{line: 406, col: 14, offset: 10774},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 406, col: 14, offset: 10774},
-																					val:        "%",
-																					ignoreCase: false,
-																				},
-																				&choiceExpr{
-																					pos: position{line: 108, col: 10, offset: 2393},
-																					alternatives: []interface{}{
-																						&charClassMatcher{
-																							pos:        position{line: 106, col: 9, offset: 2375},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 108, col: 18, offset: 2401},
-																							val:        "[a-f]i",
-																							ranges:     []rune{'a', 'f'},
-																							ignoreCase: true,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																				&choiceExpr{
-																					pos: position{line: 108, col: 10, offset: 2393},
-																					alternatives: []interface{}{
-																						&charClassMatcher{
-																							pos:        position{line: 106, col: 9, offset: 2375},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 108, col: 18, offset: 2401},
-																							val:        "[a-f]i",
-																							ranges:     []rune{'a', 'f'},
-																							ignoreCase: true,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																			},
-																		},
-																		&charClassMatcher{
-																			pos:        position{line: 410, col: 13, offset: 10839},
-																			val:        "[!$&\\*+;=:@]",
-																			chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':', '@'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-										&zeroOrOneExpr{
-											pos: position{line: 378, col: 42, offset: 10108},
-											expr: &seqExpr{
-												pos: position{line: 378, col: 44, offset: 10110},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 378, col: 44, offset: 10110},
-														val:        "?",
-														ignoreCase: false,
-													},
-													&zeroOrMoreExpr{
-														pos: position{line: 404, col: 9, offset: 10742},
-														expr: &choiceExpr{
-															pos: position{line: 404, col: 10, offset: 10743},
-															alternatives: []interface{}{
-																&charClassMatcher{
-																	pos:        position{line: 408, col: 14, offset: 10808},
-																	val:        "[._~-A-Za-z0-9]",
-																	chars:      []rune{'.', '_', '~', '-'},
-																	ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
-																&seqExpr{
-																	pos: position{line: 406, col: 14, offset: 10774},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 406, col: 14, offset: 10774},
-																			val:        "%",
-																			ignoreCase: false,
-																		},
-																		&choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																		&choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-																&charClassMatcher{
-																	pos:        position{line: 410, col: 13, offset: 10839},
-																	val:        "[!$&\\*+;=:@/?]",
-																	chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':', '@', '/', '?'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 412, col: 18, offset: 10905},
-							label: "usingClause",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 412, col: 30, offset: 10917},
-								expr: &seqExpr{
-									pos: position{line: 412, col: 32, offset: 10919},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 412, col: 32, offset: 10919},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 262, col: 9, offset: 7179},
-											val:        "using",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 412, col: 40, offset: 10927},
-											name: "_1",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 412, col: 43, offset: 10930},
-											name: "ImportExpression",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ImportType",
-			pos:  position{line: 453, col: 1, offset: 12118},
-			expr: &choiceExpr{
-				pos: position{line: 453, col: 14, offset: 12133},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 264, col: 11, offset: 7217},
-						run: (*parser).callonImportType2,
-						expr: &litMatcher{
-							pos:        position{line: 264, col: 11, offset: 7217},
-							val:        "missing",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 371, col: 14, offset: 9771},
-						run: (*parser).callonImportType4,
-						expr: &seqExpr{
-							pos: position{line: 371, col: 14, offset: 9771},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 371, col: 14, offset: 9771},
-									val:        "..",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 371, col: 19, offset: 9776},
-									label: "p",
-									expr: &actionExpr{
-										pos: position{line: 360, col: 8, offset: 9420},
-										run: (*parser).callonImportType8,
-										expr: &labeledExpr{
-											pos:   position{line: 360, col: 8, offset: 9420},
-											label: "cs",
-											expr: &oneOrMoreExpr{
-												pos: position{line: 360, col: 11, offset: 9423},
-												expr: &choiceExpr{
-													pos: position{line: 357, col: 17, offset: 9296},
-													alternatives: []interface{}{
-														&actionExpr{
-															pos: position{line: 357, col: 17, offset: 9296},
-															run: (*parser).callonImportType12,
-															expr: &seqExpr{
-																pos: position{line: 357, col: 17, offset: 9296},
-																exprs: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 357, col: 17, offset: 9296},
-																		val:        "/",
-																		ignoreCase: false,
-																	},
-																	&labeledExpr{
-																		pos:   position{line: 357, col: 21, offset: 9300},
-																		label: "u",
-																		expr: &actionExpr{
-																			pos: position{line: 354, col: 25, offset: 9155},
-																			run: (*parser).callonImportType16,
-																			expr: &oneOrMoreExpr{
-																				pos: position{line: 354, col: 25, offset: 9155},
-																				expr: &charClassMatcher{
-																					pos:        position{line: 338, col: 6, offset: 8900},
-																					val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
-																					chars:      []rune{'!', '=', '|', '~'},
-																					ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-														&actionExpr{
-															pos: position{line: 358, col: 17, offset: 9358},
-															run: (*parser).callonImportType19,
-															expr: &seqExpr{
-																pos: position{line: 358, col: 17, offset: 9358},
-																exprs: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 358, col: 17, offset: 9358},
-																		val:        "/\"",
-																		ignoreCase: false,
-																	},
-																	&labeledExpr{
-																		pos:   position{line: 358, col: 25, offset: 9366},
-																		label: "q",
-																		expr: &actionExpr{
-																			pos: position{line: 355, col: 23, offset: 9225},
-																			run: (*parser).callonImportType23,
-																			expr: &oneOrMoreExpr{
-																				pos: position{line: 355, col: 23, offset: 9225},
-																				expr: &charClassMatcher{
-																					pos:        position{line: 349, col: 6, offset: 9063},
-																					val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-																					chars:      []rune{'𐀀', 'D'},
-																					ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																	&litMatcher{
-																		pos:        position{line: 358, col: 47, offset: 9388},
-																		val:        "\"",
-																		ignoreCase: false,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 372, col: 12, offset: 9847},
-						run: (*parser).callonImportType27,
-						expr: &seqExpr{
-							pos: position{line: 372, col: 12, offset: 9847},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 372, col: 12, offset: 9847},
-									val:        ".",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 372, col: 16, offset: 9851},
-									label: "p",
-									expr: &actionExpr{
-										pos: position{line: 360, col: 8, offset: 9420},
-										run: (*parser).callonImportType31,
-										expr: &labeledExpr{
-											pos:   position{line: 360, col: 8, offset: 9420},
-											label: "cs",
-											expr: &oneOrMoreExpr{
-												pos: position{line: 360, col: 11, offset: 9423},
-												expr: &choiceExpr{
-													pos: position{line: 357, col: 17, offset: 9296},
-													alternatives: []interface{}{
-														&actionExpr{
-															pos: position{line: 357, col: 17, offset: 9296},
-															run: (*parser).callonImportType35,
-															expr: &seqExpr{
-																pos: position{line: 357, col: 17, offset: 9296},
-																exprs: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 357, col: 17, offset: 9296},
-																		val:        "/",
-																		ignoreCase: false,
-																	},
-																	&labeledExpr{
-																		pos:   position{line: 357, col: 21, offset: 9300},
-																		label: "u",
-																		expr: &actionExpr{
-																			pos: position{line: 354, col: 25, offset: 9155},
-																			run: (*parser).callonImportType39,
-																			expr: &oneOrMoreExpr{
-																				pos: position{line: 354, col: 25, offset: 9155},
-																				expr: &charClassMatcher{
-																					pos:        position{line: 338, col: 6, offset: 8900},
-																					val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
-																					chars:      []rune{'!', '=', '|', '~'},
-																					ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-														&actionExpr{
-															pos: position{line: 358, col: 17, offset: 9358},
-															run: (*parser).callonImportType42,
-															expr: &seqExpr{
-																pos: position{line: 358, col: 17, offset: 9358},
-																exprs: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 358, col: 17, offset: 9358},
-																		val:        "/\"",
-																		ignoreCase: false,
-																	},
-																	&labeledExpr{
-																		pos:   position{line: 358, col: 25, offset: 9366},
-																		label: "q",
-																		expr: &actionExpr{
-																			pos: position{line: 355, col: 23, offset: 9225},
-																			run: (*parser).callonImportType46,
-																			expr: &oneOrMoreExpr{
-																				pos: position{line: 355, col: 23, offset: 9225},
-																				expr: &charClassMatcher{
-																					pos:        position{line: 349, col: 6, offset: 9063},
-																					val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-																					chars:      []rune{'𐀀', 'D'},
-																					ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																	&litMatcher{
-																		pos:        position{line: 358, col: 47, offset: 9388},
-																		val:        "\"",
-																		ignoreCase: false,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 373, col: 12, offset: 9905},
-						run: (*parser).callonImportType50,
-						expr: &seqExpr{
-							pos: position{line: 373, col: 12, offset: 9905},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 373, col: 12, offset: 9905},
-									val:        "~",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 373, col: 16, offset: 9909},
-									label: "p",
-									expr: &actionExpr{
-										pos: position{line: 360, col: 8, offset: 9420},
-										run: (*parser).callonImportType54,
-										expr: &labeledExpr{
-											pos:   position{line: 360, col: 8, offset: 9420},
-											label: "cs",
-											expr: &oneOrMoreExpr{
-												pos: position{line: 360, col: 11, offset: 9423},
-												expr: &choiceExpr{
-													pos: position{line: 357, col: 17, offset: 9296},
-													alternatives: []interface{}{
-														&actionExpr{
-															pos: position{line: 357, col: 17, offset: 9296},
-															run: (*parser).callonImportType58,
-															expr: &seqExpr{
-																pos: position{line: 357, col: 17, offset: 9296},
-																exprs: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 357, col: 17, offset: 9296},
-																		val:        "/",
-																		ignoreCase: false,
-																	},
-																	&labeledExpr{
-																		pos:   position{line: 357, col: 21, offset: 9300},
-																		label: "u",
-																		expr: &actionExpr{
-																			pos: position{line: 354, col: 25, offset: 9155},
-																			run: (*parser).callonImportType62,
-																			expr: &oneOrMoreExpr{
-																				pos: position{line: 354, col: 25, offset: 9155},
-																				expr: &charClassMatcher{
-																					pos:        position{line: 338, col: 6, offset: 8900},
-																					val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
-																					chars:      []rune{'!', '=', '|', '~'},
-																					ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-														&actionExpr{
-															pos: position{line: 358, col: 17, offset: 9358},
-															run: (*parser).callonImportType65,
-															expr: &seqExpr{
-																pos: position{line: 358, col: 17, offset: 9358},
-																exprs: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 358, col: 17, offset: 9358},
-																		val:        "/\"",
-																		ignoreCase: false,
-																	},
-																	&labeledExpr{
-																		pos:   position{line: 358, col: 25, offset: 9366},
-																		label: "q",
-																		expr: &actionExpr{
-																			pos: position{line: 355, col: 23, offset: 9225},
-																			run: (*parser).callonImportType69,
-																			expr: &oneOrMoreExpr{
-																				pos: position{line: 355, col: 23, offset: 9225},
-																				expr: &charClassMatcher{
-																					pos:        position{line: 349, col: 6, offset: 9063},
-																					val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-																					chars:      []rune{'𐀀', 'D'},
-																					ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																	&litMatcher{
-																		pos:        position{line: 358, col: 47, offset: 9388},
-																		val:        "\"",
-																		ignoreCase: false,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 374, col: 16, offset: 9983},
-						run: (*parser).callonImportType73,
-						expr: &labeledExpr{
-							pos:   position{line: 374, col: 16, offset: 9983},
-							label: "p",
-							expr: &actionExpr{
-								pos: position{line: 360, col: 8, offset: 9420},
-								run: (*parser).callonImportType75,
-								expr: &labeledExpr{
-									pos:   position{line: 360, col: 8, offset: 9420},
-									label: "cs",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 360, col: 11, offset: 9423},
-										expr: &choiceExpr{
-											pos: position{line: 357, col: 17, offset: 9296},
-											alternatives: []interface{}{
-												&actionExpr{
-													pos: position{line: 357, col: 17, offset: 9296},
-													run: (*parser).callonImportType79,
-													expr: &seqExpr{
-														pos: position{line: 357, col: 17, offset: 9296},
-														exprs: []interface{}{
-															&litMatcher{
-																pos:        position{line: 357, col: 17, offset: 9296},
-																val:        "/",
-																ignoreCase: false,
-															},
-															&labeledExpr{
-																pos:   position{line: 357, col: 21, offset: 9300},
-																label: "u",
-																expr: &actionExpr{
-																	pos: position{line: 354, col: 25, offset: 9155},
-																	run: (*parser).callonImportType83,
-																	expr: &oneOrMoreExpr{
-																		pos: position{line: 354, col: 25, offset: 9155},
-																		expr: &charClassMatcher{
-																			pos:        position{line: 338, col: 6, offset: 8900},
-																			val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
-																			chars:      []rune{'!', '=', '|', '~'},
-																			ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-												&actionExpr{
-													pos: position{line: 358, col: 17, offset: 9358},
-													run: (*parser).callonImportType86,
-													expr: &seqExpr{
-														pos: position{line: 358, col: 17, offset: 9358},
-														exprs: []interface{}{
-															&litMatcher{
-																pos:        position{line: 358, col: 17, offset: 9358},
-																val:        "/\"",
-																ignoreCase: false,
-															},
-															&labeledExpr{
-																pos:   position{line: 358, col: 25, offset: 9366},
-																label: "q",
-																expr: &actionExpr{
-																	pos: position{line: 355, col: 23, offset: 9225},
-																	run: (*parser).callonImportType90,
-																	expr: &oneOrMoreExpr{
-																		pos: position{line: 355, col: 23, offset: 9225},
-																		expr: &charClassMatcher{
-																			pos:        position{line: 349, col: 6, offset: 9063},
-																			val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-																			chars:      []rune{'𐀀', 'D'},
-																			ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																},
-															},
-															&litMatcher{
-																pos:        position{line: 358, col: 47, offset: 9388},
-																val:        "\"",
-																ignoreCase: false,
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 453, col: 32, offset: 12151},
-						name: "Http",
-					},
-					&actionExpr{
-						pos: position{line: 419, col: 7, offset: 11130},
-						run: (*parser).callonImportType95,
-						expr: &seqExpr{
-							pos: position{line: 419, col: 7, offset: 11130},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 419, col: 7, offset: 11130},
-									val:        "env:",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 419, col: 14, offset: 11137},
-									label: "v",
-									expr: &choiceExpr{
-										pos: position{line: 419, col: 17, offset: 11140},
-										alternatives: []interface{}{
-											&actionExpr{
-												pos: position{line: 421, col: 27, offset: 11239},
-												run: (*parser).callonImportType100,
-												expr: &seqExpr{
-													pos: position{line: 421, col: 27, offset: 11239},
-													exprs: []interface{}{
-														&charClassMatcher{
-															pos:        position{line: 421, col: 27, offset: 11239},
-															val:        "[_A-Za-z]",
-															chars:      []rune{'_'},
-															ranges:     []rune{'A', 'Z', 'a', 'z'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-														&zeroOrMoreExpr{
-															pos: position{line: 421, col: 36, offset: 11248},
-															expr: &charClassMatcher{
-																pos:        position{line: 421, col: 36, offset: 11248},
-																val:        "[_A-Za-z0-9]",
-																chars:      []rune{'_'},
-																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																ignoreCase: false,
-																inverted:   false,
-															},
-														},
-													},
-												},
-											},
-											&actionExpr{
-												pos: position{line: 425, col: 28, offset: 11333},
-												run: (*parser).callonImportType105,
-												expr: &seqExpr{
-													pos: position{line: 425, col: 28, offset: 11333},
-													exprs: []interface{}{
-														&litMatcher{
-															pos:        position{line: 425, col: 28, offset: 11333},
-															val:        "\"",
-															ignoreCase: false,
-														},
-														&labeledExpr{
-															pos:   position{line: 425, col: 32, offset: 11337},
-															label: "v",
-															expr: &actionExpr{
-																pos: position{line: 429, col: 35, offset: 11432},
-																run: (*parser).callonImportType109,
-																expr: &labeledExpr{
-																	pos:   position{line: 429, col: 35, offset: 11432},
-																	label: "v",
-																	expr: &oneOrMoreExpr{
-																		pos: position{line: 429, col: 37, offset: 11434},
-																		expr: &choiceExpr{
-																			pos: position{line: 439, col: 7, offset: 11691},
-																			alternatives: []interface{}{
-																				&actionExpr{
-																					pos: position{line: 439, col: 7, offset: 11691},
-																					run: (*parser).callonImportType113,
-																					expr: &litMatcher{
-																						pos:        position{line: 439, col: 7, offset: 11691},
-																						val:        "\\\"",
-																						ignoreCase: false,
-																					},
-																				},
-																				&actionExpr{
-																					pos: position{line: 440, col: 7, offset: 11731},
-																					run: (*parser).callonImportType115,
-																					expr: &litMatcher{
-																						pos:        position{line: 440, col: 7, offset: 11731},
-																						val:        "\\\\",
-																						ignoreCase: false,
-																					},
-																				},
-																				&actionExpr{
-																					pos: position{line: 441, col: 7, offset: 11771},
-																					run: (*parser).callonImportType117,
-																					expr: &litMatcher{
-																						pos:        position{line: 441, col: 7, offset: 11771},
-																						val:        "\\a",
-																						ignoreCase: false,
-																					},
-																				},
-																				&actionExpr{
-																					pos: position{line: 442, col: 7, offset: 11811},
-																					run: (*parser).callonImportType119,
-																					expr: &litMatcher{
-																						pos:        position{line: 442, col: 7, offset: 11811},
-																						val:        "\\b",
-																						ignoreCase: false,
-																					},
-																				},
-																				&actionExpr{
-																					pos: position{line: 443, col: 7, offset: 11851},
-																					run: (*parser).callonImportType121,
-																					expr: &litMatcher{
-																						pos:        position{line: 443, col: 7, offset: 11851},
-																						val:        "\\f",
-																						ignoreCase: false,
-																					},
-																				},
-																				&actionExpr{
-																					pos: position{line: 444, col: 7, offset: 11891},
-																					run: (*parser).callonImportType123,
-																					expr: &litMatcher{
-																						pos:        position{line: 444, col: 7, offset: 11891},
-																						val:        "\\n",
-																						ignoreCase: false,
-																					},
-																				},
-																				&actionExpr{
-																					pos: position{line: 445, col: 7, offset: 11931},
-																					run: (*parser).callonImportType125,
-																					expr: &litMatcher{
-																						pos:        position{line: 445, col: 7, offset: 11931},
-																						val:        "\\r",
-																						ignoreCase: false,
-																					},
-																				},
-																				&actionExpr{
-																					pos: position{line: 446, col: 7, offset: 11971},
-																					run: (*parser).callonImportType127,
-																					expr: &litMatcher{
-																						pos:        position{line: 446, col: 7, offset: 11971},
-																						val:        "\\t",
-																						ignoreCase: false,
-																					},
-																				},
-																				&actionExpr{
-																					pos: position{line: 447, col: 7, offset: 12011},
-																					run: (*parser).callonImportType129,
-																					expr: &litMatcher{
-																						pos:        position{line: 447, col: 7, offset: 12011},
-																						val:        "\\v",
-																						ignoreCase: false,
-																					},
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 448, col: 7, offset: 12051},
-																					val:        "[ -!#-<>-[]-~]",
-																					ranges:     []rune{' ', '!', '#', '<', '>', '[', ']', '~'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-														&litMatcher{
-															pos:        position{line: 425, col: 66, offset: 11371},
-															val:        "\"",
-															ignoreCase: false,
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ImportHashed",
-			pos:  position{line: 471, col: 1, offset: 13003},
-			expr: &actionExpr{
-				pos: position{line: 471, col: 16, offset: 13020},
-				run: (*parser).callonImportHashed1,
-				expr: &seqExpr{
-					pos: position{line: 471, col: 16, offset: 13020},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 471, col: 16, offset: 13020},
-							label: "i",
-							expr: &ruleRefExpr{
-								pos:  position{line: 471, col: 18, offset: 13022},
-								name: "ImportType",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 471, col: 29, offset: 13033},
-							label: "h",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 471, col: 31, offset: 13035},
-								expr: &seqExpr{
-									pos: position{line: 471, col: 32, offset: 13036},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 471, col: 32, offset: 13036},
-											name: "_1",
-										},
-										&actionExpr{
-											pos: position{line: 469, col: 8, offset: 12919},
-											run: (*parser).callonImportHashed9,
-											expr: &seqExpr{
-												pos: position{line: 469, col: 8, offset: 12919},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 469, col: 8, offset: 12919},
-														val:        "sha256:",
-														ignoreCase: false,
-													},
-													&labeledExpr{
-														pos:   position{line: 469, col: 18, offset: 12929},
-														label: "val",
-														expr: &actionExpr{
-															pos: position{line: 456, col: 13, offset: 12243},
-															run: (*parser).callonImportHashed13,
-															expr: &seqExpr{
-																pos: position{line: 456, col: 13, offset: 12243},
-																exprs: []interface{}{
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Import",
-			pos:  position{line: 479, col: 1, offset: 13194},
-			expr: &choiceExpr{
-				pos: position{line: 479, col: 10, offset: 13205},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 479, col: 10, offset: 13205},
-						run: (*parser).callonImport2,
-						expr: &seqExpr{
-							pos: position{line: 479, col: 10, offset: 13205},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 479, col: 10, offset: 13205},
-									label: "i",
-									expr: &ruleRefExpr{
-										pos:  position{line: 479, col: 12, offset: 13207},
-										name: "ImportHashed",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 479, col: 25, offset: 13220},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 261, col: 6, offset: 7164},
-									val:        "as",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 479, col: 30, offset: 13225},
-									name: "_1",
-								},
-								&litMatcher{
-									pos:        position{line: 283, col: 8, offset: 7557},
-									val:        "Text",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 480, col: 10, offset: 13318},
-						run: (*parser).callonImport10,
-						expr: &seqExpr{
-							pos: position{line: 480, col: 10, offset: 13318},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 480, col: 10, offset: 13318},
-									label: "i",
-									expr: &ruleRefExpr{
-										pos:  position{line: 480, col: 12, offset: 13320},
-										name: "ImportHashed",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 480, col: 25, offset: 13333},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 261, col: 6, offset: 7164},
-									val:        "as",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 480, col: 30, offset: 13338},
-									name: "_1",
-								},
-								&litMatcher{
-									pos:        position{line: 285, col: 12, offset: 7593},
-									val:        "Location",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 481, col: 10, offset: 13436},
-						run: (*parser).callonImport18,
-						expr: &labeledExpr{
-							pos:   position{line: 481, col: 10, offset: 13436},
-							label: "i",
-							expr: &ruleRefExpr{
-								pos:  position{line: 481, col: 12, offset: 13438},
-								name: "ImportHashed",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "LetBinding",
-			pos:  position{line: 484, col: 1, offset: 13526},
-			expr: &actionExpr{
-				pos: position{line: 484, col: 14, offset: 13541},
-				run: (*parser).callonLetBinding1,
-				expr: &seqExpr{
-					pos: position{line: 484, col: 14, offset: 13541},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 259, col: 7, offset: 7139},
-							val:        "let",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 484, col: 18, offset: 13545},
-							name: "_1",
-						},
-						&labeledExpr{
-							pos:   position{line: 484, col: 21, offset: 13548},
-							label: "label",
-							expr: &choiceExpr{
-								pos: position{line: 124, col: 20, offset: 2920},
-								alternatives: []interface{}{
-									&actionExpr{
-										pos: position{line: 124, col: 20, offset: 2920},
-										run: (*parser).callonLetBinding7,
-										expr: &seqExpr{
-											pos: position{line: 124, col: 20, offset: 2920},
-											exprs: []interface{}{
-												&andExpr{
-													pos: position{line: 124, col: 20, offset: 2920},
-													expr: &seqExpr{
-														pos: position{line: 124, col: 22, offset: 2922},
-														exprs: []interface{}{
-															&choiceExpr{
-																pos: position{line: 221, col: 5, offset: 5657},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		run: (*parser).callonLetBinding12,
-																		expr: &litMatcher{
-																			pos:        position{line: 221, col: 5, offset: 5657},
-																			val:        "Natural/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 222, col: 5, offset: 5706},
-																		run: (*parser).callonLetBinding14,
-																		expr: &litMatcher{
-																			pos:        position{line: 222, col: 5, offset: 5706},
-																			val:        "Natural/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 223, col: 5, offset: 5753},
-																		run: (*parser).callonLetBinding16,
-																		expr: &litMatcher{
-																			pos:        position{line: 223, col: 5, offset: 5753},
-																			val:        "Natural/isZero",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 224, col: 5, offset: 5804},
-																		run: (*parser).callonLetBinding18,
-																		expr: &litMatcher{
-																			pos:        position{line: 224, col: 5, offset: 5804},
-																			val:        "Natural/even",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 225, col: 5, offset: 5851},
-																		run: (*parser).callonLetBinding20,
-																		expr: &litMatcher{
-																			pos:        position{line: 225, col: 5, offset: 5851},
-																			val:        "Natural/odd",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 226, col: 5, offset: 5896},
-																		run: (*parser).callonLetBinding22,
-																		expr: &litMatcher{
-																			pos:        position{line: 226, col: 5, offset: 5896},
-																			val:        "Natural/toInteger",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 227, col: 5, offset: 5953},
-																		run: (*parser).callonLetBinding24,
-																		expr: &litMatcher{
-																			pos:        position{line: 227, col: 5, offset: 5953},
-																			val:        "Natural/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 6000},
-																		run: (*parser).callonLetBinding26,
-																		expr: &litMatcher{
-																			pos:        position{line: 228, col: 5, offset: 6000},
-																			val:        "Natural/subtract",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 229, col: 5, offset: 6055},
-																		run: (*parser).callonLetBinding28,
-																		expr: &litMatcher{
-																			pos:        position{line: 229, col: 5, offset: 6055},
-																			val:        "Integer/toDouble",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 230, col: 5, offset: 6110},
-																		run: (*parser).callonLetBinding30,
-																		expr: &litMatcher{
-																			pos:        position{line: 230, col: 5, offset: 6110},
-																			val:        "Integer/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 231, col: 5, offset: 6157},
-																		run: (*parser).callonLetBinding32,
-																		expr: &litMatcher{
-																			pos:        position{line: 231, col: 5, offset: 6157},
-																			val:        "Double/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 232, col: 5, offset: 6202},
-																		run: (*parser).callonLetBinding34,
-																		expr: &litMatcher{
-																			pos:        position{line: 232, col: 5, offset: 6202},
-																			val:        "List/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 233, col: 5, offset: 6245},
-																		run: (*parser).callonLetBinding36,
-																		expr: &litMatcher{
-																			pos:        position{line: 233, col: 5, offset: 6245},
-																			val:        "List/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 234, col: 5, offset: 6286},
-																		run: (*parser).callonLetBinding38,
-																		expr: &litMatcher{
-																			pos:        position{line: 234, col: 5, offset: 6286},
-																			val:        "List/length",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 235, col: 5, offset: 6331},
-																		run: (*parser).callonLetBinding40,
-																		expr: &litMatcher{
-																			pos:        position{line: 235, col: 5, offset: 6331},
-																			val:        "List/head",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 236, col: 5, offset: 6372},
-																		run: (*parser).callonLetBinding42,
-																		expr: &litMatcher{
-																			pos:        position{line: 236, col: 5, offset: 6372},
-																			val:        "List/last",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 237, col: 5, offset: 6413},
-																		run: (*parser).callonLetBinding44,
-																		expr: &litMatcher{
-																			pos:        position{line: 237, col: 5, offset: 6413},
-																			val:        "List/indexed",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 238, col: 5, offset: 6460},
-																		run: (*parser).callonLetBinding46,
-																		expr: &litMatcher{
-																			pos:        position{line: 238, col: 5, offset: 6460},
-																			val:        "List/reverse",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 239, col: 5, offset: 6507},
-																		run: (*parser).callonLetBinding48,
-																		expr: &litMatcher{
-																			pos:        position{line: 239, col: 5, offset: 6507},
-																			val:        "Optional/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 240, col: 5, offset: 6558},
-																		run: (*parser).callonLetBinding50,
-																		expr: &litMatcher{
-																			pos:        position{line: 240, col: 5, offset: 6558},
-																			val:        "Optional/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 241, col: 5, offset: 6607},
-																		run: (*parser).callonLetBinding52,
-																		expr: &litMatcher{
-																			pos:        position{line: 241, col: 5, offset: 6607},
-																			val:        "Text/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 242, col: 5, offset: 6648},
-																		run: (*parser).callonLetBinding54,
-																		expr: &litMatcher{
-																			pos:        position{line: 242, col: 5, offset: 6648},
-																			val:        "Bool",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 243, col: 5, offset: 6680},
-																		run: (*parser).callonLetBinding56,
-																		expr: &litMatcher{
-																			pos:        position{line: 243, col: 5, offset: 6680},
-																			val:        "True",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 244, col: 5, offset: 6712},
-																		run: (*parser).callonLetBinding58,
-																		expr: &litMatcher{
-																			pos:        position{line: 244, col: 5, offset: 6712},
-																			val:        "False",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 245, col: 5, offset: 6746},
-																		run: (*parser).callonLetBinding60,
-																		expr: &litMatcher{
-																			pos:        position{line: 245, col: 5, offset: 6746},
-																			val:        "Optional",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 246, col: 5, offset: 6786},
-																		run: (*parser).callonLetBinding62,
-																		expr: &litMatcher{
-																			pos:        position{line: 246, col: 5, offset: 6786},
-																			val:        "Natural",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 247, col: 5, offset: 6824},
-																		run: (*parser).callonLetBinding64,
-																		expr: &litMatcher{
-																			pos:        position{line: 247, col: 5, offset: 6824},
-																			val:        "Integer",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 248, col: 5, offset: 6862},
-																		run: (*parser).callonLetBinding66,
-																		expr: &litMatcher{
-																			pos:        position{line: 248, col: 5, offset: 6862},
-																			val:        "Double",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 249, col: 5, offset: 6898},
-																		run: (*parser).callonLetBinding68,
-																		expr: &litMatcher{
-																			pos:        position{line: 249, col: 5, offset: 6898},
-																			val:        "Text",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 250, col: 5, offset: 6930},
-																		run: (*parser).callonLetBinding70,
-																		expr: &litMatcher{
-																			pos:        position{line: 250, col: 5, offset: 6930},
-																			val:        "List",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 251, col: 5, offset: 6962},
-																		run: (*parser).callonLetBinding72,
-																		expr: &litMatcher{
-																			pos:        position{line: 251, col: 5, offset: 6962},
-																			val:        "None",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 252, col: 5, offset: 6994},
-																		run: (*parser).callonLetBinding74,
-																		expr: &litMatcher{
-																			pos:        position{line: 252, col: 5, offset: 6994},
-																			val:        "Type",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 253, col: 5, offset: 7026},
-																		run: (*parser).callonLetBinding76,
-																		expr: &litMatcher{
-																			pos:        position{line: 253, col: 5, offset: 7026},
-																			val:        "Kind",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 254, col: 5, offset: 7058},
-																		run: (*parser).callonLetBinding78,
-																		expr: &litMatcher{
-																			pos:        position{line: 254, col: 5, offset: 7058},
-																			val:        "Sort",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-															&charClassMatcher{
-																pos:        position{line: 111, col: 23, offset: 2468},
-																val:        "[_/-A-Za-z0-9]",
-																chars:      []rune{'_', '/', '-'},
-																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																ignoreCase: false,
-																inverted:   false,
-															},
-														},
-													},
-												},
-												&labeledExpr{
-													pos:   position{line: 124, col: 52, offset: 2952},
-													label: "label",
-													expr: &choiceExpr{
-														pos: position{line: 121, col: 9, offset: 2802},
-														alternatives: []interface{}{
-															&actionExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																run: (*parser).callonLetBinding83,
-																expr: &seqExpr{
-																	pos: position{line: 121, col: 9, offset: 2802},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 121, col: 9, offset: 2802},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																		&labeledExpr{
-																			pos:   position{line: 121, col: 13, offset: 2806},
-																			label: "label",
-																			expr: &actionExpr{
-																				pos: position{line: 119, col: 15, offset: 2743},
-																				run: (*parser).callonLetBinding87,
-																				expr: &oneOrMoreExpr{
-																					pos: position{line: 119, col: 15, offset: 2743},
-																					expr: &charClassMatcher{
-																						pos:        position{line: 118, col: 19, offset: 2706},
-																						val:        "[ -_a-~]",
-																						ranges:     []rune{' ', '_', 'a', '~'},
-																						ignoreCase: false,
-																						inverted:   false,
-																					},
-																				},
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 121, col: 31, offset: 2824},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-															&actionExpr{
-																pos: position{line: 122, col: 9, offset: 2858},
-																run: (*parser).callonLetBinding91,
-																expr: &labeledExpr{
-																	pos:   position{line: 122, col: 9, offset: 2858},
-																	label: "label",
-																	expr: &choiceExpr{
-																		pos: position{line: 112, col: 15, offset: 2499},
-																		alternatives: []interface{}{
-																			&actionExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				run: (*parser).callonLetBinding94,
-																				expr: &seqExpr{
-																					pos: position{line: 112, col: 15, offset: 2499},
-																					exprs: []interface{}{
-																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
-																							alternatives: []interface{}{
-																								&litMatcher{
-																									pos:        position{line: 256, col: 6, offset: 7094},
-																									val:        "if",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 257, col: 8, offset: 7108},
-																									val:        "then",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 258, col: 8, offset: 7124},
-																									val:        "else",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 259, col: 7, offset: 7139},
-																									val:        "let",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 260, col: 6, offset: 7152},
-																									val:        "in",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 262, col: 9, offset: 7179},
-																									val:        "using",
-																									ignoreCase: false,
-																								},
-																								&actionExpr{
-																									pos: position{line: 264, col: 11, offset: 7217},
-																									run: (*parser).callonLetBinding103,
-																									expr: &litMatcher{
-																										pos:        position{line: 264, col: 11, offset: 7217},
-																										val:        "missing",
-																										ignoreCase: false,
-																									},
-																								},
-																								&litMatcher{
-																									pos:        position{line: 261, col: 6, offset: 7164},
-																									val:        "as",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 265, col: 8, offset: 7262},
-																									val:        "True",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 266, col: 9, offset: 7279},
-																									val:        "False",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 267, col: 12, offset: 7300},
-																									val:        "Infinity",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 268, col: 7, offset: 7319},
-																									val:        "NaN",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 263, col: 9, offset: 7197},
-																									val:        "merge",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 269, col: 8, offset: 7334},
-																									val:        "Some",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 270, col: 9, offset: 7351},
-																									val:        "toMap",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
-																								},
-																							},
-																						},
-																						&oneOrMoreExpr{
-																							pos: position{line: 112, col: 23, offset: 2507},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 113, col: 13, offset: 2571},
-																				run: (*parser).callonLetBinding116,
-																				expr: &seqExpr{
-																					pos: position{line: 113, col: 13, offset: 2571},
-																					exprs: []interface{}{
-																						&notExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
-																								alternatives: []interface{}{
-																									&litMatcher{
-																										pos:        position{line: 256, col: 6, offset: 7094},
-																										val:        "if",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 257, col: 8, offset: 7108},
-																										val:        "then",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 258, col: 8, offset: 7124},
-																										val:        "else",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 259, col: 7, offset: 7139},
-																										val:        "let",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 260, col: 6, offset: 7152},
-																										val:        "in",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 262, col: 9, offset: 7179},
-																										val:        "using",
-																										ignoreCase: false,
-																									},
-																									&actionExpr{
-																										pos: position{line: 264, col: 11, offset: 7217},
-																										run: (*parser).callonLetBinding126,
-																										expr: &litMatcher{
-																											pos:        position{line: 264, col: 11, offset: 7217},
-																											val:        "missing",
-																											ignoreCase: false,
-																										},
-																									},
-																									&litMatcher{
-																										pos:        position{line: 261, col: 6, offset: 7164},
-																										val:        "as",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 265, col: 8, offset: 7262},
-																										val:        "True",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 266, col: 9, offset: 7279},
-																										val:        "False",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 267, col: 12, offset: 7300},
-																										val:        "Infinity",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 268, col: 7, offset: 7319},
-																										val:        "NaN",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 263, col: 9, offset: 7197},
-																										val:        "merge",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 269, col: 8, offset: 7334},
-																										val:        "Some",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 270, col: 9, offset: 7351},
-																										val:        "toMap",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
-																									},
-																								},
-																							},
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 110, col: 24, offset: 2434},
-																							val:        "[_A-Za-z]",
-																							chars:      []rune{'_'},
-																							ranges:     []rune{'A', 'Z', 'a', 'z'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&zeroOrMoreExpr{
-																							pos: position{line: 113, col: 43, offset: 2601},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									&actionExpr{
-										pos: position{line: 125, col: 19, offset: 3004},
-										run: (*parser).callonLetBinding140,
-										expr: &seqExpr{
-											pos: position{line: 125, col: 19, offset: 3004},
-											exprs: []interface{}{
-												&notExpr{
-													pos: position{line: 125, col: 19, offset: 3004},
-													expr: &choiceExpr{
-														pos: position{line: 221, col: 5, offset: 5657},
-														alternatives: []interface{}{
-															&actionExpr{
-																pos: position{line: 221, col: 5, offset: 5657},
-																run: (*parser).callonLetBinding144,
-																expr: &litMatcher{
-																	pos:        position{line: 221, col: 5, offset: 5657},
-																	val:        "Natural/build",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 222, col: 5, offset: 5706},
-																run: (*parser).callonLetBinding146,
-																expr: &litMatcher{
-																	pos:        position{line: 222, col: 5, offset: 5706},
-																	val:        "Natural/fold",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 223, col: 5, offset: 5753},
-																run: (*parser).callonLetBinding148,
-																expr: &litMatcher{
-																	pos:        position{line: 223, col: 5, offset: 5753},
-																	val:        "Natural/isZero",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 224, col: 5, offset: 5804},
-																run: (*parser).callonLetBinding150,
-																expr: &litMatcher{
-																	pos:        position{line: 224, col: 5, offset: 5804},
-																	val:        "Natural/even",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 225, col: 5, offset: 5851},
-																run: (*parser).callonLetBinding152,
-																expr: &litMatcher{
-																	pos:        position{line: 225, col: 5, offset: 5851},
-																	val:        "Natural/odd",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 226, col: 5, offset: 5896},
-																run: (*parser).callonLetBinding154,
-																expr: &litMatcher{
-																	pos:        position{line: 226, col: 5, offset: 5896},
-																	val:        "Natural/toInteger",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 227, col: 5, offset: 5953},
-																run: (*parser).callonLetBinding156,
-																expr: &litMatcher{
-																	pos:        position{line: 227, col: 5, offset: 5953},
-																	val:        "Natural/show",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 228, col: 5, offset: 6000},
-																run: (*parser).callonLetBinding158,
-																expr: &litMatcher{
-																	pos:        position{line: 228, col: 5, offset: 6000},
-																	val:        "Natural/subtract",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 229, col: 5, offset: 6055},
-																run: (*parser).callonLetBinding160,
-																expr: &litMatcher{
-																	pos:        position{line: 229, col: 5, offset: 6055},
-																	val:        "Integer/toDouble",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 230, col: 5, offset: 6110},
-																run: (*parser).callonLetBinding162,
-																expr: &litMatcher{
-																	pos:        position{line: 230, col: 5, offset: 6110},
-																	val:        "Integer/show",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 231, col: 5, offset: 6157},
-																run: (*parser).callonLetBinding164,
-																expr: &litMatcher{
-																	pos:        position{line: 231, col: 5, offset: 6157},
-																	val:        "Double/show",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 232, col: 5, offset: 6202},
-																run: (*parser).callonLetBinding166,
-																expr: &litMatcher{
-																	pos:        position{line: 232, col: 5, offset: 6202},
-																	val:        "List/build",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 233, col: 5, offset: 6245},
-																run: (*parser).callonLetBinding168,
-																expr: &litMatcher{
-																	pos:        position{line: 233, col: 5, offset: 6245},
-																	val:        "List/fold",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 234, col: 5, offset: 6286},
-																run: (*parser).callonLetBinding170,
-																expr: &litMatcher{
-																	pos:        position{line: 234, col: 5, offset: 6286},
-																	val:        "List/length",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 235, col: 5, offset: 6331},
-																run: (*parser).callonLetBinding172,
-																expr: &litMatcher{
-																	pos:        position{line: 235, col: 5, offset: 6331},
-																	val:        "List/head",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 236, col: 5, offset: 6372},
-																run: (*parser).callonLetBinding174,
-																expr: &litMatcher{
-																	pos:        position{line: 236, col: 5, offset: 6372},
-																	val:        "List/last",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 237, col: 5, offset: 6413},
-																run: (*parser).callonLetBinding176,
-																expr: &litMatcher{
-																	pos:        position{line: 237, col: 5, offset: 6413},
-																	val:        "List/indexed",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 238, col: 5, offset: 6460},
-																run: (*parser).callonLetBinding178,
-																expr: &litMatcher{
-																	pos:        position{line: 238, col: 5, offset: 6460},
-																	val:        "List/reverse",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 239, col: 5, offset: 6507},
-																run: (*parser).callonLetBinding180,
-																expr: &litMatcher{
-																	pos:        position{line: 239, col: 5, offset: 6507},
-																	val:        "Optional/build",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 240, col: 5, offset: 6558},
-																run: (*parser).callonLetBinding182,
-																expr: &litMatcher{
-																	pos:        position{line: 240, col: 5, offset: 6558},
-																	val:        "Optional/fold",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 241, col: 5, offset: 6607},
-																run: (*parser).callonLetBinding184,
-																expr: &litMatcher{
-																	pos:        position{line: 241, col: 5, offset: 6607},
-																	val:        "Text/show",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 242, col: 5, offset: 6648},
-																run: (*parser).callonLetBinding186,
-																expr: &litMatcher{
-																	pos:        position{line: 242, col: 5, offset: 6648},
-																	val:        "Bool",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 243, col: 5, offset: 6680},
-																run: (*parser).callonLetBinding188,
-																expr: &litMatcher{
-																	pos:        position{line: 243, col: 5, offset: 6680},
-																	val:        "True",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 244, col: 5, offset: 6712},
-																run: (*parser).callonLetBinding190,
-																expr: &litMatcher{
-																	pos:        position{line: 244, col: 5, offset: 6712},
-																	val:        "False",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 245, col: 5, offset: 6746},
-																run: (*parser).callonLetBinding192,
-																expr: &litMatcher{
-																	pos:        position{line: 245, col: 5, offset: 6746},
-																	val:        "Optional",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 246, col: 5, offset: 6786},
-																run: (*parser).callonLetBinding194,
-																expr: &litMatcher{
-																	pos:        position{line: 246, col: 5, offset: 6786},
-																	val:        "Natural",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 247, col: 5, offset: 6824},
-																run: (*parser).callonLetBinding196,
-																expr: &litMatcher{
-																	pos:        position{line: 247, col: 5, offset: 6824},
-																	val:        "Integer",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 248, col: 5, offset: 6862},
-																run: (*parser).callonLetBinding198,
-																expr: &litMatcher{
-																	pos:        position{line: 248, col: 5, offset: 6862},
-																	val:        "Double",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 249, col: 5, offset: 6898},
-																run: (*parser).callonLetBinding200,
-																expr: &litMatcher{
-																	pos:        position{line: 249, col: 5, offset: 6898},
-																	val:        "Text",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 250, col: 5, offset: 6930},
-																run: (*parser).callonLetBinding202,
-																expr: &litMatcher{
-																	pos:        position{line: 250, col: 5, offset: 6930},
-																	val:        "List",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 251, col: 5, offset: 6962},
-																run: (*parser).callonLetBinding204,
-																expr: &litMatcher{
-																	pos:        position{line: 251, col: 5, offset: 6962},
-																	val:        "None",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 252, col: 5, offset: 6994},
-																run: (*parser).callonLetBinding206,
-																expr: &litMatcher{
-																	pos:        position{line: 252, col: 5, offset: 6994},
-																	val:        "Type",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 253, col: 5, offset: 7026},
-																run: (*parser).callonLetBinding208,
-																expr: &litMatcher{
-																	pos:        position{line: 253, col: 5, offset: 7026},
-																	val:        "Kind",
-																	ignoreCase: false,
-																},
-															},
-															&actionExpr{
-																pos: position{line: 254, col: 5, offset: 7058},
-																run: (*parser).callonLetBinding210,
-																expr: &litMatcher{
-																	pos:        position{line: 254, col: 5, offset: 7058},
-																	val:        "Sort",
-																	ignoreCase: false,
-																},
-															},
-														},
-													},
-												},
-												&labeledExpr{
-													pos:   position{line: 125, col: 29, offset: 3014},
-													label: "label",
-													expr: &choiceExpr{
-														pos: position{line: 121, col: 9, offset: 2802},
-														alternatives: []interface{}{
-															&actionExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																run: (*parser).callonLetBinding214,
-																expr: &seqExpr{
-																	pos: position{line: 121, col: 9, offset: 2802},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 121, col: 9, offset: 2802},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																		&labeledExpr{
-																			pos:   position{line: 121, col: 13, offset: 2806},
-																			label: "label",
-																			expr: &actionExpr{
-																				pos: position{line: 119, col: 15, offset: 2743},
-																				run: (*parser).callonLetBinding218,
-																				expr: &oneOrMoreExpr{
-																					pos: position{line: 119, col: 15, offset: 2743},
-																					expr: &charClassMatcher{
-																						pos:        position{line: 118, col: 19, offset: 2706},
-																						val:        "[ -_a-~]",
-																						ranges:     []rune{' ', '_', 'a', '~'},
-																						ignoreCase: false,
-																						inverted:   false,
-																					},
-																				},
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 121, col: 31, offset: 2824},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-															&actionExpr{
-																pos: position{line: 122, col: 9, offset: 2858},
-																run: (*parser).callonLetBinding222,
-																expr: &labeledExpr{
-																	pos:   position{line: 122, col: 9, offset: 2858},
-																	label: "label",
-																	expr: &choiceExpr{
-																		pos: position{line: 112, col: 15, offset: 2499},
-																		alternatives: []interface{}{
-																			&actionExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				run: (*parser).callonLetBinding225,
-																				expr: &seqExpr{
-																					pos: position{line: 112, col: 15, offset: 2499},
-																					exprs: []interface{}{
-																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
-																							alternatives: []interface{}{
-																								&litMatcher{
-																									pos:        position{line: 256, col: 6, offset: 7094},
-																									val:        "if",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 257, col: 8, offset: 7108},
-																									val:        "then",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 258, col: 8, offset: 7124},
-																									val:        "else",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 259, col: 7, offset: 7139},
-																									val:        "let",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 260, col: 6, offset: 7152},
-																									val:        "in",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 262, col: 9, offset: 7179},
-																									val:        "using",
-																									ignoreCase: false,
-																								},
-																								&actionExpr{
-																									pos: position{line: 264, col: 11, offset: 7217},
-																									run: (*parser).callonLetBinding234,
-																									expr: &litMatcher{
-																										pos:        position{line: 264, col: 11, offset: 7217},
-																										val:        "missing",
-																										ignoreCase: false,
-																									},
-																								},
-																								&litMatcher{
-																									pos:        position{line: 261, col: 6, offset: 7164},
-																									val:        "as",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 265, col: 8, offset: 7262},
-																									val:        "True",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 266, col: 9, offset: 7279},
-																									val:        "False",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 267, col: 12, offset: 7300},
-																									val:        "Infinity",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 268, col: 7, offset: 7319},
-																									val:        "NaN",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 263, col: 9, offset: 7197},
-																									val:        "merge",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 269, col: 8, offset: 7334},
-																									val:        "Some",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 270, col: 9, offset: 7351},
-																									val:        "toMap",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
-																								},
-																							},
-																						},
-																						&oneOrMoreExpr{
-																							pos: position{line: 112, col: 23, offset: 2507},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 113, col: 13, offset: 2571},
-																				run: (*parser).callonLetBinding247,
-																				expr: &seqExpr{
-																					pos: position{line: 113, col: 13, offset: 2571},
-																					exprs: []interface{}{
-																						&notExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
-																								alternatives: []interface{}{
-																									&litMatcher{
-																										pos:        position{line: 256, col: 6, offset: 7094},
-																										val:        "if",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 257, col: 8, offset: 7108},
-																										val:        "then",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 258, col: 8, offset: 7124},
-																										val:        "else",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 259, col: 7, offset: 7139},
-																										val:        "let",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 260, col: 6, offset: 7152},
-																										val:        "in",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 262, col: 9, offset: 7179},
-																										val:        "using",
-																										ignoreCase: false,
-																									},
-																									&actionExpr{
-																										pos: position{line: 264, col: 11, offset: 7217},
-																										run: (*parser).callonLetBinding257,
-																										expr: &litMatcher{
-																											pos:        position{line: 264, col: 11, offset: 7217},
-																											val:        "missing",
-																											ignoreCase: false,
-																										},
-																									},
-																									&litMatcher{
-																										pos:        position{line: 261, col: 6, offset: 7164},
-																										val:        "as",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 265, col: 8, offset: 7262},
-																										val:        "True",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 266, col: 9, offset: 7279},
-																										val:        "False",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 267, col: 12, offset: 7300},
-																										val:        "Infinity",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 268, col: 7, offset: 7319},
-																										val:        "NaN",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 263, col: 9, offset: 7197},
-																										val:        "merge",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 269, col: 8, offset: 7334},
-																										val:        "Some",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 270, col: 9, offset: 7351},
-																										val:        "toMap",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
-																									},
-																								},
-																							},
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 110, col: 24, offset: 2434},
-																							val:        "[_A-Za-z]",
-																							chars:      []rune{'_'},
-																							ranges:     []rune{'A', 'Z', 'a', 'z'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&zeroOrMoreExpr{
-																							pos: position{line: 113, col: 43, offset: 2601},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 484, col: 44, offset: 13571},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 484, col: 46, offset: 13573},
-							label: "a",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 484, col: 48, offset: 13575},
-								expr: &seqExpr{
-									pos: position{line: 484, col: 49, offset: 13576},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 484, col: 49, offset: 13576},
-											name: "Annotation",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 484, col: 60, offset: 13587},
-											name: "_",
-										},
-									},
-								},
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 485, col: 13, offset: 13603},
-							val:        "=",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 485, col: 17, offset: 13607},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 485, col: 19, offset: 13609},
-							label: "v",
-							expr: &ruleRefExpr{
-								pos:  position{line: 485, col: 21, offset: 13611},
-								name: "Expression",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 485, col: 32, offset: 13622},
-							name: "_",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Expression",
-			pos:  position{line: 500, col: 1, offset: 13931},
-			expr: &choiceExpr{
-				pos: position{line: 501, col: 7, offset: 13952},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 501, col: 7, offset: 13952},
-						run: (*parser).callonExpression2,
-						expr: &seqExpr{
-							pos: position{line: 501, col: 7, offset: 13952},
-							exprs: []interface{}{
-								&charClassMatcher{
-									pos:        position{line: 291, col: 10, offset: 7729},
-									val:        "[\\\\λ]",
-									chars:      []rune{'\\', 'λ'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 14, offset: 13959},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 501, col: 16, offset: 13961},
-									val:        "(",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 20, offset: 13965},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 501, col: 22, offset: 13967},
-									label: "label",
-									expr: &choiceExpr{
-										pos: position{line: 124, col: 20, offset: 2920},
-										alternatives: []interface{}{
-											&actionExpr{
-												pos: position{line: 124, col: 20, offset: 2920},
-												run: (*parser).callonExpression10,
-												expr: &seqExpr{
-													pos: position{line: 124, col: 20, offset: 2920},
-													exprs: []interface{}{
-														&andExpr{
-															pos: position{line: 124, col: 20, offset: 2920},
-															expr: &seqExpr{
-																pos: position{line: 124, col: 22, offset: 2922},
-																exprs: []interface{}{
-																	&choiceExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		alternatives: []interface{}{
-																			&actionExpr{
-																				pos: position{line: 221, col: 5, offset: 5657},
-																				run: (*parser).callonExpression15,
-																				expr: &litMatcher{
-																					pos:        position{line: 221, col: 5, offset: 5657},
-																					val:        "Natural/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 222, col: 5, offset: 5706},
-																				run: (*parser).callonExpression17,
-																				expr: &litMatcher{
-																					pos:        position{line: 222, col: 5, offset: 5706},
-																					val:        "Natural/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 223, col: 5, offset: 5753},
-																				run: (*parser).callonExpression19,
-																				expr: &litMatcher{
-																					pos:        position{line: 223, col: 5, offset: 5753},
-																					val:        "Natural/isZero",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 224, col: 5, offset: 5804},
-																				run: (*parser).callonExpression21,
-																				expr: &litMatcher{
-																					pos:        position{line: 224, col: 5, offset: 5804},
-																					val:        "Natural/even",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 225, col: 5, offset: 5851},
-																				run: (*parser).callonExpression23,
-																				expr: &litMatcher{
-																					pos:        position{line: 225, col: 5, offset: 5851},
-																					val:        "Natural/odd",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 226, col: 5, offset: 5896},
-																				run: (*parser).callonExpression25,
-																				expr: &litMatcher{
-																					pos:        position{line: 226, col: 5, offset: 5896},
-																					val:        "Natural/toInteger",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 227, col: 5, offset: 5953},
-																				run: (*parser).callonExpression27,
-																				expr: &litMatcher{
-																					pos:        position{line: 227, col: 5, offset: 5953},
-																					val:        "Natural/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 228, col: 5, offset: 6000},
-																				run: (*parser).callonExpression29,
-																				expr: &litMatcher{
-																					pos:        position{line: 228, col: 5, offset: 6000},
-																					val:        "Natural/subtract",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 229, col: 5, offset: 6055},
-																				run: (*parser).callonExpression31,
-																				expr: &litMatcher{
-																					pos:        position{line: 229, col: 5, offset: 6055},
-																					val:        "Integer/toDouble",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 230, col: 5, offset: 6110},
-																				run: (*parser).callonExpression33,
-																				expr: &litMatcher{
-																					pos:        position{line: 230, col: 5, offset: 6110},
-																					val:        "Integer/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 231, col: 5, offset: 6157},
-																				run: (*parser).callonExpression35,
-																				expr: &litMatcher{
-																					pos:        position{line: 231, col: 5, offset: 6157},
-																					val:        "Double/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 232, col: 5, offset: 6202},
-																				run: (*parser).callonExpression37,
-																				expr: &litMatcher{
-																					pos:        position{line: 232, col: 5, offset: 6202},
-																					val:        "List/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 233, col: 5, offset: 6245},
-																				run: (*parser).callonExpression39,
-																				expr: &litMatcher{
-																					pos:        position{line: 233, col: 5, offset: 6245},
-																					val:        "List/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 234, col: 5, offset: 6286},
-																				run: (*parser).callonExpression41,
-																				expr: &litMatcher{
-																					pos:        position{line: 234, col: 5, offset: 6286},
-																					val:        "List/length",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 235, col: 5, offset: 6331},
-																				run: (*parser).callonExpression43,
-																				expr: &litMatcher{
-																					pos:        position{line: 235, col: 5, offset: 6331},
-																					val:        "List/head",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 236, col: 5, offset: 6372},
-																				run: (*parser).callonExpression45,
-																				expr: &litMatcher{
-																					pos:        position{line: 236, col: 5, offset: 6372},
-																					val:        "List/last",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 237, col: 5, offset: 6413},
-																				run: (*parser).callonExpression47,
-																				expr: &litMatcher{
-																					pos:        position{line: 237, col: 5, offset: 6413},
-																					val:        "List/indexed",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 238, col: 5, offset: 6460},
-																				run: (*parser).callonExpression49,
-																				expr: &litMatcher{
-																					pos:        position{line: 238, col: 5, offset: 6460},
-																					val:        "List/reverse",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 239, col: 5, offset: 6507},
-																				run: (*parser).callonExpression51,
-																				expr: &litMatcher{
-																					pos:        position{line: 239, col: 5, offset: 6507},
-																					val:        "Optional/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 240, col: 5, offset: 6558},
-																				run: (*parser).callonExpression53,
-																				expr: &litMatcher{
-																					pos:        position{line: 240, col: 5, offset: 6558},
-																					val:        "Optional/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 241, col: 5, offset: 6607},
-																				run: (*parser).callonExpression55,
-																				expr: &litMatcher{
-																					pos:        position{line: 241, col: 5, offset: 6607},
-																					val:        "Text/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 242, col: 5, offset: 6648},
-																				run: (*parser).callonExpression57,
-																				expr: &litMatcher{
-																					pos:        position{line: 242, col: 5, offset: 6648},
-																					val:        "Bool",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 243, col: 5, offset: 6680},
-																				run: (*parser).callonExpression59,
-																				expr: &litMatcher{
-																					pos:        position{line: 243, col: 5, offset: 6680},
-																					val:        "True",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 244, col: 5, offset: 6712},
-																				run: (*parser).callonExpression61,
-																				expr: &litMatcher{
-																					pos:        position{line: 244, col: 5, offset: 6712},
-																					val:        "False",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 245, col: 5, offset: 6746},
-																				run: (*parser).callonExpression63,
-																				expr: &litMatcher{
-																					pos:        position{line: 245, col: 5, offset: 6746},
-																					val:        "Optional",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 246, col: 5, offset: 6786},
-																				run: (*parser).callonExpression65,
-																				expr: &litMatcher{
-																					pos:        position{line: 246, col: 5, offset: 6786},
-																					val:        "Natural",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 247, col: 5, offset: 6824},
-																				run: (*parser).callonExpression67,
-																				expr: &litMatcher{
-																					pos:        position{line: 247, col: 5, offset: 6824},
-																					val:        "Integer",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 248, col: 5, offset: 6862},
-																				run: (*parser).callonExpression69,
-																				expr: &litMatcher{
-																					pos:        position{line: 248, col: 5, offset: 6862},
-																					val:        "Double",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 249, col: 5, offset: 6898},
-																				run: (*parser).callonExpression71,
-																				expr: &litMatcher{
-																					pos:        position{line: 249, col: 5, offset: 6898},
-																					val:        "Text",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 250, col: 5, offset: 6930},
-																				run: (*parser).callonExpression73,
-																				expr: &litMatcher{
-																					pos:        position{line: 250, col: 5, offset: 6930},
-																					val:        "List",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 251, col: 5, offset: 6962},
-																				run: (*parser).callonExpression75,
-																				expr: &litMatcher{
-																					pos:        position{line: 251, col: 5, offset: 6962},
-																					val:        "None",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 252, col: 5, offset: 6994},
-																				run: (*parser).callonExpression77,
-																				expr: &litMatcher{
-																					pos:        position{line: 252, col: 5, offset: 6994},
-																					val:        "Type",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 253, col: 5, offset: 7026},
-																				run: (*parser).callonExpression79,
-																				expr: &litMatcher{
-																					pos:        position{line: 253, col: 5, offset: 7026},
-																					val:        "Kind",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 254, col: 5, offset: 7058},
-																				run: (*parser).callonExpression81,
-																				expr: &litMatcher{
-																					pos:        position{line: 254, col: 5, offset: 7058},
-																					val:        "Sort",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&charClassMatcher{
-																		pos:        position{line: 111, col: 23, offset: 2468},
-																		val:        "[_/-A-Za-z0-9]",
-																		chars:      []rune{'_', '/', '-'},
-																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
-																},
-															},
-														},
-														&labeledExpr{
-															pos:   position{line: 124, col: 52, offset: 2952},
-															label: "label",
-															expr: &choiceExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 121, col: 9, offset: 2802},
-																		run: (*parser).callonExpression86,
-																		expr: &seqExpr{
-																			pos: position{line: 121, col: 9, offset: 2802},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 121, col: 9, offset: 2802},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																				&labeledExpr{
-																					pos:   position{line: 121, col: 13, offset: 2806},
-																					label: "label",
-																					expr: &actionExpr{
-																						pos: position{line: 119, col: 15, offset: 2743},
-																						run: (*parser).callonExpression90,
-																						expr: &oneOrMoreExpr{
-																							pos: position{line: 119, col: 15, offset: 2743},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 118, col: 19, offset: 2706},
-																								val:        "[ -_a-~]",
-																								ranges:     []rune{' ', '_', 'a', '~'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																				&litMatcher{
-																					pos:        position{line: 121, col: 31, offset: 2824},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 122, col: 9, offset: 2858},
-																		run: (*parser).callonExpression94,
-																		expr: &labeledExpr{
-																			pos:   position{line: 122, col: 9, offset: 2858},
-																			label: "label",
-																			expr: &choiceExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				alternatives: []interface{}{
-																					&actionExpr{
-																						pos: position{line: 112, col: 15, offset: 2499},
-																						run: (*parser).callonExpression97,
-																						expr: &seqExpr{
-																							pos: position{line: 112, col: 15, offset: 2499},
-																							exprs: []interface{}{
-																								&choiceExpr{
-																									pos: position{line: 274, col: 5, offset: 7396},
-																									alternatives: []interface{}{
-																										&litMatcher{
-																											pos:        position{line: 256, col: 6, offset: 7094},
-																											val:        "if",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 257, col: 8, offset: 7108},
-																											val:        "then",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 258, col: 8, offset: 7124},
-																											val:        "else",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 259, col: 7, offset: 7139},
-																											val:        "let",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 260, col: 6, offset: 7152},
-																											val:        "in",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 262, col: 9, offset: 7179},
-																											val:        "using",
-																											ignoreCase: false,
-																										},
-																										&actionExpr{
-																											pos: position{line: 264, col: 11, offset: 7217},
-																											run: (*parser).callonExpression106,
-																											expr: &litMatcher{
-																												pos:        position{line: 264, col: 11, offset: 7217},
-																												val:        "missing",
-																												ignoreCase: false,
-																											},
-																										},
-																										&litMatcher{
-																											pos:        position{line: 261, col: 6, offset: 7164},
-																											val:        "as",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 265, col: 8, offset: 7262},
-																											val:        "True",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 266, col: 9, offset: 7279},
-																											val:        "False",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 267, col: 12, offset: 7300},
-																											val:        "Infinity",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 268, col: 7, offset: 7319},
-																											val:        "NaN",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 263, col: 9, offset: 7197},
-																											val:        "merge",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 269, col: 8, offset: 7334},
-																											val:        "Some",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 270, col: 9, offset: 7351},
-																											val:        "toMap",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 271, col: 10, offset: 7370},
-																											val:        "assert",
-																											ignoreCase: false,
-																										},
-																									},
-																								},
-																								&oneOrMoreExpr{
-																									pos: position{line: 112, col: 23, offset: 2507},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 113, col: 13, offset: 2571},
-																						run: (*parser).callonExpression119,
-																						expr: &seqExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							exprs: []interface{}{
-																								&notExpr{
-																									pos: position{line: 113, col: 13, offset: 2571},
-																									expr: &choiceExpr{
-																										pos: position{line: 274, col: 5, offset: 7396},
-																										alternatives: []interface{}{
-																											&litMatcher{
-																												pos:        position{line: 256, col: 6, offset: 7094},
-																												val:        "if",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 257, col: 8, offset: 7108},
-																												val:        "then",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 258, col: 8, offset: 7124},
-																												val:        "else",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 259, col: 7, offset: 7139},
-																												val:        "let",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 260, col: 6, offset: 7152},
-																												val:        "in",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 262, col: 9, offset: 7179},
-																												val:        "using",
-																												ignoreCase: false,
-																											},
-																											&actionExpr{
-																												pos: position{line: 264, col: 11, offset: 7217},
-																												run: (*parser).callonExpression129,
-																												expr: &litMatcher{
-																													pos:        position{line: 264, col: 11, offset: 7217},
-																													val:        "missing",
-																													ignoreCase: false,
-																												},
-																											},
-																											&litMatcher{
-																												pos:        position{line: 261, col: 6, offset: 7164},
-																												val:        "as",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 265, col: 8, offset: 7262},
-																												val:        "True",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 266, col: 9, offset: 7279},
-																												val:        "False",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 267, col: 12, offset: 7300},
-																												val:        "Infinity",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 268, col: 7, offset: 7319},
-																												val:        "NaN",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 263, col: 9, offset: 7197},
-																												val:        "merge",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 269, col: 8, offset: 7334},
-																												val:        "Some",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 270, col: 9, offset: 7351},
-																												val:        "toMap",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 271, col: 10, offset: 7370},
-																												val:        "assert",
-																												ignoreCase: false,
-																											},
-																										},
-																									},
-																								},
-																								&charClassMatcher{
-																									pos:        position{line: 110, col: 24, offset: 2434},
-																									val:        "[_A-Za-z]",
-																									chars:      []rune{'_'},
-																									ranges:     []rune{'A', 'Z', 'a', 'z'},
-																									ignoreCase: false,
-																									inverted:   false,
-																								},
-																								&zeroOrMoreExpr{
-																									pos: position{line: 113, col: 43, offset: 2601},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-											&actionExpr{
-												pos: position{line: 125, col: 19, offset: 3004},
-												run: (*parser).callonExpression143,
-												expr: &seqExpr{
-													pos: position{line: 125, col: 19, offset: 3004},
-													exprs: []interface{}{
-														&notExpr{
-															pos: position{line: 125, col: 19, offset: 3004},
-															expr: &choiceExpr{
-																pos: position{line: 221, col: 5, offset: 5657},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		run: (*parser).callonExpression147,
-																		expr: &litMatcher{
-																			pos:        position{line: 221, col: 5, offset: 5657},
-																			val:        "Natural/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 222, col: 5, offset: 5706},
-																		run: (*parser).callonExpression149,
-																		expr: &litMatcher{
-																			pos:        position{line: 222, col: 5, offset: 5706},
-																			val:        "Natural/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 223, col: 5, offset: 5753},
-																		run: (*parser).callonExpression151,
-																		expr: &litMatcher{
-																			pos:        position{line: 223, col: 5, offset: 5753},
-																			val:        "Natural/isZero",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 224, col: 5, offset: 5804},
-																		run: (*parser).callonExpression153,
-																		expr: &litMatcher{
-																			pos:        position{line: 224, col: 5, offset: 5804},
-																			val:        "Natural/even",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 225, col: 5, offset: 5851},
-																		run: (*parser).callonExpression155,
-																		expr: &litMatcher{
-																			pos:        position{line: 225, col: 5, offset: 5851},
-																			val:        "Natural/odd",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 226, col: 5, offset: 5896},
-																		run: (*parser).callonExpression157,
-																		expr: &litMatcher{
-																			pos:        position{line: 226, col: 5, offset: 5896},
-																			val:        "Natural/toInteger",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 227, col: 5, offset: 5953},
-																		run: (*parser).callonExpression159,
-																		expr: &litMatcher{
-																			pos:        position{line: 227, col: 5, offset: 5953},
-																			val:        "Natural/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 6000},
-																		run: (*parser).callonExpression161,
-																		expr: &litMatcher{
-																			pos:        position{line: 228, col: 5, offset: 6000},
-																			val:        "Natural/subtract",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 229, col: 5, offset: 6055},
-																		run: (*parser).callonExpression163,
-																		expr: &litMatcher{
-																			pos:        position{line: 229, col: 5, offset: 6055},
-																			val:        "Integer/toDouble",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 230, col: 5, offset: 6110},
-																		run: (*parser).callonExpression165,
-																		expr: &litMatcher{
-																			pos:        position{line: 230, col: 5, offset: 6110},
-																			val:        "Integer/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 231, col: 5, offset: 6157},
-																		run: (*parser).callonExpression167,
-																		expr: &litMatcher{
-																			pos:        position{line: 231, col: 5, offset: 6157},
-																			val:        "Double/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 232, col: 5, offset: 6202},
-																		run: (*parser).callonExpression169,
-																		expr: &litMatcher{
-																			pos:        position{line: 232, col: 5, offset: 6202},
-																			val:        "List/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 233, col: 5, offset: 6245},
-																		run: (*parser).callonExpression171,
-																		expr: &litMatcher{
-																			pos:        position{line: 233, col: 5, offset: 6245},
-																			val:        "List/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 234, col: 5, offset: 6286},
-																		run: (*parser).callonExpression173,
-																		expr: &litMatcher{
-																			pos:        position{line: 234, col: 5, offset: 6286},
-																			val:        "List/length",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 235, col: 5, offset: 6331},
-																		run: (*parser).callonExpression175,
-																		expr: &litMatcher{
-																			pos:        position{line: 235, col: 5, offset: 6331},
-																			val:        "List/head",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 236, col: 5, offset: 6372},
-																		run: (*parser).callonExpression177,
-																		expr: &litMatcher{
-																			pos:        position{line: 236, col: 5, offset: 6372},
-																			val:        "List/last",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 237, col: 5, offset: 6413},
-																		run: (*parser).callonExpression179,
-																		expr: &litMatcher{
-																			pos:        position{line: 237, col: 5, offset: 6413},
-																			val:        "List/indexed",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 238, col: 5, offset: 6460},
-																		run: (*parser).callonExpression181,
-																		expr: &litMatcher{
-																			pos:        position{line: 238, col: 5, offset: 6460},
-																			val:        "List/reverse",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 239, col: 5, offset: 6507},
-																		run: (*parser).callonExpression183,
-																		expr: &litMatcher{
-																			pos:        position{line: 239, col: 5, offset: 6507},
-																			val:        "Optional/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 240, col: 5, offset: 6558},
-																		run: (*parser).callonExpression185,
-																		expr: &litMatcher{
-																			pos:        position{line: 240, col: 5, offset: 6558},
-																			val:        "Optional/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 241, col: 5, offset: 6607},
-																		run: (*parser).callonExpression187,
-																		expr: &litMatcher{
-																			pos:        position{line: 241, col: 5, offset: 6607},
-																			val:        "Text/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 242, col: 5, offset: 6648},
-																		run: (*parser).callonExpression189,
-																		expr: &litMatcher{
-																			pos:        position{line: 242, col: 5, offset: 6648},
-																			val:        "Bool",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 243, col: 5, offset: 6680},
-																		run: (*parser).callonExpression191,
-																		expr: &litMatcher{
-																			pos:        position{line: 243, col: 5, offset: 6680},
-																			val:        "True",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 244, col: 5, offset: 6712},
-																		run: (*parser).callonExpression193,
-																		expr: &litMatcher{
-																			pos:        position{line: 244, col: 5, offset: 6712},
-																			val:        "False",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 245, col: 5, offset: 6746},
-																		run: (*parser).callonExpression195,
-																		expr: &litMatcher{
-																			pos:        position{line: 245, col: 5, offset: 6746},
-																			val:        "Optional",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 246, col: 5, offset: 6786},
-																		run: (*parser).callonExpression197,
-																		expr: &litMatcher{
-																			pos:        position{line: 246, col: 5, offset: 6786},
-																			val:        "Natural",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 247, col: 5, offset: 6824},
-																		run: (*parser).callonExpression199,
-																		expr: &litMatcher{
-																			pos:        position{line: 247, col: 5, offset: 6824},
-																			val:        "Integer",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 248, col: 5, offset: 6862},
-																		run: (*parser).callonExpression201,
-																		expr: &litMatcher{
-																			pos:        position{line: 248, col: 5, offset: 6862},
-																			val:        "Double",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 249, col: 5, offset: 6898},
-																		run: (*parser).callonExpression203,
-																		expr: &litMatcher{
-																			pos:        position{line: 249, col: 5, offset: 6898},
-																			val:        "Text",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 250, col: 5, offset: 6930},
-																		run: (*parser).callonExpression205,
-																		expr: &litMatcher{
-																			pos:        position{line: 250, col: 5, offset: 6930},
-																			val:        "List",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 251, col: 5, offset: 6962},
-																		run: (*parser).callonExpression207,
-																		expr: &litMatcher{
-																			pos:        position{line: 251, col: 5, offset: 6962},
-																			val:        "None",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 252, col: 5, offset: 6994},
-																		run: (*parser).callonExpression209,
-																		expr: &litMatcher{
-																			pos:        position{line: 252, col: 5, offset: 6994},
-																			val:        "Type",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 253, col: 5, offset: 7026},
-																		run: (*parser).callonExpression211,
-																		expr: &litMatcher{
-																			pos:        position{line: 253, col: 5, offset: 7026},
-																			val:        "Kind",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 254, col: 5, offset: 7058},
-																		run: (*parser).callonExpression213,
-																		expr: &litMatcher{
-																			pos:        position{line: 254, col: 5, offset: 7058},
-																			val:        "Sort",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-														},
-														&labeledExpr{
-															pos:   position{line: 125, col: 29, offset: 3014},
-															label: "label",
-															expr: &choiceExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 121, col: 9, offset: 2802},
-																		run: (*parser).callonExpression217,
-																		expr: &seqExpr{
-																			pos: position{line: 121, col: 9, offset: 2802},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 121, col: 9, offset: 2802},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																				&labeledExpr{
-																					pos:   position{line: 121, col: 13, offset: 2806},
-																					label: "label",
-																					expr: &actionExpr{
-																						pos: position{line: 119, col: 15, offset: 2743},
-																						run: (*parser).callonExpression221,
-																						expr: &oneOrMoreExpr{
-																							pos: position{line: 119, col: 15, offset: 2743},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 118, col: 19, offset: 2706},
-																								val:        "[ -_a-~]",
-																								ranges:     []rune{' ', '_', 'a', '~'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																				&litMatcher{
-																					pos:        position{line: 121, col: 31, offset: 2824},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 122, col: 9, offset: 2858},
-																		run: (*parser).callonExpression225,
-																		expr: &labeledExpr{
-																			pos:   position{line: 122, col: 9, offset: 2858},
-																			label: "label",
-																			expr: &choiceExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				alternatives: []interface{}{
-																					&actionExpr{
-																						pos: position{line: 112, col: 15, offset: 2499},
-																						run: (*parser).callonExpression228,
-																						expr: &seqExpr{
-																							pos: position{line: 112, col: 15, offset: 2499},
-																							exprs: []interface{}{
-																								&choiceExpr{
-																									pos: position{line: 274, col: 5, offset: 7396},
-																									alternatives: []interface{}{
-																										&litMatcher{
-																											pos:        position{line: 256, col: 6, offset: 7094},
-																											val:        "if",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 257, col: 8, offset: 7108},
-																											val:        "then",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 258, col: 8, offset: 7124},
-																											val:        "else",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 259, col: 7, offset: 7139},
-																											val:        "let",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 260, col: 6, offset: 7152},
-																											val:        "in",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 262, col: 9, offset: 7179},
-																											val:        "using",
-																											ignoreCase: false,
-																										},
-																										&actionExpr{
-																											pos: position{line: 264, col: 11, offset: 7217},
-																											run: (*parser).callonExpression237,
-																											expr: &litMatcher{
-																												pos:        position{line: 264, col: 11, offset: 7217},
-																												val:        "missing",
-																												ignoreCase: false,
-																											},
-																										},
-																										&litMatcher{
-																											pos:        position{line: 261, col: 6, offset: 7164},
-																											val:        "as",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 265, col: 8, offset: 7262},
-																											val:        "True",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 266, col: 9, offset: 7279},
-																											val:        "False",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 267, col: 12, offset: 7300},
-																											val:        "Infinity",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 268, col: 7, offset: 7319},
-																											val:        "NaN",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 263, col: 9, offset: 7197},
-																											val:        "merge",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 269, col: 8, offset: 7334},
-																											val:        "Some",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 270, col: 9, offset: 7351},
-																											val:        "toMap",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 271, col: 10, offset: 7370},
-																											val:        "assert",
-																											ignoreCase: false,
-																										},
-																									},
-																								},
-																								&oneOrMoreExpr{
-																									pos: position{line: 112, col: 23, offset: 2507},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 113, col: 13, offset: 2571},
-																						run: (*parser).callonExpression250,
-																						expr: &seqExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							exprs: []interface{}{
-																								&notExpr{
-																									pos: position{line: 113, col: 13, offset: 2571},
-																									expr: &choiceExpr{
-																										pos: position{line: 274, col: 5, offset: 7396},
-																										alternatives: []interface{}{
-																											&litMatcher{
-																												pos:        position{line: 256, col: 6, offset: 7094},
-																												val:        "if",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 257, col: 8, offset: 7108},
-																												val:        "then",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 258, col: 8, offset: 7124},
-																												val:        "else",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 259, col: 7, offset: 7139},
-																												val:        "let",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 260, col: 6, offset: 7152},
-																												val:        "in",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 262, col: 9, offset: 7179},
-																												val:        "using",
-																												ignoreCase: false,
-																											},
-																											&actionExpr{
-																												pos: position{line: 264, col: 11, offset: 7217},
-																												run: (*parser).callonExpression260,
-																												expr: &litMatcher{
-																													pos:        position{line: 264, col: 11, offset: 7217},
-																													val:        "missing",
-																													ignoreCase: false,
-																												},
-																											},
-																											&litMatcher{
-																												pos:        position{line: 261, col: 6, offset: 7164},
-																												val:        "as",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 265, col: 8, offset: 7262},
-																												val:        "True",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 266, col: 9, offset: 7279},
-																												val:        "False",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 267, col: 12, offset: 7300},
-																												val:        "Infinity",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 268, col: 7, offset: 7319},
-																												val:        "NaN",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 263, col: 9, offset: 7197},
-																												val:        "merge",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 269, col: 8, offset: 7334},
-																												val:        "Some",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 270, col: 9, offset: 7351},
-																												val:        "toMap",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 271, col: 10, offset: 7370},
-																												val:        "assert",
-																												ignoreCase: false,
-																											},
-																										},
-																									},
-																								},
-																								&charClassMatcher{
-																									pos:        position{line: 110, col: 24, offset: 2434},
-																									val:        "[_A-Za-z]",
-																									chars:      []rune{'_'},
-																									ranges:     []rune{'A', 'Z', 'a', 'z'},
-																									ignoreCase: false,
-																									inverted:   false,
-																								},
-																								&zeroOrMoreExpr{
-																									pos: position{line: 113, col: 43, offset: 2601},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 45, offset: 13990},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 501, col: 47, offset: 13992},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 51, offset: 13996},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 501, col: 54, offset: 13999},
-									label: "t",
-									expr: &ruleRefExpr{
-										pos:  position{line: 501, col: 56, offset: 14001},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 67, offset: 14012},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 501, col: 69, offset: 14014},
-									val:        ")",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 73, offset: 14018},
-									name: "_",
-								},
-								&choiceExpr{
-									pos: position{line: 293, col: 9, offset: 7779},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 293, col: 9, offset: 7779},
-											val:        "->",
-											ignoreCase: false,
-										},
-										&litMatcher{
-											pos:        position{line: 293, col: 16, offset: 7786},
-											val:        "→",
-											ignoreCase: false,
-										},
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 81, offset: 14026},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 501, col: 83, offset: 14028},
-									label: "body",
-									expr: &ruleRefExpr{
-										pos:  position{line: 501, col: 88, offset: 14033},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 504, col: 7, offset: 14149},
-						run: (*parser).callonExpression288,
-						expr: &seqExpr{
-							pos: position{line: 504, col: 7, offset: 14149},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 256, col: 6, offset: 7094},
-									val:        "if",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 10, offset: 14152},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 504, col: 13, offset: 14155},
-									label: "cond",
-									expr: &ruleRefExpr{
-										pos:  position{line: 504, col: 18, offset: 14160},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 29, offset: 14171},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 257, col: 8, offset: 7108},
-									val:        "then",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 36, offset: 14178},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 504, col: 39, offset: 14181},
-									label: "t",
-									expr: &ruleRefExpr{
-										pos:  position{line: 504, col: 41, offset: 14183},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 52, offset: 14194},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 258, col: 8, offset: 7124},
-									val:        "else",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 59, offset: 14201},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 504, col: 62, offset: 14204},
-									label: "f",
-									expr: &ruleRefExpr{
-										pos:  position{line: 504, col: 64, offset: 14206},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 507, col: 7, offset: 14292},
-						run: (*parser).callonExpression304,
-						expr: &seqExpr{
-							pos: position{line: 507, col: 7, offset: 14292},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 507, col: 7, offset: 14292},
-									label: "bindings",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 507, col: 16, offset: 14301},
-										expr: &ruleRefExpr{
-											pos:  position{line: 507, col: 16, offset: 14301},
-											name: "LetBinding",
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 260, col: 6, offset: 7152},
-									val:        "in",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 507, col: 31, offset: 14316},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 507, col: 34, offset: 14319},
-									label: "b",
-									expr: &ruleRefExpr{
-										pos:  position{line: 507, col: 36, offset: 14321},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 514, col: 7, offset: 14560},
-						run: (*parser).callonExpression313,
-						expr: &seqExpr{
-							pos: position{line: 514, col: 7, offset: 14560},
-							exprs: []interface{}{
-								&choiceExpr{
-									pos: position{line: 292, col: 10, offset: 7752},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 292, col: 10, offset: 7752},
-											val:        "forall",
-											ignoreCase: false,
-										},
-										&litMatcher{
-											pos:        position{line: 292, col: 21, offset: 7763},
-											val:        "∀",
-											ignoreCase: false,
-										},
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 14, offset: 14567},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 514, col: 16, offset: 14569},
-									val:        "(",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 20, offset: 14573},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 514, col: 22, offset: 14575},
-									label: "label",
-									expr: &choiceExpr{
-										pos: position{line: 124, col: 20, offset: 2920},
-										alternatives: []interface{}{
-											&actionExpr{
-												pos: position{line: 124, col: 20, offset: 2920},
-												run: (*parser).callonExpression323,
-												expr: &seqExpr{
-													pos: position{line: 124, col: 20, offset: 2920},
-													exprs: []interface{}{
-														&andExpr{
-															pos: position{line: 124, col: 20, offset: 2920},
-															expr: &seqExpr{
-																pos: position{line: 124, col: 22, offset: 2922},
-																exprs: []interface{}{
-																	&choiceExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		alternatives: []interface{}{
-																			&actionExpr{
-																				pos: position{line: 221, col: 5, offset: 5657},
-																				run: (*parser).callonExpression328,
-																				expr: &litMatcher{
-																					pos:        position{line: 221, col: 5, offset: 5657},
-																					val:        "Natural/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 222, col: 5, offset: 5706},
-																				run: (*parser).callonExpression330,
-																				expr: &litMatcher{
-																					pos:        position{line: 222, col: 5, offset: 5706},
-																					val:        "Natural/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 223, col: 5, offset: 5753},
-																				run: (*parser).callonExpression332,
-																				expr: &litMatcher{
-																					pos:        position{line: 223, col: 5, offset: 5753},
-																					val:        "Natural/isZero",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 224, col: 5, offset: 5804},
-																				run: (*parser).callonExpression334,
-																				expr: &litMatcher{
-																					pos:        position{line: 224, col: 5, offset: 5804},
-																					val:        "Natural/even",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 225, col: 5, offset: 5851},
-																				run: (*parser).callonExpression336,
-																				expr: &litMatcher{
-																					pos:        position{line: 225, col: 5, offset: 5851},
-																					val:        "Natural/odd",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 226, col: 5, offset: 5896},
-																				run: (*parser).callonExpression338,
-																				expr: &litMatcher{
-																					pos:        position{line: 226, col: 5, offset: 5896},
-																					val:        "Natural/toInteger",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 227, col: 5, offset: 5953},
-																				run: (*parser).callonExpression340,
-																				expr: &litMatcher{
-																					pos:        position{line: 227, col: 5, offset: 5953},
-																					val:        "Natural/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 228, col: 5, offset: 6000},
-																				run: (*parser).callonExpression342,
-																				expr: &litMatcher{
-																					pos:        position{line: 228, col: 5, offset: 6000},
-																					val:        "Natural/subtract",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 229, col: 5, offset: 6055},
-																				run: (*parser).callonExpression344,
-																				expr: &litMatcher{
-																					pos:        position{line: 229, col: 5, offset: 6055},
-																					val:        "Integer/toDouble",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 230, col: 5, offset: 6110},
-																				run: (*parser).callonExpression346,
-																				expr: &litMatcher{
-																					pos:        position{line: 230, col: 5, offset: 6110},
-																					val:        "Integer/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 231, col: 5, offset: 6157},
-																				run: (*parser).callonExpression348,
-																				expr: &litMatcher{
-																					pos:        position{line: 231, col: 5, offset: 6157},
-																					val:        "Double/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 232, col: 5, offset: 6202},
-																				run: (*parser).callonExpression350,
-																				expr: &litMatcher{
-																					pos:        position{line: 232, col: 5, offset: 6202},
-																					val:        "List/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 233, col: 5, offset: 6245},
-																				run: (*parser).callonExpression352,
-																				expr: &litMatcher{
-																					pos:        position{line: 233, col: 5, offset: 6245},
-																					val:        "List/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 234, col: 5, offset: 6286},
-																				run: (*parser).callonExpression354,
-																				expr: &litMatcher{
-																					pos:        position{line: 234, col: 5, offset: 6286},
-																					val:        "List/length",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 235, col: 5, offset: 6331},
-																				run: (*parser).callonExpression356,
-																				expr: &litMatcher{
-																					pos:        position{line: 235, col: 5, offset: 6331},
-																					val:        "List/head",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 236, col: 5, offset: 6372},
-																				run: (*parser).callonExpression358,
-																				expr: &litMatcher{
-																					pos:        position{line: 236, col: 5, offset: 6372},
-																					val:        "List/last",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 237, col: 5, offset: 6413},
-																				run: (*parser).callonExpression360,
-																				expr: &litMatcher{
-																					pos:        position{line: 237, col: 5, offset: 6413},
-																					val:        "List/indexed",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 238, col: 5, offset: 6460},
-																				run: (*parser).callonExpression362,
-																				expr: &litMatcher{
-																					pos:        position{line: 238, col: 5, offset: 6460},
-																					val:        "List/reverse",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 239, col: 5, offset: 6507},
-																				run: (*parser).callonExpression364,
-																				expr: &litMatcher{
-																					pos:        position{line: 239, col: 5, offset: 6507},
-																					val:        "Optional/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 240, col: 5, offset: 6558},
-																				run: (*parser).callonExpression366,
-																				expr: &litMatcher{
-																					pos:        position{line: 240, col: 5, offset: 6558},
-																					val:        "Optional/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 241, col: 5, offset: 6607},
-																				run: (*parser).callonExpression368,
-																				expr: &litMatcher{
-																					pos:        position{line: 241, col: 5, offset: 6607},
-																					val:        "Text/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 242, col: 5, offset: 6648},
-																				run: (*parser).callonExpression370,
-																				expr: &litMatcher{
-																					pos:        position{line: 242, col: 5, offset: 6648},
-																					val:        "Bool",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 243, col: 5, offset: 6680},
-																				run: (*parser).callonExpression372,
-																				expr: &litMatcher{
-																					pos:        position{line: 243, col: 5, offset: 6680},
-																					val:        "True",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 244, col: 5, offset: 6712},
-																				run: (*parser).callonExpression374,
-																				expr: &litMatcher{
-																					pos:        position{line: 244, col: 5, offset: 6712},
-																					val:        "False",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 245, col: 5, offset: 6746},
-																				run: (*parser).callonExpression376,
-																				expr: &litMatcher{
-																					pos:        position{line: 245, col: 5, offset: 6746},
-																					val:        "Optional",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 246, col: 5, offset: 6786},
-																				run: (*parser).callonExpression378,
-																				expr: &litMatcher{
-																					pos:        position{line: 246, col: 5, offset: 6786},
-																					val:        "Natural",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 247, col: 5, offset: 6824},
-																				run: (*parser).callonExpression380,
-																				expr: &litMatcher{
-																					pos:        position{line: 247, col: 5, offset: 6824},
-																					val:        "Integer",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 248, col: 5, offset: 6862},
-																				run: (*parser).callonExpression382,
-																				expr: &litMatcher{
-																					pos:        position{line: 248, col: 5, offset: 6862},
-																					val:        "Double",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 249, col: 5, offset: 6898},
-																				run: (*parser).callonExpression384,
-																				expr: &litMatcher{
-																					pos:        position{line: 249, col: 5, offset: 6898},
-																					val:        "Text",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 250, col: 5, offset: 6930},
-																				run: (*parser).callonExpression386,
-																				expr: &litMatcher{
-																					pos:        position{line: 250, col: 5, offset: 6930},
-																					val:        "List",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 251, col: 5, offset: 6962},
-																				run: (*parser).callonExpression388,
-																				expr: &litMatcher{
-																					pos:        position{line: 251, col: 5, offset: 6962},
-																					val:        "None",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 252, col: 5, offset: 6994},
-																				run: (*parser).callonExpression390,
-																				expr: &litMatcher{
-																					pos:        position{line: 252, col: 5, offset: 6994},
-																					val:        "Type",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 253, col: 5, offset: 7026},
-																				run: (*parser).callonExpression392,
-																				expr: &litMatcher{
-																					pos:        position{line: 253, col: 5, offset: 7026},
-																					val:        "Kind",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 254, col: 5, offset: 7058},
-																				run: (*parser).callonExpression394,
-																				expr: &litMatcher{
-																					pos:        position{line: 254, col: 5, offset: 7058},
-																					val:        "Sort",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&charClassMatcher{
-																		pos:        position{line: 111, col: 23, offset: 2468},
-																		val:        "[_/-A-Za-z0-9]",
-																		chars:      []rune{'_', '/', '-'},
-																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
-																},
-															},
-														},
-														&labeledExpr{
-															pos:   position{line: 124, col: 52, offset: 2952},
-															label: "label",
-															expr: &choiceExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 121, col: 9, offset: 2802},
-																		run: (*parser).callonExpression399,
-																		expr: &seqExpr{
-																			pos: position{line: 121, col: 9, offset: 2802},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 121, col: 9, offset: 2802},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																				&labeledExpr{
-																					pos:   position{line: 121, col: 13, offset: 2806},
-																					label: "label",
-																					expr: &actionExpr{
-																						pos: position{line: 119, col: 15, offset: 2743},
-																						run: (*parser).callonExpression403,
-																						expr: &oneOrMoreExpr{
-																							pos: position{line: 119, col: 15, offset: 2743},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 118, col: 19, offset: 2706},
-																								val:        "[ -_a-~]",
-																								ranges:     []rune{' ', '_', 'a', '~'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																				&litMatcher{
-																					pos:        position{line: 121, col: 31, offset: 2824},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 122, col: 9, offset: 2858},
-																		run: (*parser).callonExpression407,
-																		expr: &labeledExpr{
-																			pos:   position{line: 122, col: 9, offset: 2858},
-																			label: "label",
-																			expr: &choiceExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				alternatives: []interface{}{
-																					&actionExpr{
-																						pos: position{line: 112, col: 15, offset: 2499},
-																						run: (*parser).callonExpression410,
-																						expr: &seqExpr{
-																							pos: position{line: 112, col: 15, offset: 2499},
-																							exprs: []interface{}{
-																								&choiceExpr{
-																									pos: position{line: 274, col: 5, offset: 7396},
-																									alternatives: []interface{}{
-																										&litMatcher{
-																											pos:        position{line: 256, col: 6, offset: 7094},
-																											val:        "if",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 257, col: 8, offset: 7108},
-																											val:        "then",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 258, col: 8, offset: 7124},
-																											val:        "else",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 259, col: 7, offset: 7139},
-																											val:        "let",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 260, col: 6, offset: 7152},
-																											val:        "in",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 262, col: 9, offset: 7179},
-																											val:        "using",
-																											ignoreCase: false,
-																										},
-																										&actionExpr{
-																											pos: position{line: 264, col: 11, offset: 7217},
-																											run: (*parser).callonExpression419,
-																											expr: &litMatcher{
-																												pos:        position{line: 264, col: 11, offset: 7217},
-																												val:        "missing",
-																												ignoreCase: false,
-																											},
-																										},
-																										&litMatcher{
-																											pos:        position{line: 261, col: 6, offset: 7164},
-																											val:        "as",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 265, col: 8, offset: 7262},
-																											val:        "True",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 266, col: 9, offset: 7279},
-																											val:        "False",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 267, col: 12, offset: 7300},
-																											val:        "Infinity",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 268, col: 7, offset: 7319},
-																											val:        "NaN",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 263, col: 9, offset: 7197},
-																											val:        "merge",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 269, col: 8, offset: 7334},
-																											val:        "Some",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 270, col: 9, offset: 7351},
-																											val:        "toMap",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 271, col: 10, offset: 7370},
-																											val:        "assert",
-																											ignoreCase: false,
-																										},
-																									},
-																								},
-																								&oneOrMoreExpr{
-																									pos: position{line: 112, col: 23, offset: 2507},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 113, col: 13, offset: 2571},
-																						run: (*parser).callonExpression432,
-																						expr: &seqExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							exprs: []interface{}{
-																								&notExpr{
-																									pos: position{line: 113, col: 13, offset: 2571},
-																									expr: &choiceExpr{
-																										pos: position{line: 274, col: 5, offset: 7396},
-																										alternatives: []interface{}{
-																											&litMatcher{
-																												pos:        position{line: 256, col: 6, offset: 7094},
-																												val:        "if",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 257, col: 8, offset: 7108},
-																												val:        "then",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 258, col: 8, offset: 7124},
-																												val:        "else",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 259, col: 7, offset: 7139},
-																												val:        "let",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 260, col: 6, offset: 7152},
-																												val:        "in",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 262, col: 9, offset: 7179},
-																												val:        "using",
-																												ignoreCase: false,
-																											},
-																											&actionExpr{
-																												pos: position{line: 264, col: 11, offset: 7217},
-																												run: (*parser).callonExpression442,
-																												expr: &litMatcher{
-																													pos:        position{line: 264, col: 11, offset: 7217},
-																													val:        "missing",
-																													ignoreCase: false,
-																												},
-																											},
-																											&litMatcher{
-																												pos:        position{line: 261, col: 6, offset: 7164},
-																												val:        "as",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 265, col: 8, offset: 7262},
-																												val:        "True",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 266, col: 9, offset: 7279},
-																												val:        "False",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 267, col: 12, offset: 7300},
-																												val:        "Infinity",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 268, col: 7, offset: 7319},
-																												val:        "NaN",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 263, col: 9, offset: 7197},
-																												val:        "merge",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 269, col: 8, offset: 7334},
-																												val:        "Some",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 270, col: 9, offset: 7351},
-																												val:        "toMap",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 271, col: 10, offset: 7370},
-																												val:        "assert",
-																												ignoreCase: false,
-																											},
-																										},
-																									},
-																								},
-																								&charClassMatcher{
-																									pos:        position{line: 110, col: 24, offset: 2434},
-																									val:        "[_A-Za-z]",
-																									chars:      []rune{'_'},
-																									ranges:     []rune{'A', 'Z', 'a', 'z'},
-																									ignoreCase: false,
-																									inverted:   false,
-																								},
-																								&zeroOrMoreExpr{
-																									pos: position{line: 113, col: 43, offset: 2601},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-											&actionExpr{
-												pos: position{line: 125, col: 19, offset: 3004},
-												run: (*parser).callonExpression456,
-												expr: &seqExpr{
-													pos: position{line: 125, col: 19, offset: 3004},
-													exprs: []interface{}{
-														&notExpr{
-															pos: position{line: 125, col: 19, offset: 3004},
-															expr: &choiceExpr{
-																pos: position{line: 221, col: 5, offset: 5657},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		run: (*parser).callonExpression460,
-																		expr: &litMatcher{
-																			pos:        position{line: 221, col: 5, offset: 5657},
-																			val:        "Natural/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 222, col: 5, offset: 5706},
-																		run: (*parser).callonExpression462,
-																		expr: &litMatcher{
-																			pos:        position{line: 222, col: 5, offset: 5706},
-																			val:        "Natural/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 223, col: 5, offset: 5753},
-																		run: (*parser).callonExpression464,
-																		expr: &litMatcher{
-																			pos:        position{line: 223, col: 5, offset: 5753},
-																			val:        "Natural/isZero",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 224, col: 5, offset: 5804},
-																		run: (*parser).callonExpression466,
-																		expr: &litMatcher{
-																			pos:        position{line: 224, col: 5, offset: 5804},
-																			val:        "Natural/even",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 225, col: 5, offset: 5851},
-																		run: (*parser).callonExpression468,
-																		expr: &litMatcher{
-																			pos:        position{line: 225, col: 5, offset: 5851},
-																			val:        "Natural/odd",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 226, col: 5, offset: 5896},
-																		run: (*parser).callonExpression470,
-																		expr: &litMatcher{
-																			pos:        position{line: 226, col: 5, offset: 5896},
-																			val:        "Natural/toInteger",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 227, col: 5, offset: 5953},
-																		run: (*parser).callonExpression472,
-																		expr: &litMatcher{
-																			pos:        position{line: 227, col: 5, offset: 5953},
-																			val:        "Natural/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 6000},
-																		run: (*parser).callonExpression474,
-																		expr: &litMatcher{
-																			pos:        position{line: 228, col: 5, offset: 6000},
-																			val:        "Natural/subtract",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 229, col: 5, offset: 6055},
-																		run: (*parser).callonExpression476,
-																		expr: &litMatcher{
-																			pos:        position{line: 229, col: 5, offset: 6055},
-																			val:        "Integer/toDouble",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 230, col: 5, offset: 6110},
-																		run: (*parser).callonExpression478,
-																		expr: &litMatcher{
-																			pos:        position{line: 230, col: 5, offset: 6110},
-																			val:        "Integer/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 231, col: 5, offset: 6157},
-																		run: (*parser).callonExpression480,
-																		expr: &litMatcher{
-																			pos:        position{line: 231, col: 5, offset: 6157},
-																			val:        "Double/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 232, col: 5, offset: 6202},
-																		run: (*parser).callonExpression482,
-																		expr: &litMatcher{
-																			pos:        position{line: 232, col: 5, offset: 6202},
-																			val:        "List/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 233, col: 5, offset: 6245},
-																		run: (*parser).callonExpression484,
-																		expr: &litMatcher{
-																			pos:        position{line: 233, col: 5, offset: 6245},
-																			val:        "List/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 234, col: 5, offset: 6286},
-																		run: (*parser).callonExpression486,
-																		expr: &litMatcher{
-																			pos:        position{line: 234, col: 5, offset: 6286},
-																			val:        "List/length",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 235, col: 5, offset: 6331},
-																		run: (*parser).callonExpression488,
-																		expr: &litMatcher{
-																			pos:        position{line: 235, col: 5, offset: 6331},
-																			val:        "List/head",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 236, col: 5, offset: 6372},
-																		run: (*parser).callonExpression490,
-																		expr: &litMatcher{
-																			pos:        position{line: 236, col: 5, offset: 6372},
-																			val:        "List/last",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 237, col: 5, offset: 6413},
-																		run: (*parser).callonExpression492,
-																		expr: &litMatcher{
-																			pos:        position{line: 237, col: 5, offset: 6413},
-																			val:        "List/indexed",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 238, col: 5, offset: 6460},
-																		run: (*parser).callonExpression494,
-																		expr: &litMatcher{
-																			pos:        position{line: 238, col: 5, offset: 6460},
-																			val:        "List/reverse",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 239, col: 5, offset: 6507},
-																		run: (*parser).callonExpression496,
-																		expr: &litMatcher{
-																			pos:        position{line: 239, col: 5, offset: 6507},
-																			val:        "Optional/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 240, col: 5, offset: 6558},
-																		run: (*parser).callonExpression498,
-																		expr: &litMatcher{
-																			pos:        position{line: 240, col: 5, offset: 6558},
-																			val:        "Optional/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 241, col: 5, offset: 6607},
-																		run: (*parser).callonExpression500,
-																		expr: &litMatcher{
-																			pos:        position{line: 241, col: 5, offset: 6607},
-																			val:        "Text/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 242, col: 5, offset: 6648},
-																		run: (*parser).callonExpression502,
-																		expr: &litMatcher{
-																			pos:        position{line: 242, col: 5, offset: 6648},
-																			val:        "Bool",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 243, col: 5, offset: 6680},
-																		run: (*parser).callonExpression504,
-																		expr: &litMatcher{
-																			pos:        position{line: 243, col: 5, offset: 6680},
-																			val:        "True",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 244, col: 5, offset: 6712},
-																		run: (*parser).callonExpression506,
-																		expr: &litMatcher{
-																			pos:        position{line: 244, col: 5, offset: 6712},
-																			val:        "False",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 245, col: 5, offset: 6746},
-																		run: (*parser).callonExpression508,
-																		expr: &litMatcher{
-																			pos:        position{line: 245, col: 5, offset: 6746},
-																			val:        "Optional",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 246, col: 5, offset: 6786},
-																		run: (*parser).callonExpression510,
-																		expr: &litMatcher{
-																			pos:        position{line: 246, col: 5, offset: 6786},
-																			val:        "Natural",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 247, col: 5, offset: 6824},
-																		run: (*parser).callonExpression512,
-																		expr: &litMatcher{
-																			pos:        position{line: 247, col: 5, offset: 6824},
-																			val:        "Integer",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 248, col: 5, offset: 6862},
-																		run: (*parser).callonExpression514,
-																		expr: &litMatcher{
-																			pos:        position{line: 248, col: 5, offset: 6862},
-																			val:        "Double",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 249, col: 5, offset: 6898},
-																		run: (*parser).callonExpression516,
-																		expr: &litMatcher{
-																			pos:        position{line: 249, col: 5, offset: 6898},
-																			val:        "Text",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 250, col: 5, offset: 6930},
-																		run: (*parser).callonExpression518,
-																		expr: &litMatcher{
-																			pos:        position{line: 250, col: 5, offset: 6930},
-																			val:        "List",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 251, col: 5, offset: 6962},
-																		run: (*parser).callonExpression520,
-																		expr: &litMatcher{
-																			pos:        position{line: 251, col: 5, offset: 6962},
-																			val:        "None",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 252, col: 5, offset: 6994},
-																		run: (*parser).callonExpression522,
-																		expr: &litMatcher{
-																			pos:        position{line: 252, col: 5, offset: 6994},
-																			val:        "Type",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 253, col: 5, offset: 7026},
-																		run: (*parser).callonExpression524,
-																		expr: &litMatcher{
-																			pos:        position{line: 253, col: 5, offset: 7026},
-																			val:        "Kind",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 254, col: 5, offset: 7058},
-																		run: (*parser).callonExpression526,
-																		expr: &litMatcher{
-																			pos:        position{line: 254, col: 5, offset: 7058},
-																			val:        "Sort",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-														},
-														&labeledExpr{
-															pos:   position{line: 125, col: 29, offset: 3014},
-															label: "label",
-															expr: &choiceExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 121, col: 9, offset: 2802},
-																		run: (*parser).callonExpression530,
-																		expr: &seqExpr{
-																			pos: position{line: 121, col: 9, offset: 2802},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 121, col: 9, offset: 2802},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																				&labeledExpr{
-																					pos:   position{line: 121, col: 13, offset: 2806},
-																					label: "label",
-																					expr: &actionExpr{
-																						pos: position{line: 119, col: 15, offset: 2743},
-																						run: (*parser).callonExpression534,
-																						expr: &oneOrMoreExpr{
-																							pos: position{line: 119, col: 15, offset: 2743},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 118, col: 19, offset: 2706},
-																								val:        "[ -_a-~]",
-																								ranges:     []rune{' ', '_', 'a', '~'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																				&litMatcher{
-																					pos:        position{line: 121, col: 31, offset: 2824},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 122, col: 9, offset: 2858},
-																		run: (*parser).callonExpression538,
-																		expr: &labeledExpr{
-																			pos:   position{line: 122, col: 9, offset: 2858},
-																			label: "label",
-																			expr: &choiceExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				alternatives: []interface{}{
-																					&actionExpr{
-																						pos: position{line: 112, col: 15, offset: 2499},
-																						run: (*parser).callonExpression541,
-																						expr: &seqExpr{
-																							pos: position{line: 112, col: 15, offset: 2499},
-																							exprs: []interface{}{
-																								&choiceExpr{
-																									pos: position{line: 274, col: 5, offset: 7396},
-																									alternatives: []interface{}{
-																										&litMatcher{
-																											pos:        position{line: 256, col: 6, offset: 7094},
-																											val:        "if",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 257, col: 8, offset: 7108},
-																											val:        "then",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 258, col: 8, offset: 7124},
-																											val:        "else",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 259, col: 7, offset: 7139},
-																											val:        "let",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 260, col: 6, offset: 7152},
-																											val:        "in",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 262, col: 9, offset: 7179},
-																											val:        "using",
-																											ignoreCase: false,
-																										},
-																										&actionExpr{
-																											pos: position{line: 264, col: 11, offset: 7217},
-																											run: (*parser).callonExpression550,
-																											expr: &litMatcher{
-																												pos:        position{line: 264, col: 11, offset: 7217},
-																												val:        "missing",
-																												ignoreCase: false,
-																											},
-																										},
-																										&litMatcher{
-																											pos:        position{line: 261, col: 6, offset: 7164},
-																											val:        "as",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 265, col: 8, offset: 7262},
-																											val:        "True",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 266, col: 9, offset: 7279},
-																											val:        "False",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 267, col: 12, offset: 7300},
-																											val:        "Infinity",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 268, col: 7, offset: 7319},
-																											val:        "NaN",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 263, col: 9, offset: 7197},
-																											val:        "merge",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 269, col: 8, offset: 7334},
-																											val:        "Some",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 270, col: 9, offset: 7351},
-																											val:        "toMap",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 271, col: 10, offset: 7370},
-																											val:        "assert",
-																											ignoreCase: false,
-																										},
-																									},
-																								},
-																								&oneOrMoreExpr{
-																									pos: position{line: 112, col: 23, offset: 2507},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 113, col: 13, offset: 2571},
-																						run: (*parser).callonExpression563,
-																						expr: &seqExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							exprs: []interface{}{
-																								&notExpr{
-																									pos: position{line: 113, col: 13, offset: 2571},
-																									expr: &choiceExpr{
-																										pos: position{line: 274, col: 5, offset: 7396},
-																										alternatives: []interface{}{
-																											&litMatcher{
-																												pos:        position{line: 256, col: 6, offset: 7094},
-																												val:        "if",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 257, col: 8, offset: 7108},
-																												val:        "then",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 258, col: 8, offset: 7124},
-																												val:        "else",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 259, col: 7, offset: 7139},
-																												val:        "let",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 260, col: 6, offset: 7152},
-																												val:        "in",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 262, col: 9, offset: 7179},
-																												val:        "using",
-																												ignoreCase: false,
-																											},
-																											&actionExpr{
-																												pos: position{line: 264, col: 11, offset: 7217},
-																												run: (*parser).callonExpression573,
-																												expr: &litMatcher{
-																													pos:        position{line: 264, col: 11, offset: 7217},
-																													val:        "missing",
-																													ignoreCase: false,
-																												},
-																											},
-																											&litMatcher{
-																												pos:        position{line: 261, col: 6, offset: 7164},
-																												val:        "as",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 265, col: 8, offset: 7262},
-																												val:        "True",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 266, col: 9, offset: 7279},
-																												val:        "False",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 267, col: 12, offset: 7300},
-																												val:        "Infinity",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 268, col: 7, offset: 7319},
-																												val:        "NaN",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 263, col: 9, offset: 7197},
-																												val:        "merge",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 269, col: 8, offset: 7334},
-																												val:        "Some",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 270, col: 9, offset: 7351},
-																												val:        "toMap",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 271, col: 10, offset: 7370},
-																												val:        "assert",
-																												ignoreCase: false,
-																											},
-																										},
-																									},
-																								},
-																								&charClassMatcher{
-																									pos:        position{line: 110, col: 24, offset: 2434},
-																									val:        "[_A-Za-z]",
-																									chars:      []rune{'_'},
-																									ranges:     []rune{'A', 'Z', 'a', 'z'},
-																									ignoreCase: false,
-																									inverted:   false,
-																								},
-																								&zeroOrMoreExpr{
-																									pos: position{line: 113, col: 43, offset: 2601},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 45, offset: 14598},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 514, col: 47, offset: 14600},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 51, offset: 14604},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 514, col: 54, offset: 14607},
-									label: "t",
-									expr: &ruleRefExpr{
-										pos:  position{line: 514, col: 56, offset: 14609},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 67, offset: 14620},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 514, col: 69, offset: 14622},
-									val:        ")",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 73, offset: 14626},
-									name: "_",
-								},
-								&choiceExpr{
-									pos: position{line: 293, col: 9, offset: 7779},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 293, col: 9, offset: 7779},
-											val:        "->",
-											ignoreCase: false,
-										},
-										&litMatcher{
-											pos:        position{line: 293, col: 16, offset: 7786},
-											val:        "→",
-											ignoreCase: false,
-										},
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 81, offset: 14634},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 514, col: 83, offset: 14636},
-									label: "body",
-									expr: &ruleRefExpr{
-										pos:  position{line: 514, col: 88, offset: 14641},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 517, col: 7, offset: 14753},
-						run: (*parser).callonExpression601,
-						expr: &seqExpr{
-							pos: position{line: 517, col: 7, offset: 14753},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 517, col: 7, offset: 14753},
-									label: "o",
-									expr: &ruleRefExpr{
-										pos:  position{line: 517, col: 9, offset: 14755},
-										name: "OperatorExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 517, col: 28, offset: 14774},
-									name: "_",
-								},
-								&choiceExpr{
-									pos: position{line: 293, col: 9, offset: 7779},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 293, col: 9, offset: 7779},
-											val:        "->",
-											ignoreCase: false,
-										},
-										&litMatcher{
-											pos:        position{line: 293, col: 16, offset: 7786},
-											val:        "→",
-											ignoreCase: false,
-										},
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 517, col: 36, offset: 14782},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 517, col: 38, offset: 14784},
-									label: "e",
-									expr: &ruleRefExpr{
-										pos:  position{line: 517, col: 40, offset: 14786},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 518, col: 7, offset: 14848},
-						run: (*parser).callonExpression612,
-						expr: &seqExpr{
-							pos: position{line: 518, col: 7, offset: 14848},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 263, col: 9, offset: 7197},
-									val:        "merge",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 518, col: 13, offset: 14854},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 518, col: 16, offset: 14857},
-									label: "h",
-									expr: &ruleRefExpr{
-										pos:  position{line: 518, col: 18, offset: 14859},
-										name: "ImportExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 518, col: 35, offset: 14876},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 518, col: 38, offset: 14879},
-									label: "u",
-									expr: &ruleRefExpr{
-										pos:  position{line: 518, col: 40, offset: 14881},
-										name: "ImportExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 518, col: 57, offset: 14898},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 518, col: 59, offset: 14900},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 518, col: 63, offset: 14904},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 518, col: 66, offset: 14907},
-									label: "a",
-									expr: &ruleRefExpr{
-										pos:  position{line: 518, col: 68, offset: 14909},
-										name: "ApplicationExpression",
-									},
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 521, col: 7, offset: 15030},
-						name: "EmptyList",
-					},
-					&actionExpr{
-						pos: position{line: 522, col: 7, offset: 15046},
-						run: (*parser).callonExpression627,
-						expr: &seqExpr{
-							pos: position{line: 522, col: 7, offset: 15046},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 270, col: 9, offset: 7351},
-									val:        "toMap",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 522, col: 13, offset: 15052},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 522, col: 16, offset: 15055},
-									label: "e",
-									expr: &ruleRefExpr{
-										pos:  position{line: 522, col: 18, offset: 15057},
-										name: "ImportExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 522, col: 35, offset: 15074},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 522, col: 37, offset: 15076},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 522, col: 41, offset: 15080},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 522, col: 44, offset: 15083},
-									label: "t",
-									expr: &ruleRefExpr{
-										pos:  position{line: 522, col: 46, offset: 15085},
-										name: "ApplicationExpression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 523, col: 7, offset: 15155},
-						run: (*parser).callonExpression638,
-						expr: &seqExpr{
-							pos: position{line: 523, col: 7, offset: 15155},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 271, col: 10, offset: 7370},
-									val:        "assert",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 523, col: 14, offset: 15162},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 523, col: 16, offset: 15164},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 523, col: 20, offset: 15168},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 523, col: 23, offset: 15171},
-									label: "a",
-									expr: &ruleRefExpr{
-										pos:  position{line: 523, col: 25, offset: 15173},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 524, col: 7, offset: 15235},
-						name: "AnnotatedExpression",
-					},
-				},
-			},
-		},
-		{
-			name: "Annotation",
-			pos:  position{line: 526, col: 1, offset: 15256},
-			expr: &actionExpr{
-				pos: position{line: 526, col: 14, offset: 15271},
-				run: (*parser).callonAnnotation1,
-				expr: &seqExpr{
-					pos: position{line: 526, col: 14, offset: 15271},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 526, col: 14, offset: 15271},
-							val:        ":",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 526, col: 18, offset: 15275},
-							name: "_1",
-						},
-						&labeledExpr{
-							pos:   position{line: 526, col: 21, offset: 15278},
-							label: "a",
-							expr: &ruleRefExpr{
-								pos:  position{line: 526, col: 23, offset: 15280},
-								name: "Expression",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "AnnotatedExpression",
-			pos:  position{line: 528, col: 1, offset: 15310},
-			expr: &actionExpr{
-				pos: position{line: 529, col: 1, offset: 15334},
-				run: (*parser).callonAnnotatedExpression1,
-				expr: &seqExpr{
-					pos: position{line: 529, col: 1, offset: 15334},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 529, col: 1, offset: 15334},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 529, col: 3, offset: 15336},
-								name: "OperatorExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 529, col: 22, offset: 15355},
-							label: "a",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 529, col: 24, offset: 15357},
-								expr: &seqExpr{
-									pos: position{line: 529, col: 25, offset: 15358},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 529, col: 25, offset: 15358},
-											name: "_",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 529, col: 27, offset: 15360},
-											name: "Annotation",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "EmptyList",
-			pos:  position{line: 534, col: 1, offset: 15485},
-			expr: &actionExpr{
-				pos: position{line: 534, col: 13, offset: 15499},
-				run: (*parser).callonEmptyList1,
-				expr: &seqExpr{
-					pos: position{line: 534, col: 13, offset: 15499},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 534, col: 13, offset: 15499},
-							val:        "[",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 534, col: 17, offset: 15503},
-							name: "_",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 534, col: 19, offset: 15505},
-							expr: &seqExpr{
-								pos: position{line: 534, col: 20, offset: 15506},
-								exprs: []interface{}{
-									&litMatcher{
-										pos:        position{line: 534, col: 20, offset: 15506},
-										val:        ",",
-										ignoreCase: false,
-									},
-									&ruleRefExpr{
-										pos:  position{line: 534, col: 24, offset: 15510},
-										name: "_",
-									},
-								},
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 534, col: 28, offset: 15514},
-							val:        "]",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 534, col: 32, offset: 15518},
-							name: "_",
-						},
-						&litMatcher{
-							pos:        position{line: 534, col: 34, offset: 15520},
-							val:        ":",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 534, col: 38, offset: 15524},
-							name: "_1",
-						},
-						&labeledExpr{
-							pos:   position{line: 534, col: 41, offset: 15527},
-							label: "a",
-							expr: &ruleRefExpr{
-								pos:  position{line: 534, col: 43, offset: 15529},
-								name: "ApplicationExpression",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "OperatorExpression",
-			pos:  position{line: 538, col: 1, offset: 15597},
-			expr: &ruleRefExpr{
-				pos:  position{line: 538, col: 22, offset: 15620},
-				name: "ImportAltExpression",
-			},
-		},
-		{
-			name: "ImportAltExpression",
-			pos:  position{line: 540, col: 1, offset: 15641},
-			expr: &actionExpr{
-				pos: position{line: 540, col: 26, offset: 15668},
-				run: (*parser).callonImportAltExpression1,
-				expr: &seqExpr{
-					pos: position{line: 540, col: 26, offset: 15668},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 540, col: 26, offset: 15668},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 540, col: 32, offset: 15674},
-								name: "OrExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 540, col: 55, offset: 15697},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 540, col: 60, offset: 15702},
-								expr: &seqExpr{
-									pos: position{line: 540, col: 61, offset: 15703},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 540, col: 61, offset: 15703},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 540, col: 63, offset: 15705},
-											val:        "?",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 540, col: 67, offset: 15709},
-											name: "_1",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 540, col: 70, offset: 15712},
-											name: "OrExpression",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "OrExpression",
-			pos:  position{line: 542, col: 1, offset: 15783},
-			expr: &actionExpr{
-				pos: position{line: 542, col: 26, offset: 15810},
-				run: (*parser).callonOrExpression1,
-				expr: &seqExpr{
-					pos: position{line: 542, col: 26, offset: 15810},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 542, col: 26, offset: 15810},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 542, col: 32, offset: 15816},
-								name: "PlusExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 542, col: 55, offset: 15839},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 542, col: 60, offset: 15844},
-								expr: &seqExpr{
-									pos: position{line: 542, col: 61, offset: 15845},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 542, col: 61, offset: 15845},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 542, col: 63, offset: 15847},
-											val:        "||",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 542, col: 68, offset: 15852},
-											name: "_",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 542, col: 70, offset: 15854},
-											name: "PlusExpression",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "PlusExpression",
-			pos:  position{line: 544, col: 1, offset: 15920},
-			expr: &actionExpr{
-				pos: position{line: 544, col: 26, offset: 15947},
-				run: (*parser).callonPlusExpression1,
-				expr: &seqExpr{
-					pos: position{line: 544, col: 26, offset: 15947},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 544, col: 26, offset: 15947},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 544, col: 32, offset: 15953},
-								name: "TextAppendExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 544, col: 55, offset: 15976},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 544, col: 60, offset: 15981},
-								expr: &seqExpr{
-									pos: position{line: 544, col: 61, offset: 15982},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 544, col: 61, offset: 15982},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 544, col: 63, offset: 15984},
-											val:        "+",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 544, col: 67, offset: 15988},
-											name: "_1",
-										},
-										&labeledExpr{
-											pos:   position{line: 544, col: 70, offset: 15991},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 544, col: 72, offset: 15993},
-												name: "TextAppendExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "TextAppendExpression",
-			pos:  position{line: 546, col: 1, offset: 16067},
-			expr: &actionExpr{
-				pos: position{line: 546, col: 26, offset: 16094},
-				run: (*parser).callonTextAppendExpression1,
-				expr: &seqExpr{
-					pos: position{line: 546, col: 26, offset: 16094},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 546, col: 26, offset: 16094},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 546, col: 32, offset: 16100},
-								name: "ListAppendExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 546, col: 55, offset: 16123},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 546, col: 60, offset: 16128},
-								expr: &seqExpr{
-									pos: position{line: 546, col: 61, offset: 16129},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 546, col: 61, offset: 16129},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 546, col: 63, offset: 16131},
-											val:        "++",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 546, col: 68, offset: 16136},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 546, col: 70, offset: 16138},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 546, col: 72, offset: 16140},
-												name: "ListAppendExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ListAppendExpression",
-			pos:  position{line: 548, col: 1, offset: 16220},
-			expr: &actionExpr{
-				pos: position{line: 548, col: 26, offset: 16247},
-				run: (*parser).callonListAppendExpression1,
-				expr: &seqExpr{
-					pos: position{line: 548, col: 26, offset: 16247},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 548, col: 26, offset: 16247},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 548, col: 32, offset: 16253},
-								name: "AndExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 548, col: 55, offset: 16276},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 548, col: 60, offset: 16281},
-								expr: &seqExpr{
-									pos: position{line: 548, col: 61, offset: 16282},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 548, col: 61, offset: 16282},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 548, col: 63, offset: 16284},
-											val:        "#",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 548, col: 67, offset: 16288},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 548, col: 69, offset: 16290},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 548, col: 71, offset: 16292},
-												name: "AndExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "AndExpression",
-			pos:  position{line: 550, col: 1, offset: 16365},
-			expr: &actionExpr{
-				pos: position{line: 550, col: 26, offset: 16392},
-				run: (*parser).callonAndExpression1,
-				expr: &seqExpr{
-					pos: position{line: 550, col: 26, offset: 16392},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 550, col: 26, offset: 16392},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 550, col: 32, offset: 16398},
-								name: "CombineExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 550, col: 55, offset: 16421},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 550, col: 60, offset: 16426},
-								expr: &seqExpr{
-									pos: position{line: 550, col: 61, offset: 16427},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 550, col: 61, offset: 16427},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 550, col: 63, offset: 16429},
-											val:        "&&",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 550, col: 68, offset: 16434},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 550, col: 70, offset: 16436},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 550, col: 72, offset: 16438},
-												name: "CombineExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "CombineExpression",
-			pos:  position{line: 552, col: 1, offset: 16508},
-			expr: &actionExpr{
-				pos: position{line: 552, col: 26, offset: 16535},
-				run: (*parser).callonCombineExpression1,
-				expr: &seqExpr{
-					pos: position{line: 552, col: 26, offset: 16535},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 552, col: 26, offset: 16535},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 552, col: 32, offset: 16541},
-								name: "PreferExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 552, col: 55, offset: 16564},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 552, col: 60, offset: 16569},
-								expr: &seqExpr{
-									pos: position{line: 552, col: 61, offset: 16570},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 552, col: 61, offset: 16570},
-											name: "_",
-										},
-										&choiceExpr{
-											pos: position{line: 287, col: 11, offset: 7617},
-											alternatives: []interface{}{
-												&litMatcher{
-													pos:        position{line: 287, col: 11, offset: 7617},
-													val:        "/\\",
-													ignoreCase: false,
-												},
-												&litMatcher{
-													pos:        position{line: 287, col: 19, offset: 7625},
-													val:        "∧",
-													ignoreCase: false,
-												},
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 552, col: 71, offset: 16580},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 552, col: 73, offset: 16582},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 552, col: 75, offset: 16584},
-												name: "PreferExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "PreferExpression",
-			pos:  position{line: 554, col: 1, offset: 16661},
-			expr: &actionExpr{
-				pos: position{line: 554, col: 26, offset: 16688},
-				run: (*parser).callonPreferExpression1,
-				expr: &seqExpr{
-					pos: position{line: 554, col: 26, offset: 16688},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 554, col: 26, offset: 16688},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 554, col: 32, offset: 16694},
-								name: "CombineTypesExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 554, col: 55, offset: 16717},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 554, col: 60, offset: 16722},
-								expr: &seqExpr{
-									pos: position{line: 554, col: 61, offset: 16723},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 554, col: 61, offset: 16723},
-											name: "_",
-										},
-										&choiceExpr{
-											pos: position{line: 290, col: 10, offset: 7705},
-											alternatives: []interface{}{
-												&litMatcher{
-													pos:        position{line: 290, col: 10, offset: 7705},
-													val:        "//",
-													ignoreCase: false,
-												},
-												&litMatcher{
-													pos:        position{line: 290, col: 17, offset: 7712},
-													val:        "⫽",
-													ignoreCase: false,
-												},
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 554, col: 70, offset: 16732},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 554, col: 72, offset: 16734},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 554, col: 74, offset: 16736},
-												name: "CombineTypesExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "CombineTypesExpression",
-			pos:  position{line: 556, col: 1, offset: 16830},
-			expr: &actionExpr{
-				pos: position{line: 556, col: 26, offset: 16857},
-				run: (*parser).callonCombineTypesExpression1,
-				expr: &seqExpr{
-					pos: position{line: 556, col: 26, offset: 16857},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 556, col: 26, offset: 16857},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 556, col: 32, offset: 16863},
-								name: "TimesExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 556, col: 55, offset: 16886},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 556, col: 60, offset: 16891},
-								expr: &seqExpr{
-									pos: position{line: 556, col: 61, offset: 16892},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 556, col: 61, offset: 16892},
-											name: "_",
-										},
-										&choiceExpr{
-											pos: position{line: 288, col: 16, offset: 7648},
-											alternatives: []interface{}{
-												&litMatcher{
-													pos:        position{line: 288, col: 16, offset: 7648},
-													val:        "//\\\\",
-													ignoreCase: false,
-												},
-												&litMatcher{
-													pos:        position{line: 288, col: 27, offset: 7659},
-													val:        "⩓",
-													ignoreCase: false,
-												},
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 556, col: 76, offset: 16907},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 556, col: 78, offset: 16909},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 556, col: 80, offset: 16911},
-												name: "TimesExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "TimesExpression",
-			pos:  position{line: 558, col: 1, offset: 16991},
-			expr: &actionExpr{
-				pos: position{line: 558, col: 26, offset: 17018},
-				run: (*parser).callonTimesExpression1,
-				expr: &seqExpr{
-					pos: position{line: 558, col: 26, offset: 17018},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 558, col: 26, offset: 17018},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 558, col: 32, offset: 17024},
-								name: "EqualExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 558, col: 55, offset: 17047},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 558, col: 60, offset: 17052},
-								expr: &seqExpr{
-									pos: position{line: 558, col: 61, offset: 17053},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 558, col: 61, offset: 17053},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 558, col: 63, offset: 17055},
-											val:        "*",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 558, col: 67, offset: 17059},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 558, col: 69, offset: 17061},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 558, col: 71, offset: 17063},
-												name: "EqualExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "EqualExpression",
-			pos:  position{line: 560, col: 1, offset: 17133},
-			expr: &actionExpr{
-				pos: position{line: 560, col: 26, offset: 17160},
-				run: (*parser).callonEqualExpression1,
-				expr: &seqExpr{
-					pos: position{line: 560, col: 26, offset: 17160},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 560, col: 26, offset: 17160},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 560, col: 32, offset: 17166},
-								name: "NotEqualExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 560, col: 55, offset: 17189},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 560, col: 60, offset: 17194},
-								expr: &seqExpr{
-									pos: position{line: 560, col: 61, offset: 17195},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 560, col: 61, offset: 17195},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 560, col: 63, offset: 17197},
-											val:        "==",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 560, col: 68, offset: 17202},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 560, col: 70, offset: 17204},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 560, col: 72, offset: 17206},
-												name: "NotEqualExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "NotEqualExpression",
-			pos:  position{line: 562, col: 1, offset: 17276},
-			expr: &actionExpr{
-				pos: position{line: 562, col: 26, offset: 17303},
-				run: (*parser).callonNotEqualExpression1,
-				expr: &seqExpr{
-					pos: position{line: 562, col: 26, offset: 17303},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 562, col: 26, offset: 17303},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 562, col: 32, offset: 17309},
-								name: "EquivalentExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 562, col: 54, offset: 17331},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 562, col: 59, offset: 17336},
-								expr: &seqExpr{
-									pos: position{line: 562, col: 60, offset: 17337},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 562, col: 60, offset: 17337},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 562, col: 62, offset: 17339},
-											val:        "!=",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 562, col: 67, offset: 17344},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 562, col: 69, offset: 17346},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 562, col: 71, offset: 17348},
-												name: "EquivalentExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "EquivalentExpression",
-			pos:  position{line: 564, col: 1, offset: 17420},
-			expr: &actionExpr{
-				pos: position{line: 564, col: 28, offset: 17449},
-				run: (*parser).callonEquivalentExpression1,
-				expr: &seqExpr{
-					pos: position{line: 564, col: 28, offset: 17449},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 564, col: 28, offset: 17449},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 564, col: 34, offset: 17455},
-								name: "ApplicationExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 564, col: 57, offset: 17478},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 564, col: 62, offset: 17483},
-								expr: &seqExpr{
-									pos: position{line: 564, col: 63, offset: 17484},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 564, col: 63, offset: 17484},
-											name: "_",
-										},
-										&choiceExpr{
-											pos: position{line: 289, col: 14, offset: 7680},
-											alternatives: []interface{}{
-												&litMatcher{
-													pos:        position{line: 289, col: 14, offset: 7680},
-													val:        "===",
-													ignoreCase: false,
-												},
-												&litMatcher{
-													pos:        position{line: 289, col: 22, offset: 7688},
-													val:        "≡",
-													ignoreCase: false,
-												},
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 564, col: 76, offset: 17497},
-											name: "_",
-										},
-										&labeledExpr{
-											pos:   position{line: 564, col: 78, offset: 17499},
-											label: "e",
-											expr: &ruleRefExpr{
-												pos:  position{line: 564, col: 80, offset: 17501},
-												name: "ApplicationExpression",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ApplicationExpression",
-			pos:  position{line: 567, col: 1, offset: 17578},
-			expr: &actionExpr{
-				pos: position{line: 567, col: 25, offset: 17604},
-				run: (*parser).callonApplicationExpression1,
-				expr: &seqExpr{
-					pos: position{line: 567, col: 25, offset: 17604},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 567, col: 25, offset: 17604},
-							label: "f",
-							expr: &ruleRefExpr{
-								pos:  position{line: 567, col: 27, offset: 17606},
-								name: "FirstApplicationExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 567, col: 54, offset: 17633},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 567, col: 59, offset: 17638},
-								expr: &seqExpr{
-									pos: position{line: 567, col: 60, offset: 17639},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 567, col: 60, offset: 17639},
-											name: "_1",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 567, col: 63, offset: 17642},
-											name: "ImportExpression",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "FirstApplicationExpression",
-			pos:  position{line: 576, col: 1, offset: 17885},
-			expr: &choiceExpr{
-				pos: position{line: 577, col: 8, offset: 17923},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 577, col: 8, offset: 17923},
-						run: (*parser).callonFirstApplicationExpression2,
-						expr: &seqExpr{
-							pos: position{line: 577, col: 8, offset: 17923},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 263, col: 9, offset: 7197},
-									val:        "merge",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 577, col: 14, offset: 17929},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 577, col: 17, offset: 17932},
-									label: "h",
-									expr: &ruleRefExpr{
-										pos:  position{line: 577, col: 19, offset: 17934},
-										name: "ImportExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 577, col: 36, offset: 17951},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 577, col: 39, offset: 17954},
-									label: "u",
-									expr: &ruleRefExpr{
-										pos:  position{line: 577, col: 41, offset: 17956},
-										name: "ImportExpression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 580, col: 8, offset: 18059},
-						run: (*parser).callonFirstApplicationExpression11,
-						expr: &seqExpr{
-							pos: position{line: 580, col: 8, offset: 18059},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 269, col: 8, offset: 7334},
-									val:        "Some",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 580, col: 13, offset: 18064},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 580, col: 16, offset: 18067},
-									label: "e",
-									expr: &ruleRefExpr{
-										pos:  position{line: 580, col: 18, offset: 18069},
-										name: "ImportExpression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 581, col: 8, offset: 18124},
-						run: (*parser).callonFirstApplicationExpression17,
-						expr: &seqExpr{
-							pos: position{line: 581, col: 8, offset: 18124},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 270, col: 9, offset: 7351},
-									val:        "toMap",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 581, col: 14, offset: 18130},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 581, col: 17, offset: 18133},
-									label: "e",
-									expr: &ruleRefExpr{
-										pos:  position{line: 581, col: 19, offset: 18135},
-										name: "ImportExpression",
-									},
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 582, col: 8, offset: 18199},
-						name: "ImportExpression",
-					},
-				},
-			},
-		},
-		{
-			name: "ImportExpression",
-			pos:  position{line: 584, col: 1, offset: 18217},
-			expr: &choiceExpr{
-				pos: position{line: 584, col: 20, offset: 18238},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 584, col: 20, offset: 18238},
-						name: "Import",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 584, col: 29, offset: 18247},
-						name: "CompletionExpression",
-					},
-				},
-			},
-		},
-		{
-			name: "CompletionExpression",
-			pos:  position{line: 586, col: 1, offset: 18269},
-			expr: &actionExpr{
-				pos: position{line: 586, col: 24, offset: 18294},
-				run: (*parser).callonCompletionExpression1,
-				expr: &seqExpr{
-					pos: position{line: 586, col: 24, offset: 18294},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 586, col: 24, offset: 18294},
-							label: "a",
-							expr: &ruleRefExpr{
-								pos:  position{line: 586, col: 26, offset: 18296},
-								name: "SelectorExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 586, col: 45, offset: 18315},
-							label: "b",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 586, col: 47, offset: 18317},
-								expr: &seqExpr{
-									pos: position{line: 586, col: 48, offset: 18318},
-									exprs: []interface{}{
-										&litMatcher{
-											pos:        position{line: 294, col: 12, offset: 7805},
-											val:        "::",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 586, col: 57, offset: 18327},
-											name: "SelectorExpression",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "SelectorExpression",
-			pos:  position{line: 593, col: 1, offset: 18482},
-			expr: &actionExpr{
-				pos: position{line: 593, col: 22, offset: 18505},
-				run: (*parser).callonSelectorExpression1,
-				expr: &seqExpr{
-					pos: position{line: 593, col: 22, offset: 18505},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 593, col: 22, offset: 18505},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 593, col: 24, offset: 18507},
-								name: "PrimitiveExpression",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 593, col: 44, offset: 18527},
-							label: "ls",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 593, col: 47, offset: 18530},
-								expr: &seqExpr{
-									pos: position{line: 593, col: 48, offset: 18531},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 593, col: 48, offset: 18531},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 593, col: 50, offset: 18533},
-											val:        ".",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 593, col: 54, offset: 18537},
-											name: "_",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 593, col: 56, offset: 18539},
-											name: "Selector",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Selector",
-			pos:  position{line: 612, col: 1, offset: 19092},
-			expr: &choiceExpr{
-				pos: position{line: 612, col: 12, offset: 19105},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 121, col: 9, offset: 2802},
-						run: (*parser).callonSelector2,
-						expr: &seqExpr{
-							pos: position{line: 121, col: 9, offset: 2802},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 121, col: 9, offset: 2802},
-									val:        "`",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 121, col: 13, offset: 2806},
-									label: "label",
-									expr: &actionExpr{
-										pos: position{line: 119, col: 15, offset: 2743},
-										run: (*parser).callonSelector6,
-										expr: &oneOrMoreExpr{
-											pos: position{line: 119, col: 15, offset: 2743},
-											expr: &charClassMatcher{
-												pos:        position{line: 118, col: 19, offset: 2706},
-												val:        "[ -_a-~]",
-												ranges:     []rune{' ', '_', 'a', '~'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 121, col: 31, offset: 2824},
-									val:        "`",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 122, col: 9, offset: 2858},
-						run: (*parser).callonSelector10,
-						expr: &labeledExpr{
-							pos:   position{line: 122, col: 9, offset: 2858},
-							label: "label",
-							expr: &choiceExpr{
-								pos: position{line: 112, col: 15, offset: 2499},
-								alternatives: []interface{}{
-									&actionExpr{
-										pos: position{line: 112, col: 15, offset: 2499},
-										run: (*parser).callonSelector13,
-										expr: &seqExpr{
-											pos: position{line: 112, col: 15, offset: 2499},
-											exprs: []interface{}{
-												&choiceExpr{
-													pos: position{line: 274, col: 5, offset: 7396},
-													alternatives: []interface{}{
-														&litMatcher{
-															pos:        position{line: 256, col: 6, offset: 7094},
-															val:        "if",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 257, col: 8, offset: 7108},
-															val:        "then",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 258, col: 8, offset: 7124},
-															val:        "else",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 259, col: 7, offset: 7139},
-															val:        "let",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 260, col: 6, offset: 7152},
-															val:        "in",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 262, col: 9, offset: 7179},
-															val:        "using",
-															ignoreCase: false,
-														},
-														&actionExpr{
-															pos: position{line: 264, col: 11, offset: 7217},
-															run: (*parser).callonSelector22,
-															expr: &litMatcher{
-																pos:        position{line: 264, col: 11, offset: 7217},
-																val:        "missing",
-																ignoreCase: false,
-															},
-														},
-														&litMatcher{
-															pos:        position{line: 261, col: 6, offset: 7164},
-															val:        "as",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 265, col: 8, offset: 7262},
-															val:        "True",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 266, col: 9, offset: 7279},
-															val:        "False",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 267, col: 12, offset: 7300},
-															val:        "Infinity",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 268, col: 7, offset: 7319},
-															val:        "NaN",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 263, col: 9, offset: 7197},
-															val:        "merge",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 269, col: 8, offset: 7334},
-															val:        "Some",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 270, col: 9, offset: 7351},
-															val:        "toMap",
-															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 271, col: 10, offset: 7370},
-															val:        "assert",
-															ignoreCase: false,
-														},
-													},
-												},
-												&oneOrMoreExpr{
-													pos: position{line: 112, col: 23, offset: 2507},
-													expr: &charClassMatcher{
-														pos:        position{line: 111, col: 23, offset: 2468},
-														val:        "[_/-A-Za-z0-9]",
-														chars:      []rune{'_', '/', '-'},
-														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-														ignoreCase: false,
-														inverted:   false,
-													},
-												},
-											},
-										},
-									},
-									&actionExpr{
-										pos: position{line: 113, col: 13, offset: 2571},
-										run: (*parser).callonSelector35,
-										expr: &seqExpr{
-											pos: position{line: 113, col: 13, offset: 2571},
-											exprs: []interface{}{
-												&notExpr{
-													pos: position{line: 113, col: 13, offset: 2571},
-													expr: &choiceExpr{
-														pos: position{line: 274, col: 5, offset: 7396},
-														alternatives: []interface{}{
-															&litMatcher{
-																pos:        position{line: 256, col: 6, offset: 7094},
-																val:        "if",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 257, col: 8, offset: 7108},
-																val:        "then",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 258, col: 8, offset: 7124},
-																val:        "else",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 259, col: 7, offset: 7139},
-																val:        "let",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 260, col: 6, offset: 7152},
-																val:        "in",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 262, col: 9, offset: 7179},
-																val:        "using",
-																ignoreCase: false,
-															},
-															&actionExpr{
-																pos: position{line: 264, col: 11, offset: 7217},
-																run: (*parser).callonSelector45,
-																expr: &litMatcher{
-																	pos:        position{line: 264, col: 11, offset: 7217},
-																	val:        "missing",
-																	ignoreCase: false,
-																},
-															},
-															&litMatcher{
-																pos:        position{line: 261, col: 6, offset: 7164},
-																val:        "as",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 265, col: 8, offset: 7262},
-																val:        "True",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 266, col: 9, offset: 7279},
-																val:        "False",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 267, col: 12, offset: 7300},
-																val:        "Infinity",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 268, col: 7, offset: 7319},
-																val:        "NaN",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 263, col: 9, offset: 7197},
-																val:        "merge",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 269, col: 8, offset: 7334},
-																val:        "Some",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 270, col: 9, offset: 7351},
-																val:        "toMap",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 271, col: 10, offset: 7370},
-																val:        "assert",
-																ignoreCase: false,
-															},
-														},
-													},
-												},
-												&charClassMatcher{
-													pos:        position{line: 110, col: 24, offset: 2434},
-													val:        "[_A-Za-z]",
-													chars:      []rune{'_'},
-													ranges:     []rune{'A', 'Z', 'a', 'z'},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 113, col: 43, offset: 2601},
-													expr: &charClassMatcher{
-														pos:        position{line: 111, col: 23, offset: 2468},
-														val:        "[_/-A-Za-z0-9]",
-														chars:      []rune{'_', '/', '-'},
-														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-														ignoreCase: false,
-														inverted:   false,
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 612, col: 23, offset: 19116},
-						name: "Labels",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 612, col: 32, offset: 19125},
-						name: "TypeSelector",
-					},
-				},
-			},
-		},
-		{
-			name: "Labels",
-			pos:  position{line: 614, col: 1, offset: 19139},
-			expr: &actionExpr{
-				pos: position{line: 614, col: 10, offset: 19150},
-				run: (*parser).callonLabels1,
-				expr: &seqExpr{
-					pos: position{line: 614, col: 10, offset: 19150},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 614, col: 10, offset: 19150},
-							val:        "{",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 614, col: 14, offset: 19154},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 614, col: 16, offset: 19156},
-							label: "optclauses",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 614, col: 27, offset: 19167},
-								expr: &seqExpr{
-									pos: position{line: 614, col: 29, offset: 19169},
-									exprs: []interface{}{
-										&choiceExpr{
-											pos: position{line: 121, col: 9, offset: 2802},
-											alternatives: []interface{}{
-												&actionExpr{
-													pos: position{line: 121, col: 9, offset: 2802},
-													run: (*parser).callonLabels9,
-													expr: &seqExpr{
-														pos: position{line: 121, col: 9, offset: 2802},
-														exprs: []interface{}{
-															&litMatcher{
-																pos:        position{line: 121, col: 9, offset: 2802},
-																val:        "`",
-																ignoreCase: false,
-															},
-															&labeledExpr{
-																pos:   position{line: 121, col: 13, offset: 2806},
-																label: "label",
-																expr: &actionExpr{
-																	pos: position{line: 119, col: 15, offset: 2743},
-																	run: (*parser).callonLabels13,
-																	expr: &oneOrMoreExpr{
-																		pos: position{line: 119, col: 15, offset: 2743},
-																		expr: &charClassMatcher{
-																			pos:        position{line: 118, col: 19, offset: 2706},
-																			val:        "[ -_a-~]",
-																			ranges:     []rune{' ', '_', 'a', '~'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																},
-															},
-															&litMatcher{
-																pos:        position{line: 121, col: 31, offset: 2824},
-																val:        "`",
-																ignoreCase: false,
-															},
-														},
-													},
-												},
-												&actionExpr{
-													pos: position{line: 122, col: 9, offset: 2858},
-													run: (*parser).callonLabels17,
-													expr: &labeledExpr{
-														pos:   position{line: 122, col: 9, offset: 2858},
-														label: "label",
-														expr: &choiceExpr{
-															pos: position{line: 112, col: 15, offset: 2499},
-															alternatives: []interface{}{
-																&actionExpr{
-																	pos: position{line: 112, col: 15, offset: 2499},
-																	run: (*parser).callonLabels20,
-																	expr: &seqExpr{
-																		pos: position{line: 112, col: 15, offset: 2499},
-																		exprs: []interface{}{
-																			&choiceExpr{
-																				pos: position{line: 274, col: 5, offset: 7396},
-																				alternatives: []interface{}{
-																					&litMatcher{
-																						pos:        position{line: 256, col: 6, offset: 7094},
-																						val:        "if",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 257, col: 8, offset: 7108},
-																						val:        "then",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 258, col: 8, offset: 7124},
-																						val:        "else",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 259, col: 7, offset: 7139},
-																						val:        "let",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 260, col: 6, offset: 7152},
-																						val:        "in",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 262, col: 9, offset: 7179},
-																						val:        "using",
-																						ignoreCase: false,
-																					},
-																					&actionExpr{
-																						pos: position{line: 264, col: 11, offset: 7217},
-																						run: (*parser).callonLabels29,
-																						expr: &litMatcher{
-																							pos:        position{line: 264, col: 11, offset: 7217},
-																							val:        "missing",
-																							ignoreCase: false,
-																						},
-																					},
-																					&litMatcher{
-																						pos:        position{line: 261, col: 6, offset: 7164},
-																						val:        "as",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 265, col: 8, offset: 7262},
-																						val:        "True",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 266, col: 9, offset: 7279},
-																						val:        "False",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 267, col: 12, offset: 7300},
-																						val:        "Infinity",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 268, col: 7, offset: 7319},
-																						val:        "NaN",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 263, col: 9, offset: 7197},
-																						val:        "merge",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 269, col: 8, offset: 7334},
-																						val:        "Some",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 270, col: 9, offset: 7351},
-																						val:        "toMap",
-																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 271, col: 10, offset: 7370},
-																						val:        "assert",
-																						ignoreCase: false,
-																					},
-																				},
-																			},
-																			&oneOrMoreExpr{
-																				pos: position{line: 112, col: 23, offset: 2507},
-																				expr: &charClassMatcher{
-																					pos:        position{line: 111, col: 23, offset: 2468},
-																					val:        "[_/-A-Za-z0-9]",
-																					chars:      []rune{'_', '/', '-'},
-																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-																&actionExpr{
-																	pos: position{line: 113, col: 13, offset: 2571},
-																	run: (*parser).callonLabels42,
-																	expr: &seqExpr{
-																		pos: position{line: 113, col: 13, offset: 2571},
-																		exprs: []interface{}{
-																			&notExpr{
-																				pos: position{line: 113, col: 13, offset: 2571},
-																				expr: &choiceExpr{
-																					pos: position{line: 274, col: 5, offset: 7396},
-																					alternatives: []interface{}{
-																						&litMatcher{
-																							pos:        position{line: 256, col: 6, offset: 7094},
-																							val:        "if",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 257, col: 8, offset: 7108},
-																							val:        "then",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 258, col: 8, offset: 7124},
-																							val:        "else",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 259, col: 7, offset: 7139},
-																							val:        "let",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 260, col: 6, offset: 7152},
-																							val:        "in",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 262, col: 9, offset: 7179},
-																							val:        "using",
-																							ignoreCase: false,
-																						},
-																						&actionExpr{
-																							pos: position{line: 264, col: 11, offset: 7217},
-																							run: (*parser).callonLabels52,
-																							expr: &litMatcher{
-																								pos:        position{line: 264, col: 11, offset: 7217},
-																								val:        "missing",
-																								ignoreCase: false,
-																							},
-																						},
-																						&litMatcher{
-																							pos:        position{line: 261, col: 6, offset: 7164},
-																							val:        "as",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 265, col: 8, offset: 7262},
-																							val:        "True",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 266, col: 9, offset: 7279},
-																							val:        "False",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 267, col: 12, offset: 7300},
-																							val:        "Infinity",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 268, col: 7, offset: 7319},
-																							val:        "NaN",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 263, col: 9, offset: 7197},
-																							val:        "merge",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 269, col: 8, offset: 7334},
-																							val:        "Some",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 270, col: 9, offset: 7351},
-																							val:        "toMap",
-																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 271, col: 10, offset: 7370},
-																							val:        "assert",
-																							ignoreCase: false,
-																						},
-																					},
-																				},
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 110, col: 24, offset: 2434},
-																				val:        "[_A-Za-z]",
-																				chars:      []rune{'_'},
-																				ranges:     []rune{'A', 'Z', 'a', 'z'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&zeroOrMoreExpr{
-																				pos: position{line: 113, col: 43, offset: 2601},
-																				expr: &charClassMatcher{
-																					pos:        position{line: 111, col: 23, offset: 2468},
-																					val:        "[_/-A-Za-z0-9]",
-																					chars:      []rune{'_', '/', '-'},
-																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 614, col: 38, offset: 19178},
-											name: "_",
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 614, col: 40, offset: 19180},
-											expr: &seqExpr{
-												pos: position{line: 614, col: 41, offset: 19181},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 614, col: 41, offset: 19181},
-														val:        ",",
-														ignoreCase: false,
-													},
-													&ruleRefExpr{
-														pos:  position{line: 614, col: 45, offset: 19185},
-														name: "_",
-													},
-													&choiceExpr{
-														pos: position{line: 121, col: 9, offset: 2802},
-														alternatives: []interface{}{
-															&actionExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																run: (*parser).callonLabels72,
-																expr: &seqExpr{
-																	pos: position{line: 121, col: 9, offset: 2802},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 121, col: 9, offset: 2802},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																		&labeledExpr{
-																			pos:   position{line: 121, col: 13, offset: 2806},
-																			label: "label",
-																			expr: &actionExpr{
-																				pos: position{line: 119, col: 15, offset: 2743},
-																				run: (*parser).callonLabels76,
-																				expr: &oneOrMoreExpr{
-																					pos: position{line: 119, col: 15, offset: 2743},
-																					expr: &charClassMatcher{
-																						pos:        position{line: 118, col: 19, offset: 2706},
-																						val:        "[ -_a-~]",
-																						ranges:     []rune{' ', '_', 'a', '~'},
-																						ignoreCase: false,
-																						inverted:   false,
-																					},
-																				},
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 121, col: 31, offset: 2824},
-																			val:        "`",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-															&actionExpr{
-																pos: position{line: 122, col: 9, offset: 2858},
-																run: (*parser).callonLabels80,
-																expr: &labeledExpr{
-																	pos:   position{line: 122, col: 9, offset: 2858},
-																	label: "label",
-																	expr: &choiceExpr{
-																		pos: position{line: 112, col: 15, offset: 2499},
-																		alternatives: []interface{}{
-																			&actionExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				run: (*parser).callonLabels83,
-																				expr: &seqExpr{
-																					pos: position{line: 112, col: 15, offset: 2499},
-																					exprs: []interface{}{
-																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
-																							alternatives: []interface{}{
-																								&litMatcher{
-																									pos:        position{line: 256, col: 6, offset: 7094},
-																									val:        "if",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 257, col: 8, offset: 7108},
-																									val:        "then",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 258, col: 8, offset: 7124},
-																									val:        "else",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 259, col: 7, offset: 7139},
-																									val:        "let",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 260, col: 6, offset: 7152},
-																									val:        "in",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 262, col: 9, offset: 7179},
-																									val:        "using",
-																									ignoreCase: false,
-																								},
-																								&actionExpr{
-																									pos: position{line: 264, col: 11, offset: 7217},
-																									run: (*parser).callonLabels92,
-																									expr: &litMatcher{
-																										pos:        position{line: 264, col: 11, offset: 7217},
-																										val:        "missing",
-																										ignoreCase: false,
-																									},
-																								},
-																								&litMatcher{
-																									pos:        position{line: 261, col: 6, offset: 7164},
-																									val:        "as",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 265, col: 8, offset: 7262},
-																									val:        "True",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 266, col: 9, offset: 7279},
-																									val:        "False",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 267, col: 12, offset: 7300},
-																									val:        "Infinity",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 268, col: 7, offset: 7319},
-																									val:        "NaN",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 263, col: 9, offset: 7197},
-																									val:        "merge",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 269, col: 8, offset: 7334},
-																									val:        "Some",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 270, col: 9, offset: 7351},
-																									val:        "toMap",
-																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
-																								},
-																							},
-																						},
-																						&oneOrMoreExpr{
-																							pos: position{line: 112, col: 23, offset: 2507},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 113, col: 13, offset: 2571},
-																				run: (*parser).callonLabels105,
-																				expr: &seqExpr{
-																					pos: position{line: 113, col: 13, offset: 2571},
-																					exprs: []interface{}{
-																						&notExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
-																								alternatives: []interface{}{
-																									&litMatcher{
-																										pos:        position{line: 256, col: 6, offset: 7094},
-																										val:        "if",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 257, col: 8, offset: 7108},
-																										val:        "then",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 258, col: 8, offset: 7124},
-																										val:        "else",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 259, col: 7, offset: 7139},
-																										val:        "let",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 260, col: 6, offset: 7152},
-																										val:        "in",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 262, col: 9, offset: 7179},
-																										val:        "using",
-																										ignoreCase: false,
-																									},
-																									&actionExpr{
-																										pos: position{line: 264, col: 11, offset: 7217},
-																										run: (*parser).callonLabels115,
-																										expr: &litMatcher{
-																											pos:        position{line: 264, col: 11, offset: 7217},
-																											val:        "missing",
-																											ignoreCase: false,
-																										},
-																									},
-																									&litMatcher{
-																										pos:        position{line: 261, col: 6, offset: 7164},
-																										val:        "as",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 265, col: 8, offset: 7262},
-																										val:        "True",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 266, col: 9, offset: 7279},
-																										val:        "False",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 267, col: 12, offset: 7300},
-																										val:        "Infinity",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 268, col: 7, offset: 7319},
-																										val:        "NaN",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 263, col: 9, offset: 7197},
-																										val:        "merge",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 269, col: 8, offset: 7334},
-																										val:        "Some",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 270, col: 9, offset: 7351},
-																										val:        "toMap",
-																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
-																									},
-																								},
-																							},
-																						},
-																						&charClassMatcher{
-																							pos:        position{line: 110, col: 24, offset: 2434},
-																							val:        "[_A-Za-z]",
-																							chars:      []rune{'_'},
-																							ranges:     []rune{'A', 'Z', 'a', 'z'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																						&zeroOrMoreExpr{
-																							pos: position{line: 113, col: 43, offset: 2601},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 111, col: 23, offset: 2468},
-																								val:        "[_/-A-Za-z0-9]",
-																								chars:      []rune{'_', '/', '-'},
-																								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-													&ruleRefExpr{
-														pos:  position{line: 614, col: 56, offset: 19196},
-														name: "_",
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 614, col: 64, offset: 19204},
-							val:        "}",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "TypeSelector",
-			pos:  position{line: 624, col: 1, offset: 19500},
-			expr: &actionExpr{
-				pos: position{line: 624, col: 16, offset: 19517},
-				run: (*parser).callonTypeSelector1,
-				expr: &seqExpr{
-					pos: position{line: 624, col: 16, offset: 19517},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 624, col: 16, offset: 19517},
-							val:        "(",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 624, col: 20, offset: 19521},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 624, col: 22, offset: 19523},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 624, col: 24, offset: 19525},
-								name: "Expression",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 624, col: 35, offset: 19536},
-							name: "_",
-						},
-						&litMatcher{
-							pos:        position{line: 624, col: 37, offset: 19538},
-							val:        ")",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "PrimitiveExpression",
-			pos:  position{line: 626, col: 1, offset: 19561},
-			expr: &choiceExpr{
-				pos: position{line: 627, col: 7, offset: 19591},
-				alternatives: []interface{}{
-					&labeledExpr{
-						pos:   position{line: 306, col: 17, offset: 8080},
-						label: "d",
-						expr: &actionExpr{
-							pos: position{line: 298, col: 24, offset: 7868},
-							run: (*parser).callonPrimitiveExpression3,
-							expr: &seqExpr{
-								pos: position{line: 298, col: 24, offset: 7868},
-								exprs: []interface{}{
-									&zeroOrOneExpr{
-										pos: position{line: 298, col: 24, offset: 7868},
-										expr: &charClassMatcher{
-											pos:        position{line: 298, col: 24, offset: 7868},
-											val:        "[+-]",
-											chars:      []rune{'+', '-'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-									},
-									&oneOrMoreExpr{
-										pos: position{line: 298, col: 30, offset: 7874},
-										expr: &charClassMatcher{
-											pos:        position{line: 106, col: 9, offset: 2375},
-											val:        "[0-9]",
-											ranges:     []rune{'0', '9'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-									},
-									&choiceExpr{
-										pos: position{line: 298, col: 39, offset: 7883},
-										alternatives: []interface{}{
-											&seqExpr{
-												pos: position{line: 298, col: 39, offset: 7883},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 298, col: 39, offset: 7883},
-														val:        ".",
-														ignoreCase: false,
-													},
-													&oneOrMoreExpr{
-														pos: position{line: 298, col: 43, offset: 7887},
-														expr: &charClassMatcher{
-															pos:        position{line: 106, col: 9, offset: 2375},
-															val:        "[0-9]",
-															ranges:     []rune{'0', '9'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-													},
-													&zeroOrOneExpr{
-														pos: position{line: 298, col: 50, offset: 7894},
-														expr: &seqExpr{
-															pos: position{line: 296, col: 12, offset: 7824},
-															exprs: []interface{}{
-																&litMatcher{
-																	pos:        position{line: 296, col: 12, offset: 7824},
-																	val:        "e",
-																	ignoreCase: true,
-																},
-																&zeroOrOneExpr{
-																	pos: position{line: 296, col: 17, offset: 7829},
-																	expr: &charClassMatcher{
-																		pos:        position{line: 296, col: 17, offset: 7829},
-																		val:        "[+-]",
-																		chars:      []rune{'+', '-'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
-																},
-																&oneOrMoreExpr{
-																	pos: position{line: 296, col: 23, offset: 7835},
-																	expr: &charClassMatcher{
-																		pos:        position{line: 106, col: 9, offset: 2375},
-																		val:        "[0-9]",
-																		ranges:     []rune{'0', '9'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-											&seqExpr{
-												pos: position{line: 296, col: 12, offset: 7824},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 296, col: 12, offset: 7824},
-														val:        "e",
-														ignoreCase: true,
-													},
-													&zeroOrOneExpr{
-														pos: position{line: 296, col: 17, offset: 7829},
-														expr: &charClassMatcher{
-															pos:        position{line: 296, col: 17, offset: 7829},
-															val:        "[+-]",
-															chars:      []rune{'+', '-'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-													},
-													&oneOrMoreExpr{
-														pos: position{line: 296, col: 23, offset: 7835},
-														expr: &charClassMatcher{
-															pos:        position{line: 106, col: 9, offset: 2375},
-															val:        "[0-9]",
-															ranges:     []rune{'0', '9'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 307, col: 5, offset: 8107},
-						run: (*parser).callonPrimitiveExpression27,
-						expr: &litMatcher{
-							pos:        position{line: 267, col: 12, offset: 7300},
-							val:        "Infinity",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 308, col: 5, offset: 8159},
-						run: (*parser).callonPrimitiveExpression29,
-						expr: &litMatcher{
-							pos:        position{line: 308, col: 5, offset: 8159},
-							val:        "-Infinity",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 309, col: 5, offset: 8216},
-						run: (*parser).callonPrimitiveExpression31,
-						expr: &litMatcher{
-							pos:        position{line: 268, col: 7, offset: 7319},
-							val:        "NaN",
-							ignoreCase: false,
-						},
-					},
-					&actionExpr{
-						pos: position{line: 311, col: 18, offset: 8278},
-						run: (*parser).callonPrimitiveExpression33,
-						expr: &oneOrMoreExpr{
-							pos: position{line: 311, col: 18, offset: 8278},
-							expr: &charClassMatcher{
-								pos:        position{line: 106, col: 9, offset: 2375},
-								val:        "[0-9]",
-								ranges:     []rune{'0', '9'},
-								ignoreCase: false,
-								inverted:   false,
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 316, col: 18, offset: 8386},
-						run: (*parser).callonPrimitiveExpression36,
-						expr: &seqExpr{
-							pos: position{line: 316, col: 18, offset: 8386},
-							exprs: []interface{}{
-								&charClassMatcher{
-									pos:        position{line: 316, col: 18, offset: 8386},
-									val:        "[+-]",
-									chars:      []rune{'+', '-'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&actionExpr{
-									pos: position{line: 311, col: 18, offset: 8278},
-									run: (*parser).callonPrimitiveExpression39,
-									expr: &oneOrMoreExpr{
-										pos: position{line: 311, col: 18, offset: 8278},
-										expr: &charClassMatcher{
-											pos:        position{line: 106, col: 9, offset: 2375},
-											val:        "[0-9]",
-											ranges:     []rune{'0', '9'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-									},
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 630, col: 7, offset: 19653},
-						name: "TextLiteral",
-					},
-					&actionExpr{
-						pos: position{line: 631, col: 7, offset: 19671},
-						run: (*parser).callonPrimitiveExpression43,
-						expr: &seqExpr{
-							pos: position{line: 631, col: 7, offset: 19671},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 631, col: 7, offset: 19671},
-									val:        "{",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 631, col: 11, offset: 19675},
-									name: "_",
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 631, col: 13, offset: 19677},
-									expr: &seqExpr{
-										pos: position{line: 631, col: 14, offset: 19678},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 631, col: 14, offset: 19678},
-												val:        ",",
-												ignoreCase: false,
-											},
-											&ruleRefExpr{
-												pos:  position{line: 631, col: 18, offset: 19682},
-												name: "_",
-											},
-										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 631, col: 22, offset: 19686},
-									label: "r",
-									expr: &ruleRefExpr{
-										pos:  position{line: 631, col: 24, offset: 19688},
-										name: "RecordTypeOrLiteral",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 631, col: 44, offset: 19708},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 631, col: 46, offset: 19710},
-									val:        "}",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 632, col: 7, offset: 19738},
-						run: (*parser).callonPrimitiveExpression55,
-						expr: &seqExpr{
-							pos: position{line: 632, col: 7, offset: 19738},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 632, col: 7, offset: 19738},
-									val:        "<",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 632, col: 11, offset: 19742},
-									name: "_",
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 632, col: 13, offset: 19744},
-									expr: &seqExpr{
-										pos: position{line: 632, col: 14, offset: 19745},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 632, col: 14, offset: 19745},
-												val:        "|",
-												ignoreCase: false,
-											},
-											&ruleRefExpr{
-												pos:  position{line: 632, col: 18, offset: 19749},
-												name: "_",
-											},
-										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 632, col: 22, offset: 19753},
-									label: "u",
-									expr: &ruleRefExpr{
-										pos:  position{line: 632, col: 24, offset: 19755},
-										name: "UnionType",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 632, col: 34, offset: 19765},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 632, col: 36, offset: 19767},
-									val:        ">",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 633, col: 7, offset: 19795},
-						name: "NonEmptyListLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 634, col: 7, offset: 19821},
-						name: "Identifier",
-					},
-					&actionExpr{
-						pos: position{line: 635, col: 7, offset: 19838},
-						run: (*parser).callonPrimitiveExpression69,
-						expr: &seqExpr{
-							pos: position{line: 635, col: 7, offset: 19838},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 635, col: 7, offset: 19838},
-									val:        "(",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 635, col: 11, offset: 19842},
-									name: "_",
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 635, col: 13, offset: 19844},
-									expr: &seqExpr{
-										pos: position{line: 635, col: 14, offset: 19845},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 635, col: 14, offset: 19845},
-												val:        "|",
-												ignoreCase: false,
-											},
-											&ruleRefExpr{
-												pos:  position{line: 635, col: 18, offset: 19849},
-												name: "_",
-											},
-										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 635, col: 22, offset: 19853},
-									label: "e",
-									expr: &ruleRefExpr{
-										pos:  position{line: 635, col: 24, offset: 19855},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 635, col: 35, offset: 19866},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 635, col: 37, offset: 19868},
-									val:        ")",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "RecordTypeOrLiteral",
-			pos:  position{line: 637, col: 1, offset: 19891},
-			expr: &choiceExpr{
-				pos: position{line: 638, col: 7, offset: 19921},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 638, col: 7, offset: 19921},
-						run: (*parser).callonRecordTypeOrLiteral2,
-						expr: &litMatcher{
-							pos:        position{line: 638, col: 7, offset: 19921},
-							val:        "=",
-							ignoreCase: false,
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 639, col: 7, offset: 19959},
-						name: "NonEmptyRecordType",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 640, col: 7, offset: 19984},
-						name: "NonEmptyRecordLiteral",
-					},
-					&actionExpr{
-						pos: position{line: 641, col: 7, offset: 20012},
-						run: (*parser).callonRecordTypeOrLiteral6,
-						expr: &litMatcher{
-							pos:        position{line: 641, col: 7, offset: 20012},
-							val:        "",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "RecordTypeField",
-			pos:  position{line: 643, col: 1, offset: 20045},
-			expr: &actionExpr{
-				pos: position{line: 643, col: 19, offset: 20065},
-				run: (*parser).callonRecordTypeField1,
-				expr: &seqExpr{
-					pos: position{line: 643, col: 19, offset: 20065},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 643, col: 19, offset: 20065},
-							label: "name",
-							expr: &choiceExpr{
-								pos: position{line: 121, col: 9, offset: 2802},
-								alternatives: []interface{}{
-									&actionExpr{
-										pos: position{line: 121, col: 9, offset: 2802},
-										run: (*parser).callonRecordTypeField5,
-										expr: &seqExpr{
-											pos: position{line: 121, col: 9, offset: 2802},
-											exprs: []interface{}{
-												&litMatcher{
-													pos:        position{line: 121, col: 9, offset: 2802},
-													val:        "`",
-													ignoreCase: false,
-												},
-												&labeledExpr{
-													pos:   position{line: 121, col: 13, offset: 2806},
-													label: "label",
-													expr: &actionExpr{
-														pos: position{line: 119, col: 15, offset: 2743},
-														run: (*parser).callonRecordTypeField9,
-														expr: &oneOrMoreExpr{
-															pos: position{line: 119, col: 15, offset: 2743},
-															expr: &charClassMatcher{
-																pos:        position{line: 118, col: 19, offset: 2706},
-																val:        "[ -_a-~]",
-																ranges:     []rune{' ', '_', 'a', '~'},
-																ignoreCase: false,
-																inverted:   false,
-															},
-														},
-													},
-												},
-												&litMatcher{
-													pos:        position{line: 121, col: 31, offset: 2824},
-													val:        "`",
-													ignoreCase: false,
-												},
-											},
-										},
-									},
-									&actionExpr{
-										pos: position{line: 122, col: 9, offset: 2858},
-										run: (*parser).callonRecordTypeField13,
-										expr: &labeledExpr{
-											pos:   position{line: 122, col: 9, offset: 2858},
-											label: "label",
-											expr: &choiceExpr{
-												pos: position{line: 112, col: 15, offset: 2499},
-												alternatives: []interface{}{
-													&actionExpr{
-														pos: position{line: 112, col: 15, offset: 2499},
-														run: (*parser).callonRecordTypeField16,
-														expr: &seqExpr{
-															pos: position{line: 112, col: 15, offset: 2499},
-															exprs: []interface{}{
-																&choiceExpr{
-																	pos: position{line: 274, col: 5, offset: 7396},
-																	alternatives: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 256, col: 6, offset: 7094},
-																			val:        "if",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 257, col: 8, offset: 7108},
-																			val:        "then",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 258, col: 8, offset: 7124},
-																			val:        "else",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 259, col: 7, offset: 7139},
-																			val:        "let",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 260, col: 6, offset: 7152},
-																			val:        "in",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 262, col: 9, offset: 7179},
-																			val:        "using",
-																			ignoreCase: false,
-																		},
-																		&actionExpr{
-																			pos: position{line: 264, col: 11, offset: 7217},
-																			run: (*parser).callonRecordTypeField25,
-																			expr: &litMatcher{
-																				pos:        position{line: 264, col: 11, offset: 7217},
-																				val:        "missing",
-																				ignoreCase: false,
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 261, col: 6, offset: 7164},
-																			val:        "as",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 265, col: 8, offset: 7262},
-																			val:        "True",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 266, col: 9, offset: 7279},
-																			val:        "False",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 267, col: 12, offset: 7300},
-																			val:        "Infinity",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 268, col: 7, offset: 7319},
-																			val:        "NaN",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 263, col: 9, offset: 7197},
-																			val:        "merge",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 269, col: 8, offset: 7334},
-																			val:        "Some",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 270, col: 9, offset: 7351},
-																			val:        "toMap",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 271, col: 10, offset: 7370},
-																			val:        "assert",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-																&oneOrMoreExpr{
-																	pos: position{line: 112, col: 23, offset: 2507},
-																	expr: &charClassMatcher{
-																		pos:        position{line: 111, col: 23, offset: 2468},
-																		val:        "[_/-A-Za-z0-9]",
-																		chars:      []rune{'_', '/', '-'},
-																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
-																},
-															},
-														},
-													},
-													&actionExpr{
-														pos: position{line: 113, col: 13, offset: 2571},
-														run: (*parser).callonRecordTypeField38,
-														expr: &seqExpr{
-															pos: position{line: 113, col: 13, offset: 2571},
-															exprs: []interface{}{
-																&notExpr{
-																	pos: position{line: 113, col: 13, offset: 2571},
-																	expr: &choiceExpr{
-																		pos: position{line: 274, col: 5, offset: 7396},
-																		alternatives: []interface{}{
-																			&litMatcher{
-																				pos:        position{line: 256, col: 6, offset: 7094},
-																				val:        "if",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 257, col: 8, offset: 7108},
-																				val:        "then",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 258, col: 8, offset: 7124},
-																				val:        "else",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 259, col: 7, offset: 7139},
-																				val:        "let",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 260, col: 6, offset: 7152},
-																				val:        "in",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 262, col: 9, offset: 7179},
-																				val:        "using",
-																				ignoreCase: false,
-																			},
-																			&actionExpr{
-																				pos: position{line: 264, col: 11, offset: 7217},
-																				run: (*parser).callonRecordTypeField48,
-																				expr: &litMatcher{
-																					pos:        position{line: 264, col: 11, offset: 7217},
-																					val:        "missing",
-																					ignoreCase: false,
-																				},
-																			},
-																			&litMatcher{
-																				pos:        position{line: 261, col: 6, offset: 7164},
-																				val:        "as",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 265, col: 8, offset: 7262},
-																				val:        "True",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 266, col: 9, offset: 7279},
-																				val:        "False",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 267, col: 12, offset: 7300},
-																				val:        "Infinity",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 268, col: 7, offset: 7319},
-																				val:        "NaN",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 263, col: 9, offset: 7197},
-																				val:        "merge",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 269, col: 8, offset: 7334},
-																				val:        "Some",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 270, col: 9, offset: 7351},
-																				val:        "toMap",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 271, col: 10, offset: 7370},
-																				val:        "assert",
-																				ignoreCase: false,
-																			},
-																		},
-																	},
-																},
-																&charClassMatcher{
-																	pos:        position{line: 110, col: 24, offset: 2434},
-																	val:        "[_A-Za-z]",
-																	chars:      []rune{'_'},
-																	ranges:     []rune{'A', 'Z', 'a', 'z'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
-																&zeroOrMoreExpr{
-																	pos: position{line: 113, col: 43, offset: 2601},
-																	expr: &charClassMatcher{
-																		pos:        position{line: 111, col: 23, offset: 2468},
-																		val:        "[_/-A-Za-z0-9]",
-																		chars:      []rune{'_', '/', '-'},
-																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 643, col: 33, offset: 20079},
-							name: "_",
-						},
-						&litMatcher{
-							pos:        position{line: 643, col: 35, offset: 20081},
-							val:        ":",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 643, col: 39, offset: 20085},
-							name: "_1",
-						},
-						&labeledExpr{
-							pos:   position{line: 643, col: 42, offset: 20088},
-							label: "expr",
-							expr: &ruleRefExpr{
-								pos:  position{line: 643, col: 47, offset: 20093},
-								name: "Expression",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "MoreRecordType",
-			pos:  position{line: 646, col: 1, offset: 20150},
-			expr: &actionExpr{
-				pos: position{line: 646, col: 18, offset: 20169},
-				run: (*parser).callonMoreRecordType1,
-				expr: &seqExpr{
-					pos: position{line: 646, col: 18, offset: 20169},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 646, col: 18, offset: 20169},
-							name: "_",
-						},
-						&litMatcher{
-							pos:        position{line: 646, col: 20, offset: 20171},
-							val:        ",",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 646, col: 24, offset: 20175},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 646, col: 26, offset: 20177},
-							label: "f",
-							expr: &ruleRefExpr{
-								pos:  position{line: 646, col: 28, offset: 20179},
-								name: "RecordTypeField",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "NonEmptyRecordType",
-			pos:  position{line: 647, col: 1, offset: 20211},
-			expr: &actionExpr{
-				pos: position{line: 648, col: 7, offset: 20240},
-				run: (*parser).callonNonEmptyRecordType1,
-				expr: &seqExpr{
-					pos: position{line: 648, col: 7, offset: 20240},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 648, col: 7, offset: 20240},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 648, col: 13, offset: 20246},
-								name: "RecordTypeField",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 648, col: 29, offset: 20262},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 648, col: 34, offset: 20267},
-								expr: &ruleRefExpr{
-									pos:  position{line: 648, col: 34, offset: 20267},
-									name: "MoreRecordType",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "RecordLiteralField",
-			pos:  position{line: 662, col: 1, offset: 20838},
-			expr: &actionExpr{
-				pos: position{line: 662, col: 22, offset: 20861},
-				run: (*parser).callonRecordLiteralField1,
-				expr: &seqExpr{
-					pos: position{line: 662, col: 22, offset: 20861},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 662, col: 22, offset: 20861},
-							label: "name",
-							expr: &choiceExpr{
-								pos: position{line: 121, col: 9, offset: 2802},
-								alternatives: []interface{}{
-									&actionExpr{
-										pos: position{line: 121, col: 9, offset: 2802},
-										run: (*parser).callonRecordLiteralField5,
-										expr: &seqExpr{
-											pos: position{line: 121, col: 9, offset: 2802},
-											exprs: []interface{}{
-												&litMatcher{
-													pos:        position{line: 121, col: 9, offset: 2802},
-													val:        "`",
-													ignoreCase: false,
-												},
-												&labeledExpr{
-													pos:   position{line: 121, col: 13, offset: 2806},
-													label: "label",
-													expr: &actionExpr{
-														pos: position{line: 119, col: 15, offset: 2743},
-														run: (*parser).callonRecordLiteralField9,
-														expr: &oneOrMoreExpr{
-															pos: position{line: 119, col: 15, offset: 2743},
-															expr: &charClassMatcher{
-																pos:        position{line: 118, col: 19, offset: 2706},
-																val:        "[ -_a-~]",
-																ranges:     []rune{' ', '_', 'a', '~'},
-																ignoreCase: false,
-																inverted:   false,
-															},
-														},
-													},
-												},
-												&litMatcher{
-													pos:        position{line: 121, col: 31, offset: 2824},
-													val:        "`",
-													ignoreCase: false,
-												},
-											},
-										},
-									},
-									&actionExpr{
-										pos: position{line: 122, col: 9, offset: 2858},
-										run: (*parser).callonRecordLiteralField13,
-										expr: &labeledExpr{
-											pos:   position{line: 122, col: 9, offset: 2858},
-											label: "label",
-											expr: &choiceExpr{
-												pos: position{line: 112, col: 15, offset: 2499},
-												alternatives: []interface{}{
-													&actionExpr{
-														pos: position{line: 112, col: 15, offset: 2499},
-														run: (*parser).callonRecordLiteralField16,
-														expr: &seqExpr{
-															pos: position{line: 112, col: 15, offset: 2499},
-															exprs: []interface{}{
-																&choiceExpr{
-																	pos: position{line: 274, col: 5, offset: 7396},
-																	alternatives: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 256, col: 6, offset: 7094},
-																			val:        "if",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 257, col: 8, offset: 7108},
-																			val:        "then",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 258, col: 8, offset: 7124},
-																			val:        "else",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 259, col: 7, offset: 7139},
-																			val:        "let",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 260, col: 6, offset: 7152},
-																			val:        "in",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 262, col: 9, offset: 7179},
-																			val:        "using",
-																			ignoreCase: false,
-																		},
-																		&actionExpr{
-																			pos: position{line: 264, col: 11, offset: 7217},
-																			run: (*parser).callonRecordLiteralField25,
-																			expr: &litMatcher{
-																				pos:        position{line: 264, col: 11, offset: 7217},
-																				val:        "missing",
-																				ignoreCase: false,
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 261, col: 6, offset: 7164},
-																			val:        "as",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 265, col: 8, offset: 7262},
-																			val:        "True",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 266, col: 9, offset: 7279},
-																			val:        "False",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 267, col: 12, offset: 7300},
-																			val:        "Infinity",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 268, col: 7, offset: 7319},
-																			val:        "NaN",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 263, col: 9, offset: 7197},
-																			val:        "merge",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 269, col: 8, offset: 7334},
-																			val:        "Some",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 270, col: 9, offset: 7351},
-																			val:        "toMap",
-																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 271, col: 10, offset: 7370},
-																			val:        "assert",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-																&oneOrMoreExpr{
-																	pos: position{line: 112, col: 23, offset: 2507},
-																	expr: &charClassMatcher{
-																		pos:        position{line: 111, col: 23, offset: 2468},
-																		val:        "[_/-A-Za-z0-9]",
-																		chars:      []rune{'_', '/', '-'},
-																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
-																},
-															},
-														},
-													},
-													&actionExpr{
-														pos: position{line: 113, col: 13, offset: 2571},
-														run: (*parser).callonRecordLiteralField38,
-														expr: &seqExpr{
-															pos: position{line: 113, col: 13, offset: 2571},
-															exprs: []interface{}{
-																&notExpr{
-																	pos: position{line: 113, col: 13, offset: 2571},
-																	expr: &choiceExpr{
-																		pos: position{line: 274, col: 5, offset: 7396},
-																		alternatives: []interface{}{
-																			&litMatcher{
-																				pos:        position{line: 256, col: 6, offset: 7094},
-																				val:        "if",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 257, col: 8, offset: 7108},
-																				val:        "then",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 258, col: 8, offset: 7124},
-																				val:        "else",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 259, col: 7, offset: 7139},
-																				val:        "let",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 260, col: 6, offset: 7152},
-																				val:        "in",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 262, col: 9, offset: 7179},
-																				val:        "using",
-																				ignoreCase: false,
-																			},
-																			&actionExpr{
-																				pos: position{line: 264, col: 11, offset: 7217},
-																				run: (*parser).callonRecordLiteralField48,
-																				expr: &litMatcher{
-																					pos:        position{line: 264, col: 11, offset: 7217},
-																					val:        "missing",
-																					ignoreCase: false,
-																				},
-																			},
-																			&litMatcher{
-																				pos:        position{line: 261, col: 6, offset: 7164},
-																				val:        "as",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 265, col: 8, offset: 7262},
-																				val:        "True",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 266, col: 9, offset: 7279},
-																				val:        "False",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 267, col: 12, offset: 7300},
-																				val:        "Infinity",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 268, col: 7, offset: 7319},
-																				val:        "NaN",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 263, col: 9, offset: 7197},
-																				val:        "merge",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 269, col: 8, offset: 7334},
-																				val:        "Some",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 270, col: 9, offset: 7351},
-																				val:        "toMap",
-																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 271, col: 10, offset: 7370},
-																				val:        "assert",
-																				ignoreCase: false,
-																			},
-																		},
-																	},
-																},
-																&charClassMatcher{
-																	pos:        position{line: 110, col: 24, offset: 2434},
-																	val:        "[_A-Za-z]",
-																	chars:      []rune{'_'},
-																	ranges:     []rune{'A', 'Z', 'a', 'z'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
-																&zeroOrMoreExpr{
-																	pos: position{line: 113, col: 43, offset: 2601},
-																	expr: &charClassMatcher{
-																		pos:        position{line: 111, col: 23, offset: 2468},
-																		val:        "[_/-A-Za-z0-9]",
-																		chars:      []rune{'_', '/', '-'},
-																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 662, col: 36, offset: 20875},
-							name: "_",
-						},
-						&litMatcher{
-							pos:        position{line: 662, col: 38, offset: 20877},
-							val:        "=",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 662, col: 42, offset: 20881},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 662, col: 44, offset: 20883},
-							label: "expr",
-							expr: &ruleRefExpr{
-								pos:  position{line: 662, col: 49, offset: 20888},
-								name: "Expression",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "MoreRecordLiteral",
-			pos:  position{line: 665, col: 1, offset: 20945},
-			expr: &actionExpr{
-				pos: position{line: 665, col: 21, offset: 20967},
-				run: (*parser).callonMoreRecordLiteral1,
-				expr: &seqExpr{
-					pos: position{line: 665, col: 21, offset: 20967},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 665, col: 21, offset: 20967},
-							name: "_",
-						},
-						&litMatcher{
-							pos:        position{line: 665, col: 23, offset: 20969},
-							val:        ",",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 665, col: 27, offset: 20973},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 665, col: 29, offset: 20975},
-							label: "f",
-							expr: &ruleRefExpr{
-								pos:  position{line: 665, col: 31, offset: 20977},
-								name: "RecordLiteralField",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "NonEmptyRecordLiteral",
-			pos:  position{line: 666, col: 1, offset: 21012},
-			expr: &actionExpr{
-				pos: position{line: 667, col: 7, offset: 21044},
-				run: (*parser).callonNonEmptyRecordLiteral1,
-				expr: &seqExpr{
-					pos: position{line: 667, col: 7, offset: 21044},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 667, col: 7, offset: 21044},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 667, col: 13, offset: 21050},
-								name: "RecordLiteralField",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 667, col: 32, offset: 21069},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 667, col: 37, offset: 21074},
-								expr: &ruleRefExpr{
-									pos:  position{line: 667, col: 37, offset: 21074},
-									name: "MoreRecordLiteral",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "UnionType",
-			pos:  position{line: 681, col: 1, offset: 21647},
-			expr: &choiceExpr{
-				pos: position{line: 681, col: 13, offset: 21661},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 681, col: 13, offset: 21661},
-						name: "NonEmptyUnionType",
-					},
-					&actionExpr{
-						pos: position{line: 683, col: 18, offset: 21716},
-						run: (*parser).callonUnionType3,
-						expr: &litMatcher{
-							pos:        position{line: 683, col: 18, offset: 21716},
-							val:        "",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "NonEmptyUnionType",
-			pos:  position{line: 685, col: 1, offset: 21748},
-			expr: &actionExpr{
-				pos: position{line: 685, col: 21, offset: 21770},
-				run: (*parser).callonNonEmptyUnionType1,
-				expr: &seqExpr{
-					pos: position{line: 685, col: 21, offset: 21770},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 685, col: 21, offset: 21770},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 685, col: 27, offset: 21776},
-								name: "UnionVariant",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 685, col: 40, offset: 21789},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 685, col: 45, offset: 21794},
-								expr: &seqExpr{
-									pos: position{line: 685, col: 46, offset: 21795},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 685, col: 46, offset: 21795},
-											name: "_",
-										},
-										&litMatcher{
-											pos:        position{line: 685, col: 48, offset: 21797},
-											val:        "|",
-											ignoreCase: false,
-										},
-										&ruleRefExpr{
-											pos:  position{line: 685, col: 52, offset: 21801},
-											name: "_",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 685, col: 54, offset: 21803},
-											name: "UnionVariant",
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "UnionVariant",
-			pos:  position{line: 710, col: 1, offset: 22644},
-			expr: &seqExpr{
-				pos: position{line: 710, col: 16, offset: 22661},
-				exprs: []interface{}{
-					&choiceExpr{
-						pos: position{line: 121, col: 9, offset: 2802},
-						alternatives: []interface{}{
-							&actionExpr{
-								pos: position{line: 121, col: 9, offset: 2802},
-								run: (*parser).callonUnionVariant3,
-								expr: &seqExpr{
-									pos: position{line: 121, col: 9, offset: 2802},
-									exprs: []interface{}{
-										&litMatcher{
-											pos:        position{line: 121, col: 9, offset: 2802},
-											val:        "`",
-											ignoreCase: false,
-										},
-										&labeledExpr{
-											pos:   position{line: 121, col: 13, offset: 2806},
-											label: "label",
-											expr: &actionExpr{
-												pos: position{line: 119, col: 15, offset: 2743},
-												run: (*parser).callonUnionVariant7,
-												expr: &oneOrMoreExpr{
-													pos: position{line: 119, col: 15, offset: 2743},
-													expr: &charClassMatcher{
-														pos:        position{line: 118, col: 19, offset: 2706},
-														val:        "[ -_a-~]",
-														ranges:     []rune{' ', '_', 'a', '~'},
-														ignoreCase: false,
-														inverted:   false,
-													},
-												},
-											},
-										},
-										&litMatcher{
-											pos:        position{line: 121, col: 31, offset: 2824},
-											val:        "`",
-											ignoreCase: false,
-										},
-									},
-								},
-							},
-							&actionExpr{
-								pos: position{line: 122, col: 9, offset: 2858},
-								run: (*parser).callonUnionVariant11,
-								expr: &labeledExpr{
-									pos:   position{line: 122, col: 9, offset: 2858},
-									label: "label",
-									expr: &choiceExpr{
-										pos: position{line: 112, col: 15, offset: 2499},
-										alternatives: []interface{}{
-											&actionExpr{
-												pos: position{line: 112, col: 15, offset: 2499},
-												run: (*parser).callonUnionVariant14,
-												expr: &seqExpr{
-													pos: position{line: 112, col: 15, offset: 2499},
-													exprs: []interface{}{
-														&choiceExpr{
-															pos: position{line: 274, col: 5, offset: 7396},
-															alternatives: []interface{}{
-																&litMatcher{
-																	pos:        position{line: 256, col: 6, offset: 7094},
-																	val:        "if",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 257, col: 8, offset: 7108},
-																	val:        "then",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 258, col: 8, offset: 7124},
-																	val:        "else",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 259, col: 7, offset: 7139},
-																	val:        "let",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 260, col: 6, offset: 7152},
-																	val:        "in",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 262, col: 9, offset: 7179},
-																	val:        "using",
-																	ignoreCase: false,
-																},
-																&actionExpr{
-																	pos: position{line: 264, col: 11, offset: 7217},
-																	run: (*parser).callonUnionVariant23,
-																	expr: &litMatcher{
-																		pos:        position{line: 264, col: 11, offset: 7217},
-																		val:        "missing",
-																		ignoreCase: false,
-																	},
-																},
-																&litMatcher{
-																	pos:        position{line: 261, col: 6, offset: 7164},
-																	val:        "as",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 265, col: 8, offset: 7262},
-																	val:        "True",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 266, col: 9, offset: 7279},
-																	val:        "False",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 267, col: 12, offset: 7300},
-																	val:        "Infinity",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 268, col: 7, offset: 7319},
-																	val:        "NaN",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 263, col: 9, offset: 7197},
-																	val:        "merge",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 269, col: 8, offset: 7334},
-																	val:        "Some",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 270, col: 9, offset: 7351},
-																	val:        "toMap",
-																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 271, col: 10, offset: 7370},
-																	val:        "assert",
-																	ignoreCase: false,
-																},
-															},
-														},
-														&oneOrMoreExpr{
-															pos: position{line: 112, col: 23, offset: 2507},
-															expr: &charClassMatcher{
-																pos:        position{line: 111, col: 23, offset: 2468},
-																val:        "[_/-A-Za-z0-9]",
-																chars:      []rune{'_', '/', '-'},
-																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																ignoreCase: false,
-																inverted:   false,
-															},
-														},
-													},
-												},
-											},
-											&actionExpr{
-												pos: position{line: 113, col: 13, offset: 2571},
-												run: (*parser).callonUnionVariant36,
-												expr: &seqExpr{
-													pos: position{line: 113, col: 13, offset: 2571},
-													exprs: []interface{}{
-														&notExpr{
-															pos: position{line: 113, col: 13, offset: 2571},
-															expr: &choiceExpr{
-																pos: position{line: 274, col: 5, offset: 7396},
-																alternatives: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 256, col: 6, offset: 7094},
-																		val:        "if",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 257, col: 8, offset: 7108},
-																		val:        "then",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 258, col: 8, offset: 7124},
-																		val:        "else",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 259, col: 7, offset: 7139},
-																		val:        "let",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 260, col: 6, offset: 7152},
-																		val:        "in",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 262, col: 9, offset: 7179},
-																		val:        "using",
-																		ignoreCase: false,
-																	},
-																	&actionExpr{
-																		pos: position{line: 264, col: 11, offset: 7217},
-																		run: (*parser).callonUnionVariant46,
-																		expr: &litMatcher{
-																			pos:        position{line: 264, col: 11, offset: 7217},
-																			val:        "missing",
-																			ignoreCase: false,
-																		},
-																	},
-																	&litMatcher{
-																		pos:        position{line: 261, col: 6, offset: 7164},
-																		val:        "as",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 265, col: 8, offset: 7262},
-																		val:        "True",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 266, col: 9, offset: 7279},
-																		val:        "False",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 267, col: 12, offset: 7300},
-																		val:        "Infinity",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 268, col: 7, offset: 7319},
-																		val:        "NaN",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 263, col: 9, offset: 7197},
-																		val:        "merge",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 269, col: 8, offset: 7334},
-																		val:        "Some",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 270, col: 9, offset: 7351},
-																		val:        "toMap",
-																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 271, col: 10, offset: 7370},
-																		val:        "assert",
-																		ignoreCase: false,
-																	},
-																},
-															},
-														},
-														&charClassMatcher{
-															pos:        position{line: 110, col: 24, offset: 2434},
-															val:        "[_A-Za-z]",
-															chars:      []rune{'_'},
-															ranges:     []rune{'A', 'Z', 'a', 'z'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-														&zeroOrMoreExpr{
-															pos: position{line: 113, col: 43, offset: 2601},
-															expr: &charClassMatcher{
-																pos:        position{line: 111, col: 23, offset: 2468},
-																val:        "[_/-A-Za-z0-9]",
-																chars:      []rune{'_', '/', '-'},
-																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																ignoreCase: false,
-																inverted:   false,
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&zeroOrOneExpr{
-						pos: position{line: 710, col: 25, offset: 22670},
-						expr: &seqExpr{
-							pos: position{line: 710, col: 26, offset: 22671},
-							exprs: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 710, col: 26, offset: 22671},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 710, col: 28, offset: 22673},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 710, col: 32, offset: 22677},
-									name: "_1",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 710, col: 35, offset: 22680},
-									name: "Expression",
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "MoreList",
-			pos:  position{line: 712, col: 1, offset: 22694},
-			expr: &actionExpr{
-				pos: position{line: 712, col: 12, offset: 22707},
-				run: (*parser).callonMoreList1,
-				expr: &seqExpr{
-					pos: position{line: 712, col: 12, offset: 22707},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 712, col: 12, offset: 22707},
-							val:        ",",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 712, col: 16, offset: 22711},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 712, col: 18, offset: 22713},
-							label: "e",
-							expr: &ruleRefExpr{
-								pos:  position{line: 712, col: 20, offset: 22715},
-								name: "Expression",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 712, col: 31, offset: 22726},
-							name: "_",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "NonEmptyListLiteral",
-			pos:  position{line: 714, col: 1, offset: 22745},
-			expr: &actionExpr{
-				pos: position{line: 715, col: 7, offset: 22775},
-				run: (*parser).callonNonEmptyListLiteral1,
-				expr: &seqExpr{
-					pos: position{line: 715, col: 7, offset: 22775},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 715, col: 7, offset: 22775},
-							val:        "[",
-							ignoreCase: false,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 715, col: 11, offset: 22779},
-							name: "_",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 715, col: 13, offset: 22781},
-							expr: &seqExpr{
-								pos: position{line: 715, col: 14, offset: 22782},
-								exprs: []interface{}{
-									&litMatcher{
-										pos:        position{line: 715, col: 14, offset: 22782},
-										val:        ",",
-										ignoreCase: false,
-									},
-									&ruleRefExpr{
-										pos:  position{line: 715, col: 18, offset: 22786},
-										name: "_",
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 715, col: 22, offset: 22790},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 715, col: 28, offset: 22796},
-								name: "Expression",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 715, col: 39, offset: 22807},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 715, col: 41, offset: 22809},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 715, col: 46, offset: 22814},
-								expr: &ruleRefExpr{
-									pos:  position{line: 715, col: 46, offset: 22814},
-									name: "MoreList",
-								},
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 715, col: 56, offset: 22824},
-							val:        "]",
-							ignoreCase: false,
-						},
-					},
-				},
-			},
-		},
-	},
-}
-
-func (c *current) onDhallFile1(e interface{}) (interface{}, error) {
-	return e, nil
-}
-
-func (p *parser) callonDhallFile1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDhallFile1(stack["e"])
-}
-
-func (c *current) onCompleteExpression1(e interface{}) (interface{}, error) {
-	return e, nil
-}
-
-func (p *parser) callonCompleteExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onCompleteExpression1(stack["e"])
-}
-
-func (c *current) onBlockCommentContinue9() (interface{}, error) {
-	return []byte{'\n'}, nil
-}
-
-func (p *parser) callonBlockCommentContinue9() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onBlockCommentContinue9()
-}
-
-func (c *current) onWhitespaceChunk3() (interface{}, error) {
-	return []byte{'\n'}, nil
-}
-
-func (p *parser) callonWhitespaceChunk3() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onWhitespaceChunk3()
-}
-
-func (c *current) onWhitespaceChunk9() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonWhitespaceChunk9() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onWhitespaceChunk9()
-}
-
-func (c *current) onWhitespaceChunk14() (interface{}, error) {
-	return []byte{'\n'}, nil
-}
-
-func (p *parser) callonWhitespaceChunk14() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onWhitespaceChunk14()
-}
-
-func (c *current) onWhitespaceChunk5(content interface{}) (interface{}, error) {
-	return content, nil
-}
-
-func (p *parser) callonWhitespaceChunk5() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onWhitespaceChunk5(stack["content"])
-}
-
-func (c *current) onDoubleQuoteChunk9() (interface{}, error) {
-	return []byte("\b"), nil
-}
-
-func (p *parser) callonDoubleQuoteChunk9() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteChunk9()
-}
-
-func (c *current) onDoubleQuoteChunk11() (interface{}, error) {
-	return []byte("\f"), nil
-}
-
-func (p *parser) callonDoubleQuoteChunk11() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteChunk11()
-}
-
-func (c *current) onDoubleQuoteChunk13() (interface{}, error) {
-	return []byte("\n"), nil
-}
-
-func (p *parser) callonDoubleQuoteChunk13() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteChunk13()
-}
-
-func (c *current) onDoubleQuoteChunk15() (interface{}, error) {
-	return []byte("\r"), nil
-}
-
-func (p *parser) callonDoubleQuoteChunk15() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteChunk15()
-}
-
-func (c *current) onDoubleQuoteChunk17() (interface{}, error) {
-	return []byte("\t"), nil
-}
-
-func (p *parser) callonDoubleQuoteChunk17() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteChunk17()
-}
-
-func (c *current) onDoubleQuoteChunk24() (interface{}, error) {
-	return parseCodepoint(string(c.text))
-
-}
-
-func (p *parser) callonDoubleQuoteChunk24() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteChunk24()
-}
-
-func (c *current) onDoubleQuoteChunk38() (interface{}, error) {
-	return parseCodepoint(string(c.text[1 : len(c.text)-1]))
-
-}
-
-func (p *parser) callonDoubleQuoteChunk38() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteChunk38()
-}
-
-func (c *current) onDoubleQuoteChunk19(u interface{}) (interface{}, error) {
-	return u, nil
-}
-
-func (p *parser) callonDoubleQuoteChunk19() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteChunk19(stack["u"])
-}
-
-func (c *current) onDoubleQuoteChunk3(e interface{}) (interface{}, error) {
-	return e, nil
-}
-
-func (p *parser) callonDoubleQuoteChunk3() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteChunk3(stack["e"])
-}
-
-func (c *current) onDoubleQuoteLiteral1(chunks interface{}) (interface{}, error) {
-	var str strings.Builder
-	var outChunks Chunks
-	for _, chunk := range chunks.([]interface{}) {
-		switch e := chunk.(type) {
-		case []byte:
-			str.Write(e)
-		case Term:
-			outChunks = append(outChunks, Chunk{str.String(), e})
-			str.Reset()
-		default:
-			return nil, errors.New("can't happen")
-		}
-	}
-	return TextLitTerm{Chunks: outChunks, Suffix: str.String()}, nil
-}
-
-func (p *parser) callonDoubleQuoteLiteral1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDoubleQuoteLiteral1(stack["chunks"])
-}
-
-func (c *current) onSingleQuoteContinue6() (interface{}, error) {
-	return []byte("''"), nil
-}
-
-func (p *parser) callonSingleQuoteContinue6() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSingleQuoteContinue6()
-}
-
-func (c *current) onSingleQuoteContinue10() (interface{}, error) {
-	return []byte("$\u007b"), nil
-}
-
-func (p *parser) callonSingleQuoteContinue10() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSingleQuoteContinue10()
-}
-
-func (c *current) onSingleQuoteContinue16() (interface{}, error) {
-	return []byte{'\n'}, nil
-}
-
-func (p *parser) callonSingleQuoteContinue16() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSingleQuoteContinue16()
-}
-
-func (c *current) onSingleQuoteLiteral6() (interface{}, error) {
-	return []byte{'\n'}, nil
-}
-
-func (p *parser) callonSingleQuoteLiteral6() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSingleQuoteLiteral6()
-}
-
-func (c *current) onSingleQuoteLiteral1(content interface{}) (interface{}, error) {
-	var str strings.Builder
-	var outChunks Chunks
-	chunk, ok := content.([]interface{})
-	for ; ok; chunk, ok = chunk[1].([]interface{}) {
-		switch e := chunk[0].(type) {
-		case []byte:
-			str.Write(e)
-		case Term:
-			outChunks = append(outChunks, Chunk{str.String(), e})
-			str.Reset()
-		default:
-			return nil, errors.New("unimplemented")
-		}
-	}
-	return removeLeadingCommonIndent(TextLitTerm{Chunks: outChunks, Suffix: str.String()}), nil
-}
-
-func (p *parser) callonSingleQuoteLiteral1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSingleQuoteLiteral1(stack["content"])
-}
-
-func (c *current) onInterpolation1(e interface{}) (interface{}, error) {
-	return e, nil
-}
-
-func (p *parser) callonInterpolation1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onInterpolation1(stack["e"])
-}
-
-func (c *current) onDeBruijn7() (interface{}, error) {
-	i, err := strconv.Atoi(string(c.text))
-	return NaturalLit(i), err
-}
-
-func (p *parser) callonDeBruijn7() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDeBruijn7()
-}
-
-func (c *current) onDeBruijn1(index interface{}) (interface{}, error) {
-	return int(index.(NaturalLit)), nil
-}
-
-func (p *parser) callonDeBruijn1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onDeBruijn1(stack["index"])
-}
-
-func (c *current) onVariable10() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonVariable10() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable10()
-}
-
-func (c *current) onVariable12() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonVariable12() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable12()
-}
-
-func (c *current) onVariable14() (interface{}, error) {
-	return NaturalIsZero, nil
-}
-
-func (p *parser) callonVariable14() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable14()
-}
-
-func (c *current) onVariable16() (interface{}, error) {
-	return NaturalEven, nil
-}
-
-func (p *parser) callonVariable16() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable16()
-}
-
-func (c *current) onVariable18() (interface{}, error) {
-	return NaturalOdd, nil
-}
-
-func (p *parser) callonVariable18() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable18()
-}
-
-func (c *current) onVariable20() (interface{}, error) {
-	return NaturalToInteger, nil
-}
-
-func (p *parser) callonVariable20() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable20()
-}
-
-func (c *current) onVariable22() (interface{}, error) {
-	return NaturalShow, nil
-}
-
-func (p *parser) callonVariable22() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable22()
-}
-
-func (c *current) onVariable24() (interface{}, error) {
-	return NaturalSubtract, nil
-}
-
-func (p *parser) callonVariable24() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable24()
-}
-
-func (c *current) onVariable26() (interface{}, error) {
-	return IntegerToDouble, nil
-}
-
-func (p *parser) callonVariable26() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable26()
-}
-
-func (c *current) onVariable28() (interface{}, error) {
-	return IntegerShow, nil
-}
-
-func (p *parser) callonVariable28() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable28()
-}
-
-func (c *current) onVariable30() (interface{}, error) {
-	return DoubleShow, nil
-}
-
-func (p *parser) callonVariable30() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable30()
-}
-
-func (c *current) onVariable32() (interface{}, error) {
-	return ListBuild, nil
-}
-
-func (p *parser) callonVariable32() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable32()
-}
-
-func (c *current) onVariable34() (interface{}, error) {
-	return ListFold, nil
-}
-
-func (p *parser) callonVariable34() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable34()
-}
-
-func (c *current) onVariable36() (interface{}, error) {
-	return ListLength, nil
-}
-
-func (p *parser) callonVariable36() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable36()
-}
-
-func (c *current) onVariable38() (interface{}, error) {
-	return ListHead, nil
-}
-
-func (p *parser) callonVariable38() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable38()
-}
-
-func (c *current) onVariable40() (interface{}, error) {
-	return ListLast, nil
-}
-
-func (p *parser) callonVariable40() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable40()
-}
-
-func (c *current) onVariable42() (interface{}, error) {
-	return ListIndexed, nil
-}
-
-func (p *parser) callonVariable42() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable42()
-}
-
-func (c *current) onVariable44() (interface{}, error) {
-	return ListReverse, nil
-}
-
-func (p *parser) callonVariable44() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable44()
-}
-
-func (c *current) onVariable46() (interface{}, error) {
-	return OptionalBuild, nil
-}
-
-func (p *parser) callonVariable46() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable46()
-}
-
-func (c *current) onVariable48() (interface{}, error) {
-	return OptionalFold, nil
-}
-
-func (p *parser) callonVariable48() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable48()
-}
-
-func (c *current) onVariable50() (interface{}, error) {
-	return TextShow, nil
-}
-
-func (p *parser) callonVariable50() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable50()
-}
-
-func (c *current) onVariable52() (interface{}, error) {
-	return Bool, nil
-}
-
-func (p *parser) callonVariable52() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable52()
-}
-
-func (c *current) onVariable54() (interface{}, error) {
-	return True, nil
-}
-
-func (p *parser) callonVariable54() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable54()
-}
-
-func (c *current) onVariable56() (interface{}, error) {
-	return False, nil
-}
-
-func (p *parser) callonVariable56() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable56()
-}
-
-func (c *current) onVariable58() (interface{}, error) {
-	return Optional, nil
-}
-
-func (p *parser) callonVariable58() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable58()
-}
-
-func (c *current) onVariable60() (interface{}, error) {
-	return Natural, nil
-}
-
-func (p *parser) callonVariable60() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable60()
-}
-
-func (c *current) onVariable62() (interface{}, error) {
-	return Integer, nil
-}
-
-func (p *parser) callonVariable62() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable62()
-}
-
-func (c *current) onVariable64() (interface{}, error) {
-	return Double, nil
-}
-
-func (p *parser) callonVariable64() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable64()
-}
-
-func (c *current) onVariable66() (interface{}, error) {
-	return Text, nil
-}
-
-func (p *parser) callonVariable66() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable66()
-}
-
-func (c *current) onVariable68() (interface{}, error) {
-	return List, nil
-}
-
-func (p *parser) callonVariable68() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable68()
-}
-
-func (c *current) onVariable70() (interface{}, error) {
-	return None, nil
-}
-
-func (p *parser) callonVariable70() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable70()
-}
-
-func (c *current) onVariable72() (interface{}, error) {
-	return Type, nil
-}
-
-func (p *parser) callonVariable72() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable72()
-}
-
-func (c *current) onVariable74() (interface{}, error) {
-	return Kind, nil
-}
-
-func (p *parser) callonVariable74() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable74()
-}
-
-func (c *current) onVariable76() (interface{}, error) {
-	return Sort, nil
-}
-
-func (p *parser) callonVariable76() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable76()
-}
-
-func (c *current) onVariable85() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonVariable85() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable85()
-}
-
-func (c *current) onVariable81(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonVariable81() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable81(stack["label"])
-}
-
-func (c *current) onVariable101() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonVariable101() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable101()
-}
-
-func (c *current) onVariable92() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonVariable92() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable92()
-}
-
-func (c *current) onVariable124() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonVariable124() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable124()
-}
-
-func (c *current) onVariable114() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonVariable114() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable114()
-}
-
-func (c *current) onVariable89(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonVariable89() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable89(stack["label"])
-}
-
-func (c *current) onVariable5(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonVariable5() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable5(stack["label"])
-}
-
-func (c *current) onVariable142() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonVariable142() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable142()
-}
-
-func (c *current) onVariable144() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonVariable144() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable144()
-}
-
-func (c *current) onVariable146() (interface{}, error) {
-	return NaturalIsZero, nil
-}
-
-func (p *parser) callonVariable146() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable146()
-}
-
-func (c *current) onVariable148() (interface{}, error) {
-	return NaturalEven, nil
-}
-
-func (p *parser) callonVariable148() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable148()
-}
-
-func (c *current) onVariable150() (interface{}, error) {
-	return NaturalOdd, nil
-}
-
-func (p *parser) callonVariable150() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable150()
-}
-
-func (c *current) onVariable152() (interface{}, error) {
-	return NaturalToInteger, nil
-}
-
-func (p *parser) callonVariable152() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable152()
-}
-
-func (c *current) onVariable154() (interface{}, error) {
-	return NaturalShow, nil
-}
-
-func (p *parser) callonVariable154() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable154()
-}
-
-func (c *current) onVariable156() (interface{}, error) {
-	return NaturalSubtract, nil
-}
-
-func (p *parser) callonVariable156() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable156()
-}
-
-func (c *current) onVariable158() (interface{}, error) {
-	return IntegerToDouble, nil
-}
-
-func (p *parser) callonVariable158() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable158()
-}
-
-func (c *current) onVariable160() (interface{}, error) {
-	return IntegerShow, nil
-}
-
-func (p *parser) callonVariable160() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable160()
-}
-
-func (c *current) onVariable162() (interface{}, error) {
-	return DoubleShow, nil
-}
-
-func (p *parser) callonVariable162() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable162()
-}
-
-func (c *current) onVariable164() (interface{}, error) {
-	return ListBuild, nil
-}
-
-func (p *parser) callonVariable164() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable164()
-}
-
-func (c *current) onVariable166() (interface{}, error) {
-	return ListFold, nil
-}
-
-func (p *parser) callonVariable166() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable166()
-}
-
-func (c *current) onVariable168() (interface{}, error) {
-	return ListLength, nil
-}
-
-func (p *parser) callonVariable168() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable168()
-}
-
-func (c *current) onVariable170() (interface{}, error) {
-	return ListHead, nil
-}
-
-func (p *parser) callonVariable170() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable170()
-}
-
-func (c *current) onVariable172() (interface{}, error) {
-	return ListLast, nil
-}
-
-func (p *parser) callonVariable172() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable172()
-}
-
-func (c *current) onVariable174() (interface{}, error) {
-	return ListIndexed, nil
-}
-
-func (p *parser) callonVariable174() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable174()
-}
-
-func (c *current) onVariable176() (interface{}, error) {
-	return ListReverse, nil
-}
-
-func (p *parser) callonVariable176() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable176()
-}
-
-func (c *current) onVariable178() (interface{}, error) {
-	return OptionalBuild, nil
-}
-
-func (p *parser) callonVariable178() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable178()
-}
-
-func (c *current) onVariable180() (interface{}, error) {
-	return OptionalFold, nil
-}
-
-func (p *parser) callonVariable180() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable180()
-}
-
-func (c *current) onVariable182() (interface{}, error) {
-	return TextShow, nil
-}
-
-func (p *parser) callonVariable182() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable182()
-}
-
-func (c *current) onVariable184() (interface{}, error) {
-	return Bool, nil
-}
-
-func (p *parser) callonVariable184() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable184()
-}
-
-func (c *current) onVariable186() (interface{}, error) {
-	return True, nil
-}
-
-func (p *parser) callonVariable186() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable186()
-}
-
-func (c *current) onVariable188() (interface{}, error) {
-	return False, nil
-}
-
-func (p *parser) callonVariable188() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable188()
-}
-
-func (c *current) onVariable190() (interface{}, error) {
-	return Optional, nil
-}
-
-func (p *parser) callonVariable190() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable190()
-}
-
-func (c *current) onVariable192() (interface{}, error) {
-	return Natural, nil
-}
-
-func (p *parser) callonVariable192() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable192()
-}
-
-func (c *current) onVariable194() (interface{}, error) {
-	return Integer, nil
-}
-
-func (p *parser) callonVariable194() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable194()
-}
-
-func (c *current) onVariable196() (interface{}, error) {
-	return Double, nil
-}
-
-func (p *parser) callonVariable196() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable196()
-}
-
-func (c *current) onVariable198() (interface{}, error) {
-	return Text, nil
-}
-
-func (p *parser) callonVariable198() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable198()
-}
-
-func (c *current) onVariable200() (interface{}, error) {
-	return List, nil
-}
-
-func (p *parser) callonVariable200() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable200()
-}
-
-func (c *current) onVariable202() (interface{}, error) {
-	return None, nil
-}
-
-func (p *parser) callonVariable202() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable202()
-}
-
-func (c *current) onVariable204() (interface{}, error) {
-	return Type, nil
-}
-
-func (p *parser) callonVariable204() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable204()
-}
-
-func (c *current) onVariable206() (interface{}, error) {
-	return Kind, nil
-}
-
-func (p *parser) callonVariable206() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable206()
-}
-
-func (c *current) onVariable208() (interface{}, error) {
-	return Sort, nil
-}
-
-func (p *parser) callonVariable208() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable208()
-}
-
-func (c *current) onVariable216() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonVariable216() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable216()
-}
-
-func (c *current) onVariable212(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonVariable212() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable212(stack["label"])
-}
-
-func (c *current) onVariable232() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonVariable232() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable232()
-}
-
-func (c *current) onVariable223() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonVariable223() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable223()
-}
-
-func (c *current) onVariable255() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonVariable255() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable255()
-}
-
-func (c *current) onVariable245() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonVariable245() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable245()
-}
-
-func (c *current) onVariable220(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonVariable220() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable220(stack["label"])
-}
-
-func (c *current) onVariable138(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonVariable138() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable138(stack["label"])
-}
-
-func (c *current) onVariable1(name, index interface{}) (interface{}, error) {
-	if index != nil {
-		return Var{Name: name.(string), Index: index.(int)}, nil
-	} else {
-		return Var{Name: name.(string)}, nil
-	}
-}
-
-func (p *parser) callonVariable1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onVariable1(stack["name"], stack["index"])
-}
-
-func (c *current) onIdentifier3() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonIdentifier3() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier3()
-}
-
-func (c *current) onIdentifier5() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonIdentifier5() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier5()
-}
-
-func (c *current) onIdentifier7() (interface{}, error) {
-	return NaturalIsZero, nil
-}
-
-func (p *parser) callonIdentifier7() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier7()
-}
-
-func (c *current) onIdentifier9() (interface{}, error) {
-	return NaturalEven, nil
-}
-
-func (p *parser) callonIdentifier9() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier9()
-}
-
-func (c *current) onIdentifier11() (interface{}, error) {
-	return NaturalOdd, nil
-}
-
-func (p *parser) callonIdentifier11() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier11()
-}
-
-func (c *current) onIdentifier13() (interface{}, error) {
-	return NaturalToInteger, nil
-}
-
-func (p *parser) callonIdentifier13() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier13()
-}
-
-func (c *current) onIdentifier15() (interface{}, error) {
-	return NaturalShow, nil
-}
-
-func (p *parser) callonIdentifier15() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier15()
-}
-
-func (c *current) onIdentifier17() (interface{}, error) {
-	return NaturalSubtract, nil
-}
-
-func (p *parser) callonIdentifier17() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier17()
-}
-
-func (c *current) onIdentifier19() (interface{}, error) {
-	return IntegerToDouble, nil
-}
-
-func (p *parser) callonIdentifier19() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier19()
-}
-
-func (c *current) onIdentifier21() (interface{}, error) {
-	return IntegerShow, nil
-}
-
-func (p *parser) callonIdentifier21() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier21()
-}
-
-func (c *current) onIdentifier23() (interface{}, error) {
-	return DoubleShow, nil
-}
-
-func (p *parser) callonIdentifier23() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier23()
-}
-
-func (c *current) onIdentifier25() (interface{}, error) {
-	return ListBuild, nil
-}
-
-func (p *parser) callonIdentifier25() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier25()
-}
-
-func (c *current) onIdentifier27() (interface{}, error) {
-	return ListFold, nil
-}
-
-func (p *parser) callonIdentifier27() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier27()
-}
-
-func (c *current) onIdentifier29() (interface{}, error) {
-	return ListLength, nil
-}
-
-func (p *parser) callonIdentifier29() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier29()
-}
-
-func (c *current) onIdentifier31() (interface{}, error) {
-	return ListHead, nil
-}
-
-func (p *parser) callonIdentifier31() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier31()
-}
-
-func (c *current) onIdentifier33() (interface{}, error) {
-	return ListLast, nil
-}
-
-func (p *parser) callonIdentifier33() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier33()
-}
-
-func (c *current) onIdentifier35() (interface{}, error) {
-	return ListIndexed, nil
-}
-
-func (p *parser) callonIdentifier35() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier35()
-}
-
-func (c *current) onIdentifier37() (interface{}, error) {
-	return ListReverse, nil
-}
-
-func (p *parser) callonIdentifier37() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier37()
-}
-
-func (c *current) onIdentifier39() (interface{}, error) {
-	return OptionalBuild, nil
-}
-
-func (p *parser) callonIdentifier39() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier39()
-}
-
-func (c *current) onIdentifier41() (interface{}, error) {
-	return OptionalFold, nil
-}
-
-func (p *parser) callonIdentifier41() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier41()
-}
-
-func (c *current) onIdentifier43() (interface{}, error) {
-	return TextShow, nil
-}
-
-func (p *parser) callonIdentifier43() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier43()
-}
-
-func (c *current) onIdentifier45() (interface{}, error) {
-	return Bool, nil
-}
-
-func (p *parser) callonIdentifier45() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier45()
-}
-
-func (c *current) onIdentifier47() (interface{}, error) {
-	return True, nil
-}
-
-func (p *parser) callonIdentifier47() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier47()
-}
-
-func (c *current) onIdentifier49() (interface{}, error) {
-	return False, nil
-}
-
-func (p *parser) callonIdentifier49() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier49()
-}
-
-func (c *current) onIdentifier51() (interface{}, error) {
-	return Optional, nil
-}
-
-func (p *parser) callonIdentifier51() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier51()
-}
-
-func (c *current) onIdentifier53() (interface{}, error) {
-	return Natural, nil
-}
-
-func (p *parser) callonIdentifier53() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier53()
-}
-
-func (c *current) onIdentifier55() (interface{}, error) {
-	return Integer, nil
-}
-
-func (p *parser) callonIdentifier55() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier55()
-}
-
-func (c *current) onIdentifier57() (interface{}, error) {
-	return Double, nil
-}
-
-func (p *parser) callonIdentifier57() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier57()
-}
-
-func (c *current) onIdentifier59() (interface{}, error) {
-	return Text, nil
-}
-
-func (p *parser) callonIdentifier59() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier59()
-}
-
-func (c *current) onIdentifier61() (interface{}, error) {
-	return List, nil
-}
-
-func (p *parser) callonIdentifier61() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier61()
-}
-
-func (c *current) onIdentifier63() (interface{}, error) {
-	return None, nil
-}
-
-func (p *parser) callonIdentifier63() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier63()
-}
-
-func (c *current) onIdentifier65() (interface{}, error) {
-	return Type, nil
-}
-
-func (p *parser) callonIdentifier65() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier65()
-}
-
-func (c *current) onIdentifier67() (interface{}, error) {
-	return Kind, nil
-}
-
-func (p *parser) callonIdentifier67() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier67()
-}
-
-func (c *current) onIdentifier69() (interface{}, error) {
-	return Sort, nil
-}
-
-func (p *parser) callonIdentifier69() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier69()
-}
-
-func (c *current) onHttp28() (interface{}, error) {
-	addr := net.ParseIP(string(c.text))
-	if addr == nil {
-		return nil, errors.New("Malformed IPv6 address")
-	}
-	return string(c.text), nil
-}
-
-func (p *parser) callonHttp28() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onHttp28()
-}
-
-func (c *current) onHttp64() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonHttp64() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onHttp64()
-}
-
-func (c *current) onHttp60(u interface{}) (interface{}, error) {
-	return u, nil
-}
-
-func (p *parser) callonHttp60() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onHttp60(stack["u"])
-}
-
-func (c *current) onHttp71() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonHttp71() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onHttp71()
-}
-
-func (c *current) onHttp67(q interface{}) (interface{}, error) {
-	return q, nil
-}
-
-func (p *parser) callonHttp67() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onHttp67(stack["q"])
-}
-
-func (c *current) onHttp4() (interface{}, error) {
-	return url.ParseRequestURI(string(c.text))
-}
-
-func (p *parser) callonHttp4() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onHttp4()
-}
-
-func (c *current) onHttp1(u, usingClause interface{}) (interface{}, error) {
-	if usingClause != nil {
-		return NewRemote(u.(*url.URL)), errors.New("dhall-golang does not support ❰using❱ clauses")
-	}
-	return NewRemote(u.(*url.URL)), nil
-}
-
-func (p *parser) callonHttp1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onHttp1(stack["u"], stack["usingClause"])
-}
-
-func (c *current) onImportType2() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonImportType2() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType2()
-}
-
-func (c *current) onImportType16() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonImportType16() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType16()
-}
-
-func (c *current) onImportType12(u interface{}) (interface{}, error) {
-	return u, nil
-}
-
-func (p *parser) callonImportType12() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType12(stack["u"])
-}
-
-func (c *current) onImportType23() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonImportType23() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType23()
-}
-
-func (c *current) onImportType19(q interface{}) (interface{}, error) {
-	return q, nil
-}
-
-func (p *parser) callonImportType19() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType19(stack["q"])
-}
-
-func (c *current) onImportType8(cs interface{}) (interface{}, error) {
-	// urgh, have to convert []interface{} to []string
-	components := make([]string, len(cs.([]interface{})))
-	for i, component := range cs.([]interface{}) {
-		components[i] = component.(string)
-	}
-	return path.Join(components...), nil
-}
-
-func (p *parser) callonImportType8() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType8(stack["cs"])
-}
-
-func (c *current) onImportType4(p interface{}) (interface{}, error) {
-	return Local(path.Join("..", p.(string))), nil
-}
-
-func (p *parser) callonImportType4() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType4(stack["p"])
-}
-
-func (c *current) onImportType39() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonImportType39() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType39()
-}
-
-func (c *current) onImportType35(u interface{}) (interface{}, error) {
-	return u, nil
-}
-
-func (p *parser) callonImportType35() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType35(stack["u"])
-}
-
-func (c *current) onImportType46() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonImportType46() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType46()
-}
-
-func (c *current) onImportType42(q interface{}) (interface{}, error) {
-	return q, nil
-}
-
-func (p *parser) callonImportType42() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType42(stack["q"])
-}
-
-func (c *current) onImportType31(cs interface{}) (interface{}, error) {
-	// urgh, have to convert []interface{} to []string
-	components := make([]string, len(cs.([]interface{})))
-	for i, component := range cs.([]interface{}) {
-		components[i] = component.(string)
-	}
-	return path.Join(components...), nil
-}
-
-func (p *parser) callonImportType31() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType31(stack["cs"])
-}
-
-func (c *current) onImportType27(p interface{}) (interface{}, error) {
-	return Local(p.(string)), nil
-}
-
-func (p *parser) callonImportType27() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType27(stack["p"])
-}
-
-func (c *current) onImportType62() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonImportType62() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType62()
-}
-
-func (c *current) onImportType58(u interface{}) (interface{}, error) {
-	return u, nil
-}
-
-func (p *parser) callonImportType58() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType58(stack["u"])
-}
-
-func (c *current) onImportType69() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonImportType69() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType69()
-}
-
-func (c *current) onImportType65(q interface{}) (interface{}, error) {
-	return q, nil
-}
-
-func (p *parser) callonImportType65() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType65(stack["q"])
-}
-
-func (c *current) onImportType54(cs interface{}) (interface{}, error) {
-	// urgh, have to convert []interface{} to []string
-	components := make([]string, len(cs.([]interface{})))
-	for i, component := range cs.([]interface{}) {
-		components[i] = component.(string)
-	}
-	return path.Join(components...), nil
-}
-
-func (p *parser) callonImportType54() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType54(stack["cs"])
-}
-
-func (c *current) onImportType50(p interface{}) (interface{}, error) {
-	return Local(path.Join("~", p.(string))), nil
-}
-
-func (p *parser) callonImportType50() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType50(stack["p"])
-}
-
-func (c *current) onImportType83() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonImportType83() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType83()
-}
-
-func (c *current) onImportType79(u interface{}) (interface{}, error) {
-	return u, nil
-}
-
-func (p *parser) callonImportType79() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType79(stack["u"])
-}
-
-func (c *current) onImportType90() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonImportType90() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType90()
-}
-
-func (c *current) onImportType86(q interface{}) (interface{}, error) {
-	return q, nil
-}
-
-func (p *parser) callonImportType86() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType86(stack["q"])
-}
-
-func (c *current) onImportType75(cs interface{}) (interface{}, error) {
-	// urgh, have to convert []interface{} to []string
-	components := make([]string, len(cs.([]interface{})))
-	for i, component := range cs.([]interface{}) {
-		components[i] = component.(string)
-	}
-	return path.Join(components...), nil
-}
-
-func (p *parser) callonImportType75() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType75(stack["cs"])
-}
-
-func (c *current) onImportType73(p interface{}) (interface{}, error) {
-	return Local(path.Join("/", p.(string))), nil
-}
-
-func (p *parser) callonImportType73() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType73(stack["p"])
-}
-
-func (c *current) onImportType100() (interface{}, error) {
-	return EnvVar(string(c.text)), nil
-}
-
-func (p *parser) callonImportType100() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType100()
-}
-
-func (c *current) onImportType113() (interface{}, error) {
-	return []byte{0x22}, nil
-}
-
-func (p *parser) callonImportType113() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType113()
-}
-
-func (c *current) onImportType115() (interface{}, error) {
-	return []byte{0x5c}, nil
-}
-
-func (p *parser) callonImportType115() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType115()
-}
-
-func (c *current) onImportType117() (interface{}, error) {
-	return []byte{0x07}, nil
-}
-
-func (p *parser) callonImportType117() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType117()
-}
-
-func (c *current) onImportType119() (interface{}, error) {
-	return []byte{0x08}, nil
-}
-
-func (p *parser) callonImportType119() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType119()
-}
-
-func (c *current) onImportType121() (interface{}, error) {
-	return []byte{0x0c}, nil
-}
-
-func (p *parser) callonImportType121() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType121()
-}
-
-func (c *current) onImportType123() (interface{}, error) {
-	return []byte{0x0a}, nil
-}
-
-func (p *parser) callonImportType123() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType123()
-}
-
-func (c *current) onImportType125() (interface{}, error) {
-	return []byte{0x0d}, nil
-}
-
-func (p *parser) callonImportType125() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType125()
-}
-
-func (c *current) onImportType127() (interface{}, error) {
-	return []byte{0x09}, nil
-}
-
-func (p *parser) callonImportType127() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType127()
-}
-
-func (c *current) onImportType129() (interface{}, error) {
-	return []byte{0x0b}, nil
-}
-
-func (p *parser) callonImportType129() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType129()
-}
-
-func (c *current) onImportType109(v interface{}) (interface{}, error) {
-	var b strings.Builder
-	for _, c := range v.([]interface{}) {
-		_, err := b.Write(c.([]byte))
-		if err != nil {
-			return nil, err
-		}
-	}
-	return EnvVar(b.String()), nil
-}
-
-func (p *parser) callonImportType109() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType109(stack["v"])
-}
-
-func (c *current) onImportType105(v interface{}) (interface{}, error) {
-	return v, nil
-}
-
-func (p *parser) callonImportType105() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType105(stack["v"])
-}
-
-func (c *current) onImportType95(v interface{}) (interface{}, error) {
-	return v, nil
-}
-
-func (p *parser) callonImportType95() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportType95(stack["v"])
-}
-
-func (c *current) onImportHashed13() (interface{}, error) {
-	out := make([]byte, sha256.Size)
-	_, err := hex.Decode(out, c.text)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (p *parser) callonImportHashed13() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportHashed13()
-}
-
-func (c *current) onImportHashed9(val interface{}) (interface{}, error) {
-	return append([]byte{0x12, 0x20}, val.([]byte)...), nil
-}
-
-func (p *parser) callonImportHashed9() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportHashed9(stack["val"])
-}
-
-func (c *current) onImportHashed1(i, h interface{}) (interface{}, error) {
-	out := ImportHashed{Fetchable: i.(Fetchable)}
-	if h != nil {
-		out.Hash = h.([]interface{})[1].([]byte)
-	}
-	return out, nil
-}
-
-func (p *parser) callonImportHashed1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportHashed1(stack["i"], stack["h"])
-}
-
-func (c *current) onImport2(i interface{}) (interface{}, error) {
-	return Import{ImportHashed: i.(ImportHashed), ImportMode: RawText}, nil
-}
-
-func (p *parser) callonImport2() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImport2(stack["i"])
-}
-
-func (c *current) onImport10(i interface{}) (interface{}, error) {
-	return Import{ImportHashed: i.(ImportHashed), ImportMode: Location}, nil
-}
-
-func (p *parser) callonImport10() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImport10(stack["i"])
-}
-
-func (c *current) onImport18(i interface{}) (interface{}, error) {
-	return Import{ImportHashed: i.(ImportHashed), ImportMode: Code}, nil
-}
-
-func (p *parser) callonImport18() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImport18(stack["i"])
-}
-
-func (c *current) onLetBinding12() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonLetBinding12() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding12()
-}
-
-func (c *current) onLetBinding14() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonLetBinding14() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding14()
-}
-
-func (c *current) onLetBinding16() (interface{}, error) {
-	return NaturalIsZero, nil
-}
-
-func (p *parser) callonLetBinding16() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding16()
-}
-
-func (c *current) onLetBinding18() (interface{}, error) {
-	return NaturalEven, nil
-}
-
-func (p *parser) callonLetBinding18() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding18()
-}
-
-func (c *current) onLetBinding20() (interface{}, error) {
-	return NaturalOdd, nil
-}
-
-func (p *parser) callonLetBinding20() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding20()
-}
-
-func (c *current) onLetBinding22() (interface{}, error) {
-	return NaturalToInteger, nil
-}
-
-func (p *parser) callonLetBinding22() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding22()
-}
-
-func (c *current) onLetBinding24() (interface{}, error) {
-	return NaturalShow, nil
-}
-
-func (p *parser) callonLetBinding24() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding24()
-}
-
-func (c *current) onLetBinding26() (interface{}, error) {
-	return NaturalSubtract, nil
-}
-
-func (p *parser) callonLetBinding26() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding26()
-}
-
-func (c *current) onLetBinding28() (interface{}, error) {
-	return IntegerToDouble, nil
-}
-
-func (p *parser) callonLetBinding28() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding28()
-}
-
-func (c *current) onLetBinding30() (interface{}, error) {
-	return IntegerShow, nil
-}
-
-func (p *parser) callonLetBinding30() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding30()
-}
-
-func (c *current) onLetBinding32() (interface{}, error) {
-	return DoubleShow, nil
-}
-
-func (p *parser) callonLetBinding32() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding32()
-}
-
-func (c *current) onLetBinding34() (interface{}, error) {
-	return ListBuild, nil
-}
-
-func (p *parser) callonLetBinding34() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding34()
-}
-
-func (c *current) onLetBinding36() (interface{}, error) {
-	return ListFold, nil
-}
-
-func (p *parser) callonLetBinding36() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding36()
-}
-
-func (c *current) onLetBinding38() (interface{}, error) {
-	return ListLength, nil
-}
-
-func (p *parser) callonLetBinding38() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding38()
-}
-
-func (c *current) onLetBinding40() (interface{}, error) {
-	return ListHead, nil
-}
-
-func (p *parser) callonLetBinding40() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding40()
-}
-
-func (c *current) onLetBinding42() (interface{}, error) {
-	return ListLast, nil
-}
-
-func (p *parser) callonLetBinding42() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding42()
-}
-
-func (c *current) onLetBinding44() (interface{}, error) {
-	return ListIndexed, nil
-}
-
-func (p *parser) callonLetBinding44() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding44()
-}
-
-func (c *current) onLetBinding46() (interface{}, error) {
-	return ListReverse, nil
-}
-
-func (p *parser) callonLetBinding46() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding46()
-}
-
-func (c *current) onLetBinding48() (interface{}, error) {
-	return OptionalBuild, nil
-}
-
-func (p *parser) callonLetBinding48() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding48()
-}
-
-func (c *current) onLetBinding50() (interface{}, error) {
-	return OptionalFold, nil
-}
-
-func (p *parser) callonLetBinding50() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding50()
-}
-
-func (c *current) onLetBinding52() (interface{}, error) {
-	return TextShow, nil
-}
-
-func (p *parser) callonLetBinding52() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding52()
-}
-
-func (c *current) onLetBinding54() (interface{}, error) {
-	return Bool, nil
-}
-
-func (p *parser) callonLetBinding54() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding54()
-}
-
-func (c *current) onLetBinding56() (interface{}, error) {
-	return True, nil
-}
-
-func (p *parser) callonLetBinding56() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding56()
-}
-
-func (c *current) onLetBinding58() (interface{}, error) {
-	return False, nil
-}
-
-func (p *parser) callonLetBinding58() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding58()
-}
-
-func (c *current) onLetBinding60() (interface{}, error) {
-	return Optional, nil
-}
-
-func (p *parser) callonLetBinding60() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding60()
-}
-
-func (c *current) onLetBinding62() (interface{}, error) {
-	return Natural, nil
-}
-
-func (p *parser) callonLetBinding62() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding62()
-}
-
-func (c *current) onLetBinding64() (interface{}, error) {
-	return Integer, nil
-}
-
-func (p *parser) callonLetBinding64() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding64()
-}
-
-func (c *current) onLetBinding66() (interface{}, error) {
-	return Double, nil
-}
-
-func (p *parser) callonLetBinding66() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding66()
-}
-
-func (c *current) onLetBinding68() (interface{}, error) {
-	return Text, nil
-}
-
-func (p *parser) callonLetBinding68() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding68()
-}
-
-func (c *current) onLetBinding70() (interface{}, error) {
-	return List, nil
-}
-
-func (p *parser) callonLetBinding70() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding70()
-}
-
-func (c *current) onLetBinding72() (interface{}, error) {
-	return None, nil
-}
-
-func (p *parser) callonLetBinding72() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding72()
-}
-
-func (c *current) onLetBinding74() (interface{}, error) {
-	return Type, nil
-}
-
-func (p *parser) callonLetBinding74() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding74()
-}
-
-func (c *current) onLetBinding76() (interface{}, error) {
-	return Kind, nil
-}
-
-func (p *parser) callonLetBinding76() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding76()
-}
-
-func (c *current) onLetBinding78() (interface{}, error) {
-	return Sort, nil
-}
-
-func (p *parser) callonLetBinding78() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding78()
-}
-
-func (c *current) onLetBinding87() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonLetBinding87() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding87()
-}
-
-func (c *current) onLetBinding83(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLetBinding83() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding83(stack["label"])
-}
-
-func (c *current) onLetBinding103() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonLetBinding103() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding103()
-}
-
-func (c *current) onLetBinding94() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonLetBinding94() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding94()
-}
-
-func (c *current) onLetBinding126() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonLetBinding126() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding126()
-}
-
-func (c *current) onLetBinding116() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonLetBinding116() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding116()
-}
-
-func (c *current) onLetBinding91(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLetBinding91() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding91(stack["label"])
-}
-
-func (c *current) onLetBinding7(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLetBinding7() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding7(stack["label"])
-}
-
-func (c *current) onLetBinding144() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonLetBinding144() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding144()
-}
-
-func (c *current) onLetBinding146() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonLetBinding146() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding146()
-}
-
-func (c *current) onLetBinding148() (interface{}, error) {
-	return NaturalIsZero, nil
-}
-
-func (p *parser) callonLetBinding148() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding148()
-}
-
-func (c *current) onLetBinding150() (interface{}, error) {
-	return NaturalEven, nil
-}
-
-func (p *parser) callonLetBinding150() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding150()
-}
-
-func (c *current) onLetBinding152() (interface{}, error) {
-	return NaturalOdd, nil
-}
-
-func (p *parser) callonLetBinding152() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding152()
-}
-
-func (c *current) onLetBinding154() (interface{}, error) {
-	return NaturalToInteger, nil
-}
-
-func (p *parser) callonLetBinding154() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding154()
-}
-
-func (c *current) onLetBinding156() (interface{}, error) {
-	return NaturalShow, nil
-}
-
-func (p *parser) callonLetBinding156() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding156()
-}
-
-func (c *current) onLetBinding158() (interface{}, error) {
-	return NaturalSubtract, nil
-}
-
-func (p *parser) callonLetBinding158() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding158()
-}
-
-func (c *current) onLetBinding160() (interface{}, error) {
-	return IntegerToDouble, nil
-}
-
-func (p *parser) callonLetBinding160() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding160()
-}
-
-func (c *current) onLetBinding162() (interface{}, error) {
-	return IntegerShow, nil
-}
-
-func (p *parser) callonLetBinding162() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding162()
-}
-
-func (c *current) onLetBinding164() (interface{}, error) {
-	return DoubleShow, nil
-}
-
-func (p *parser) callonLetBinding164() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding164()
-}
-
-func (c *current) onLetBinding166() (interface{}, error) {
-	return ListBuild, nil
-}
-
-func (p *parser) callonLetBinding166() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding166()
-}
-
-func (c *current) onLetBinding168() (interface{}, error) {
-	return ListFold, nil
-}
-
-func (p *parser) callonLetBinding168() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding168()
-}
-
-func (c *current) onLetBinding170() (interface{}, error) {
-	return ListLength, nil
-}
-
-func (p *parser) callonLetBinding170() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding170()
-}
-
-func (c *current) onLetBinding172() (interface{}, error) {
-	return ListHead, nil
-}
-
-func (p *parser) callonLetBinding172() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding172()
-}
-
-func (c *current) onLetBinding174() (interface{}, error) {
-	return ListLast, nil
-}
-
-func (p *parser) callonLetBinding174() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding174()
-}
-
-func (c *current) onLetBinding176() (interface{}, error) {
-	return ListIndexed, nil
-}
-
-func (p *parser) callonLetBinding176() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding176()
-}
-
-func (c *current) onLetBinding178() (interface{}, error) {
-	return ListReverse, nil
-}
-
-func (p *parser) callonLetBinding178() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding178()
-}
-
-func (c *current) onLetBinding180() (interface{}, error) {
-	return OptionalBuild, nil
-}
-
-func (p *parser) callonLetBinding180() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding180()
-}
-
-func (c *current) onLetBinding182() (interface{}, error) {
-	return OptionalFold, nil
-}
-
-func (p *parser) callonLetBinding182() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding182()
-}
-
-func (c *current) onLetBinding184() (interface{}, error) {
-	return TextShow, nil
-}
-
-func (p *parser) callonLetBinding184() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding184()
-}
-
-func (c *current) onLetBinding186() (interface{}, error) {
-	return Bool, nil
-}
-
-func (p *parser) callonLetBinding186() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding186()
-}
-
-func (c *current) onLetBinding188() (interface{}, error) {
-	return True, nil
-}
-
-func (p *parser) callonLetBinding188() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding188()
-}
-
-func (c *current) onLetBinding190() (interface{}, error) {
-	return False, nil
-}
-
-func (p *parser) callonLetBinding190() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding190()
-}
-
-func (c *current) onLetBinding192() (interface{}, error) {
-	return Optional, nil
-}
-
-func (p *parser) callonLetBinding192() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding192()
-}
-
-func (c *current) onLetBinding194() (interface{}, error) {
-	return Natural, nil
-}
-
-func (p *parser) callonLetBinding194() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding194()
-}
-
-func (c *current) onLetBinding196() (interface{}, error) {
-	return Integer, nil
-}
-
-func (p *parser) callonLetBinding196() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding196()
-}
-
-func (c *current) onLetBinding198() (interface{}, error) {
-	return Double, nil
-}
-
-func (p *parser) callonLetBinding198() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding198()
-}
-
-func (c *current) onLetBinding200() (interface{}, error) {
-	return Text, nil
-}
-
-func (p *parser) callonLetBinding200() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding200()
-}
-
-func (c *current) onLetBinding202() (interface{}, error) {
-	return List, nil
-}
-
-func (p *parser) callonLetBinding202() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding202()
-}
-
-func (c *current) onLetBinding204() (interface{}, error) {
-	return None, nil
-}
-
-func (p *parser) callonLetBinding204() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding204()
-}
-
-func (c *current) onLetBinding206() (interface{}, error) {
-	return Type, nil
-}
-
-func (p *parser) callonLetBinding206() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding206()
-}
-
-func (c *current) onLetBinding208() (interface{}, error) {
-	return Kind, nil
-}
-
-func (p *parser) callonLetBinding208() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding208()
-}
-
-func (c *current) onLetBinding210() (interface{}, error) {
-	return Sort, nil
-}
-
-func (p *parser) callonLetBinding210() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding210()
-}
-
-func (c *current) onLetBinding218() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonLetBinding218() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding218()
-}
-
-func (c *current) onLetBinding214(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLetBinding214() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding214(stack["label"])
-}
-
-func (c *current) onLetBinding234() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonLetBinding234() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding234()
-}
-
-func (c *current) onLetBinding225() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonLetBinding225() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding225()
-}
-
-func (c *current) onLetBinding257() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonLetBinding257() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding257()
-}
-
-func (c *current) onLetBinding247() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonLetBinding247() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding247()
-}
-
-func (c *current) onLetBinding222(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLetBinding222() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding222(stack["label"])
-}
-
-func (c *current) onLetBinding140(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLetBinding140() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding140(stack["label"])
-}
-
-func (c *current) onLetBinding1(label, a, v interface{}) (interface{}, error) {
-	if a != nil {
-		return Binding{
-			Variable:   label.(string),
-			Annotation: a.([]interface{})[0].(Term),
-			Value:      v.(Term),
-		}, nil
-	} else {
-		return Binding{
-			Variable: label.(string),
-			Value:    v.(Term),
-		}, nil
-	}
-}
-
-func (p *parser) callonLetBinding1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLetBinding1(stack["label"], stack["a"], stack["v"])
-}
-
-func (c *current) onExpression15() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonExpression15() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression15()
-}
-
-func (c *current) onExpression17() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonExpression17() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression17()
-}
-
-func (c *current) onExpression19() (interface{}, error) {
-	return NaturalIsZero, nil
-}
-
-func (p *parser) callonExpression19() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression19()
-}
-
-func (c *current) onExpression21() (interface{}, error) {
-	return NaturalEven, nil
-}
-
-func (p *parser) callonExpression21() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression21()
-}
-
-func (c *current) onExpression23() (interface{}, error) {
-	return NaturalOdd, nil
-}
-
-func (p *parser) callonExpression23() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression23()
-}
-
-func (c *current) onExpression25() (interface{}, error) {
-	return NaturalToInteger, nil
-}
-
-func (p *parser) callonExpression25() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression25()
-}
-
-func (c *current) onExpression27() (interface{}, error) {
-	return NaturalShow, nil
-}
-
-func (p *parser) callonExpression27() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression27()
-}
-
-func (c *current) onExpression29() (interface{}, error) {
-	return NaturalSubtract, nil
-}
-
-func (p *parser) callonExpression29() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression29()
-}
-
-func (c *current) onExpression31() (interface{}, error) {
-	return IntegerToDouble, nil
-}
-
-func (p *parser) callonExpression31() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression31()
-}
-
-func (c *current) onExpression33() (interface{}, error) {
-	return IntegerShow, nil
-}
-
-func (p *parser) callonExpression33() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression33()
-}
-
-func (c *current) onExpression35() (interface{}, error) {
-	return DoubleShow, nil
-}
-
-func (p *parser) callonExpression35() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression35()
-}
-
-func (c *current) onExpression37() (interface{}, error) {
-	return ListBuild, nil
-}
-
-func (p *parser) callonExpression37() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression37()
-}
-
-func (c *current) onExpression39() (interface{}, error) {
-	return ListFold, nil
-}
-
-func (p *parser) callonExpression39() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression39()
-}
-
-func (c *current) onExpression41() (interface{}, error) {
-	return ListLength, nil
-}
-
-func (p *parser) callonExpression41() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression41()
-}
-
-func (c *current) onExpression43() (interface{}, error) {
-	return ListHead, nil
-}
-
-func (p *parser) callonExpression43() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression43()
-}
-
-func (c *current) onExpression45() (interface{}, error) {
-	return ListLast, nil
-}
-
-func (p *parser) callonExpression45() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression45()
-}
-
-func (c *current) onExpression47() (interface{}, error) {
-	return ListIndexed, nil
-}
-
-func (p *parser) callonExpression47() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression47()
-}
-
-func (c *current) onExpression49() (interface{}, error) {
-	return ListReverse, nil
-}
-
-func (p *parser) callonExpression49() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression49()
-}
-
-func (c *current) onExpression51() (interface{}, error) {
-	return OptionalBuild, nil
-}
-
-func (p *parser) callonExpression51() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression51()
-}
-
-func (c *current) onExpression53() (interface{}, error) {
-	return OptionalFold, nil
-}
-
-func (p *parser) callonExpression53() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression53()
-}
-
-func (c *current) onExpression55() (interface{}, error) {
-	return TextShow, nil
-}
-
-func (p *parser) callonExpression55() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression55()
-}
-
-func (c *current) onExpression57() (interface{}, error) {
-	return Bool, nil
-}
-
-func (p *parser) callonExpression57() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression57()
-}
-
-func (c *current) onExpression59() (interface{}, error) {
-	return True, nil
-}
-
-func (p *parser) callonExpression59() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression59()
-}
-
-func (c *current) onExpression61() (interface{}, error) {
-	return False, nil
-}
-
-func (p *parser) callonExpression61() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression61()
-}
-
-func (c *current) onExpression63() (interface{}, error) {
-	return Optional, nil
-}
-
-func (p *parser) callonExpression63() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression63()
-}
-
-func (c *current) onExpression65() (interface{}, error) {
-	return Natural, nil
-}
-
-func (p *parser) callonExpression65() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression65()
-}
-
-func (c *current) onExpression67() (interface{}, error) {
-	return Integer, nil
-}
-
-func (p *parser) callonExpression67() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression67()
-}
-
-func (c *current) onExpression69() (interface{}, error) {
-	return Double, nil
-}
-
-func (p *parser) callonExpression69() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression69()
-}
-
-func (c *current) onExpression71() (interface{}, error) {
-	return Text, nil
-}
-
-func (p *parser) callonExpression71() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression71()
-}
-
-func (c *current) onExpression73() (interface{}, error) {
-	return List, nil
-}
-
-func (p *parser) callonExpression73() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression73()
-}
-
-func (c *current) onExpression75() (interface{}, error) {
-	return None, nil
-}
-
-func (p *parser) callonExpression75() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression75()
-}
-
-func (c *current) onExpression77() (interface{}, error) {
-	return Type, nil
-}
-
-func (p *parser) callonExpression77() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression77()
-}
-
-func (c *current) onExpression79() (interface{}, error) {
-	return Kind, nil
-}
-
-func (p *parser) callonExpression79() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression79()
-}
-
-func (c *current) onExpression81() (interface{}, error) {
-	return Sort, nil
-}
-
-func (p *parser) callonExpression81() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression81()
-}
-
-func (c *current) onExpression90() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonExpression90() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression90()
-}
-
-func (c *current) onExpression86(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression86() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression86(stack["label"])
-}
-
-func (c *current) onExpression106() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonExpression106() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression106()
-}
-
-func (c *current) onExpression97() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonExpression97() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression97()
-}
-
-func (c *current) onExpression129() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonExpression129() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression129()
-}
-
-func (c *current) onExpression119() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonExpression119() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression119()
-}
-
-func (c *current) onExpression94(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression94() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression94(stack["label"])
-}
-
-func (c *current) onExpression10(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression10() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression10(stack["label"])
-}
-
-func (c *current) onExpression147() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonExpression147() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression147()
-}
-
-func (c *current) onExpression149() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonExpression149() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression149()
-}
-
-func (c *current) onExpression151() (interface{}, error) {
-	return NaturalIsZero, nil
-}
-
-func (p *parser) callonExpression151() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression151()
-}
-
-func (c *current) onExpression153() (interface{}, error) {
-	return NaturalEven, nil
-}
-
-func (p *parser) callonExpression153() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression153()
-}
-
-func (c *current) onExpression155() (interface{}, error) {
-	return NaturalOdd, nil
-}
-
-func (p *parser) callonExpression155() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression155()
-}
-
-func (c *current) onExpression157() (interface{}, error) {
-	return NaturalToInteger, nil
-}
-
-func (p *parser) callonExpression157() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression157()
-}
-
-func (c *current) onExpression159() (interface{}, error) {
-	return NaturalShow, nil
-}
-
-func (p *parser) callonExpression159() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression159()
-}
-
-func (c *current) onExpression161() (interface{}, error) {
-	return NaturalSubtract, nil
-}
-
-func (p *parser) callonExpression161() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression161()
-}
-
-func (c *current) onExpression163() (interface{}, error) {
-	return IntegerToDouble, nil
-}
-
-func (p *parser) callonExpression163() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression163()
-}
-
-func (c *current) onExpression165() (interface{}, error) {
-	return IntegerShow, nil
-}
-
-func (p *parser) callonExpression165() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression165()
-}
-
-func (c *current) onExpression167() (interface{}, error) {
-	return DoubleShow, nil
-}
-
-func (p *parser) callonExpression167() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression167()
-}
-
-func (c *current) onExpression169() (interface{}, error) {
-	return ListBuild, nil
-}
-
-func (p *parser) callonExpression169() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression169()
-}
-
-func (c *current) onExpression171() (interface{}, error) {
-	return ListFold, nil
-}
-
-func (p *parser) callonExpression171() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression171()
-}
-
-func (c *current) onExpression173() (interface{}, error) {
-	return ListLength, nil
-}
-
-func (p *parser) callonExpression173() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression173()
-}
-
-func (c *current) onExpression175() (interface{}, error) {
-	return ListHead, nil
-}
-
-func (p *parser) callonExpression175() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression175()
-}
-
-func (c *current) onExpression177() (interface{}, error) {
-	return ListLast, nil
-}
-
-func (p *parser) callonExpression177() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression177()
-}
-
-func (c *current) onExpression179() (interface{}, error) {
-	return ListIndexed, nil
-}
-
-func (p *parser) callonExpression179() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression179()
-}
-
-func (c *current) onExpression181() (interface{}, error) {
-	return ListReverse, nil
-}
-
-func (p *parser) callonExpression181() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression181()
-}
-
-func (c *current) onExpression183() (interface{}, error) {
-	return OptionalBuild, nil
-}
-
-func (p *parser) callonExpression183() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression183()
-}
-
-func (c *current) onExpression185() (interface{}, error) {
-	return OptionalFold, nil
-}
-
-func (p *parser) callonExpression185() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression185()
-}
-
-func (c *current) onExpression187() (interface{}, error) {
-	return TextShow, nil
-}
-
-func (p *parser) callonExpression187() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression187()
-}
-
-func (c *current) onExpression189() (interface{}, error) {
-	return Bool, nil
-}
-
-func (p *parser) callonExpression189() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression189()
-}
-
-func (c *current) onExpression191() (interface{}, error) {
-	return True, nil
-}
-
-func (p *parser) callonExpression191() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression191()
-}
-
-func (c *current) onExpression193() (interface{}, error) {
-	return False, nil
-}
-
-func (p *parser) callonExpression193() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression193()
-}
-
-func (c *current) onExpression195() (interface{}, error) {
-	return Optional, nil
-}
-
-func (p *parser) callonExpression195() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression195()
-}
-
-func (c *current) onExpression197() (interface{}, error) {
-	return Natural, nil
-}
-
-func (p *parser) callonExpression197() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression197()
-}
-
-func (c *current) onExpression199() (interface{}, error) {
-	return Integer, nil
-}
-
-func (p *parser) callonExpression199() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression199()
-}
-
-func (c *current) onExpression201() (interface{}, error) {
-	return Double, nil
-}
-
-func (p *parser) callonExpression201() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression201()
-}
-
-func (c *current) onExpression203() (interface{}, error) {
-	return Text, nil
-}
-
-func (p *parser) callonExpression203() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression203()
-}
-
-func (c *current) onExpression205() (interface{}, error) {
-	return List, nil
-}
-
-func (p *parser) callonExpression205() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression205()
-}
-
-func (c *current) onExpression207() (interface{}, error) {
-	return None, nil
-}
-
-func (p *parser) callonExpression207() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression207()
-}
-
-func (c *current) onExpression209() (interface{}, error) {
-	return Type, nil
-}
-
-func (p *parser) callonExpression209() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression209()
-}
-
-func (c *current) onExpression211() (interface{}, error) {
-	return Kind, nil
-}
-
-func (p *parser) callonExpression211() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression211()
-}
-
-func (c *current) onExpression213() (interface{}, error) {
-	return Sort, nil
-}
-
-func (p *parser) callonExpression213() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression213()
-}
-
-func (c *current) onExpression221() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonExpression221() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression221()
-}
-
-func (c *current) onExpression217(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression217() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression217(stack["label"])
-}
-
-func (c *current) onExpression237() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonExpression237() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression237()
-}
-
-func (c *current) onExpression228() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonExpression228() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression228()
-}
-
-func (c *current) onExpression260() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonExpression260() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression260()
-}
-
-func (c *current) onExpression250() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonExpression250() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression250()
-}
-
-func (c *current) onExpression225(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression225() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression225(stack["label"])
-}
-
-func (c *current) onExpression143(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression143() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression143(stack["label"])
-}
-
-func (c *current) onExpression2(label, t, body interface{}) (interface{}, error) {
-	return LambdaTerm{Label: label.(string), Type: t.(Term), Body: body.(Term)}, nil
-
-}
-
-func (p *parser) callonExpression2() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression2(stack["label"], stack["t"], stack["body"])
-}
-
-func (c *current) onExpression288(cond, t, f interface{}) (interface{}, error) {
-	return IfTerm{cond.(Term), t.(Term), f.(Term)}, nil
-
-}
-
-func (p *parser) callonExpression288() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression288(stack["cond"], stack["t"], stack["f"])
-}
-
-func (c *current) onExpression304(bindings, b interface{}) (interface{}, error) {
-	bs := make([]Binding, len(bindings.([]interface{})))
-	for i, binding := range bindings.([]interface{}) {
-		bs[i] = binding.(Binding)
-	}
-	return NewLet(b.(Term), bs...), nil
-
-}
-
-func (p *parser) callonExpression304() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression304(stack["bindings"], stack["b"])
-}
-
-func (c *current) onExpression328() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonExpression328() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression328()
-}
-
-func (c *current) onExpression330() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonExpression330() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression330()
-}
-
-func (c *current) onExpression332() (interface{}, error) {
-	return NaturalIsZero, nil
-}
-
-func (p *parser) callonExpression332() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression332()
-}
-
-func (c *current) onExpression334() (interface{}, error) {
-	return NaturalEven, nil
-}
-
-func (p *parser) callonExpression334() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression334()
-}
-
-func (c *current) onExpression336() (interface{}, error) {
-	return NaturalOdd, nil
-}
-
-func (p *parser) callonExpression336() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression336()
-}
-
-func (c *current) onExpression338() (interface{}, error) {
-	return NaturalToInteger, nil
-}
-
-func (p *parser) callonExpression338() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression338()
-}
-
-func (c *current) onExpression340() (interface{}, error) {
-	return NaturalShow, nil
-}
-
-func (p *parser) callonExpression340() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression340()
-}
-
-func (c *current) onExpression342() (interface{}, error) {
-	return NaturalSubtract, nil
-}
-
-func (p *parser) callonExpression342() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression342()
-}
-
-func (c *current) onExpression344() (interface{}, error) {
-	return IntegerToDouble, nil
-}
-
-func (p *parser) callonExpression344() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression344()
-}
-
-func (c *current) onExpression346() (interface{}, error) {
-	return IntegerShow, nil
-}
-
-func (p *parser) callonExpression346() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression346()
-}
-
-func (c *current) onExpression348() (interface{}, error) {
-	return DoubleShow, nil
-}
-
-func (p *parser) callonExpression348() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression348()
-}
-
-func (c *current) onExpression350() (interface{}, error) {
-	return ListBuild, nil
-}
-
-func (p *parser) callonExpression350() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression350()
-}
-
-func (c *current) onExpression352() (interface{}, error) {
-	return ListFold, nil
-}
-
-func (p *parser) callonExpression352() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression352()
-}
-
-func (c *current) onExpression354() (interface{}, error) {
-	return ListLength, nil
-}
-
-func (p *parser) callonExpression354() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression354()
-}
-
-func (c *current) onExpression356() (interface{}, error) {
-	return ListHead, nil
-}
-
-func (p *parser) callonExpression356() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression356()
-}
-
-func (c *current) onExpression358() (interface{}, error) {
-	return ListLast, nil
-}
-
-func (p *parser) callonExpression358() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression358()
-}
-
-func (c *current) onExpression360() (interface{}, error) {
-	return ListIndexed, nil
-}
-
-func (p *parser) callonExpression360() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression360()
-}
-
-func (c *current) onExpression362() (interface{}, error) {
-	return ListReverse, nil
-}
-
-func (p *parser) callonExpression362() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression362()
-}
-
-func (c *current) onExpression364() (interface{}, error) {
-	return OptionalBuild, nil
-}
-
-func (p *parser) callonExpression364() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression364()
-}
-
-func (c *current) onExpression366() (interface{}, error) {
-	return OptionalFold, nil
-}
-
-func (p *parser) callonExpression366() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression366()
-}
-
-func (c *current) onExpression368() (interface{}, error) {
-	return TextShow, nil
-}
-
-func (p *parser) callonExpression368() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression368()
-}
-
-func (c *current) onExpression370() (interface{}, error) {
-	return Bool, nil
-}
-
-func (p *parser) callonExpression370() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression370()
-}
-
-func (c *current) onExpression372() (interface{}, error) {
-	return True, nil
-}
-
-func (p *parser) callonExpression372() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression372()
-}
-
-func (c *current) onExpression374() (interface{}, error) {
-	return False, nil
-}
-
-func (p *parser) callonExpression374() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression374()
-}
-
-func (c *current) onExpression376() (interface{}, error) {
-	return Optional, nil
-}
-
-func (p *parser) callonExpression376() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression376()
-}
-
-func (c *current) onExpression378() (interface{}, error) {
-	return Natural, nil
-}
-
-func (p *parser) callonExpression378() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression378()
-}
-
-func (c *current) onExpression380() (interface{}, error) {
-	return Integer, nil
-}
-
-func (p *parser) callonExpression380() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression380()
-}
-
-func (c *current) onExpression382() (interface{}, error) {
-	return Double, nil
-}
-
-func (p *parser) callonExpression382() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression382()
-}
-
-func (c *current) onExpression384() (interface{}, error) {
-	return Text, nil
-}
-
-func (p *parser) callonExpression384() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression384()
-}
-
-func (c *current) onExpression386() (interface{}, error) {
-	return List, nil
-}
-
-func (p *parser) callonExpression386() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression386()
-}
-
-func (c *current) onExpression388() (interface{}, error) {
-	return None, nil
-}
-
-func (p *parser) callonExpression388() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression388()
-}
-
-func (c *current) onExpression390() (interface{}, error) {
-	return Type, nil
-}
-
-func (p *parser) callonExpression390() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression390()
-}
-
-func (c *current) onExpression392() (interface{}, error) {
-	return Kind, nil
-}
-
-func (p *parser) callonExpression392() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression392()
-}
-
-func (c *current) onExpression394() (interface{}, error) {
-	return Sort, nil
-}
-
-func (p *parser) callonExpression394() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression394()
-}
-
-func (c *current) onExpression403() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonExpression403() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression403()
-}
-
-func (c *current) onExpression399(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression399() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression399(stack["label"])
-}
-
-func (c *current) onExpression419() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonExpression419() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression419()
-}
-
-func (c *current) onExpression410() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonExpression410() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression410()
-}
-
-func (c *current) onExpression442() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonExpression442() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression442()
-}
-
-func (c *current) onExpression432() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonExpression432() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression432()
-}
-
-func (c *current) onExpression407(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression407() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression407(stack["label"])
-}
-
-func (c *current) onExpression323(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression323() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression323(stack["label"])
-}
-
-func (c *current) onExpression460() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonExpression460() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression460()
-}
-
-func (c *current) onExpression462() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonExpression462() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression462()
-}
-
-func (c *current) onExpression464() (interface{}, error) {
-	return NaturalIsZero, nil
-}
-
-func (p *parser) callonExpression464() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression464()
-}
-
-func (c *current) onExpression466() (interface{}, error) {
-	return NaturalEven, nil
-}
-
-func (p *parser) callonExpression466() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression466()
-}
-
-func (c *current) onExpression468() (interface{}, error) {
-	return NaturalOdd, nil
-}
-
-func (p *parser) callonExpression468() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression468()
-}
-
-func (c *current) onExpression470() (interface{}, error) {
-	return NaturalToInteger, nil
-}
-
-func (p *parser) callonExpression470() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression470()
-}
-
-func (c *current) onExpression472() (interface{}, error) {
-	return NaturalShow, nil
-}
-
-func (p *parser) callonExpression472() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression472()
-}
-
-func (c *current) onExpression474() (interface{}, error) {
-	return NaturalSubtract, nil
-}
-
-func (p *parser) callonExpression474() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression474()
-}
-
-func (c *current) onExpression476() (interface{}, error) {
-	return IntegerToDouble, nil
-}
-
-func (p *parser) callonExpression476() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression476()
-}
-
-func (c *current) onExpression478() (interface{}, error) {
-	return IntegerShow, nil
-}
-
-func (p *parser) callonExpression478() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression478()
-}
-
-func (c *current) onExpression480() (interface{}, error) {
-	return DoubleShow, nil
-}
-
-func (p *parser) callonExpression480() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression480()
-}
-
-func (c *current) onExpression482() (interface{}, error) {
-	return ListBuild, nil
-}
-
-func (p *parser) callonExpression482() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression482()
-}
-
-func (c *current) onExpression484() (interface{}, error) {
-	return ListFold, nil
-}
-
-func (p *parser) callonExpression484() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression484()
-}
-
-func (c *current) onExpression486() (interface{}, error) {
-	return ListLength, nil
-}
-
-func (p *parser) callonExpression486() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression486()
-}
-
-func (c *current) onExpression488() (interface{}, error) {
-	return ListHead, nil
-}
-
-func (p *parser) callonExpression488() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression488()
-}
-
-func (c *current) onExpression490() (interface{}, error) {
-	return ListLast, nil
-}
-
-func (p *parser) callonExpression490() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression490()
-}
-
-func (c *current) onExpression492() (interface{}, error) {
-	return ListIndexed, nil
-}
-
-func (p *parser) callonExpression492() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression492()
-}
-
-func (c *current) onExpression494() (interface{}, error) {
-	return ListReverse, nil
-}
-
-func (p *parser) callonExpression494() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression494()
-}
-
-func (c *current) onExpression496() (interface{}, error) {
-	return OptionalBuild, nil
-}
-
-func (p *parser) callonExpression496() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression496()
-}
-
-func (c *current) onExpression498() (interface{}, error) {
-	return OptionalFold, nil
-}
-
-func (p *parser) callonExpression498() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression498()
-}
-
-func (c *current) onExpression500() (interface{}, error) {
-	return TextShow, nil
-}
-
-func (p *parser) callonExpression500() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression500()
-}
-
-func (c *current) onExpression502() (interface{}, error) {
-	return Bool, nil
-}
-
-func (p *parser) callonExpression502() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression502()
-}
-
-func (c *current) onExpression504() (interface{}, error) {
-	return True, nil
-}
-
-func (p *parser) callonExpression504() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression504()
-}
-
-func (c *current) onExpression506() (interface{}, error) {
-	return False, nil
-}
-
-func (p *parser) callonExpression506() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression506()
-}
-
-func (c *current) onExpression508() (interface{}, error) {
-	return Optional, nil
-}
-
-func (p *parser) callonExpression508() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression508()
-}
-
-func (c *current) onExpression510() (interface{}, error) {
-	return Natural, nil
-}
-
-func (p *parser) callonExpression510() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression510()
-}
-
-func (c *current) onExpression512() (interface{}, error) {
-	return Integer, nil
-}
-
-func (p *parser) callonExpression512() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression512()
-}
-
-func (c *current) onExpression514() (interface{}, error) {
-	return Double, nil
-}
-
-func (p *parser) callonExpression514() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression514()
-}
-
-func (c *current) onExpression516() (interface{}, error) {
-	return Text, nil
-}
-
-func (p *parser) callonExpression516() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression516()
-}
-
-func (c *current) onExpression518() (interface{}, error) {
-	return List, nil
-}
-
-func (p *parser) callonExpression518() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression518()
-}
-
-func (c *current) onExpression520() (interface{}, error) {
-	return None, nil
-}
-
-func (p *parser) callonExpression520() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression520()
-}
-
-func (c *current) onExpression522() (interface{}, error) {
-	return Type, nil
-}
-
-func (p *parser) callonExpression522() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression522()
-}
-
-func (c *current) onExpression524() (interface{}, error) {
-	return Kind, nil
-}
-
-func (p *parser) callonExpression524() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression524()
-}
-
-func (c *current) onExpression526() (interface{}, error) {
-	return Sort, nil
-}
-
-func (p *parser) callonExpression526() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression526()
-}
-
-func (c *current) onExpression534() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonExpression534() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression534()
-}
-
-func (c *current) onExpression530(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression530() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression530(stack["label"])
-}
-
-func (c *current) onExpression550() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonExpression550() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression550()
-}
-
-func (c *current) onExpression541() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonExpression541() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression541()
-}
-
-func (c *current) onExpression573() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonExpression573() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression573()
-}
-
-func (c *current) onExpression563() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonExpression563() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression563()
-}
-
-func (c *current) onExpression538(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression538() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression538(stack["label"])
-}
-
-func (c *current) onExpression456(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonExpression456() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression456(stack["label"])
-}
-
-func (c *current) onExpression313(label, t, body interface{}) (interface{}, error) {
-	return PiTerm{Label: label.(string), Type: t.(Term), Body: body.(Term)}, nil
-
-}
-
-func (p *parser) callonExpression313() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression313(stack["label"], stack["t"], stack["body"])
-}
-
-func (c *current) onExpression601(o, e interface{}) (interface{}, error) {
-	return NewAnonPi(o.(Term), e.(Term)), nil
-}
-
-func (p *parser) callonExpression601() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression601(stack["o"], stack["e"])
-}
-
-func (c *current) onExpression612(h, u, a interface{}) (interface{}, error) {
-	return Merge{Handler: h.(Term), Union: u.(Term), Annotation: a.(Term)}, nil
-
-}
-
-func (p *parser) callonExpression612() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression612(stack["h"], stack["u"], stack["a"])
-}
-
-func (c *current) onExpression627(e, t interface{}) (interface{}, error) {
-	return ToMap{e.(Term), t.(Term)}, nil
-}
-
-func (p *parser) callonExpression627() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression627(stack["e"], stack["t"])
-}
-
-func (c *current) onExpression638(a interface{}) (interface{}, error) {
-	return Assert{Annotation: a.(Term)}, nil
-}
-
-func (p *parser) callonExpression638() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression638(stack["a"])
-}
-
-func (c *current) onAnnotation1(a interface{}) (interface{}, error) {
-	return a, nil
-}
-
-func (p *parser) callonAnnotation1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onAnnotation1(stack["a"])
-}
-
-func (c *current) onAnnotatedExpression1(e, a interface{}) (interface{}, error) {
-	if a == nil {
-		return e, nil
-	}
-	return Annot{e.(Term), a.([]interface{})[1].(Term)}, nil
-
-}
-
-func (p *parser) callonAnnotatedExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onAnnotatedExpression1(stack["e"], stack["a"])
-}
-
-func (c *current) onEmptyList1(a interface{}) (interface{}, error) {
-	return EmptyList{a.(Term)}, nil
-}
-
-func (p *parser) callonEmptyList1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onEmptyList1(stack["a"])
-}
-
-func (c *current) onImportAltExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(ImportAltOp, first, rest), nil
-}
-
-func (p *parser) callonImportAltExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onImportAltExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onOrExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(OrOp, first, rest), nil
-}
-
-func (p *parser) callonOrExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onOrExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onPlusExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(PlusOp, first, rest), nil
-}
-
-func (p *parser) callonPlusExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPlusExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onTextAppendExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(TextAppendOp, first, rest), nil
-}
-
-func (p *parser) callonTextAppendExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onTextAppendExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onListAppendExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(ListAppendOp, first, rest), nil
-}
-
-func (p *parser) callonListAppendExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onListAppendExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onAndExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(AndOp, first, rest), nil
-}
-
-func (p *parser) callonAndExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onAndExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onCombineExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(RecordMergeOp, first, rest), nil
-}
-
-func (p *parser) callonCombineExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onCombineExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onPreferExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(RightBiasedRecordMergeOp, first, rest), nil
-}
-
-func (p *parser) callonPreferExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPreferExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onCombineTypesExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(RecordTypeMergeOp, first, rest), nil
-}
-
-func (p *parser) callonCombineTypesExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onCombineTypesExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onTimesExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(TimesOp, first, rest), nil
-}
-
-func (p *parser) callonTimesExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onTimesExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onEqualExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(EqOp, first, rest), nil
-}
-
-func (p *parser) callonEqualExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onEqualExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onNotEqualExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(NeOp, first, rest), nil
-}
-
-func (p *parser) callonNotEqualExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onNotEqualExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onEquivalentExpression1(first, rest interface{}) (interface{}, error) {
-	return parseOperator(EquivOp, first, rest), nil
-}
-
-func (p *parser) callonEquivalentExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onEquivalentExpression1(stack["first"], stack["rest"])
-}
-
-func (c *current) onApplicationExpression1(f, rest interface{}) (interface{}, error) {
-	e := f.(Term)
-	if rest == nil {
-		return e, nil
-	}
-	for _, arg := range rest.([]interface{}) {
-		e = Apply(e, arg.([]interface{})[1].(Term))
-	}
-	return e, nil
-
-}
-
-func (p *parser) callonApplicationExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onApplicationExpression1(stack["f"], stack["rest"])
-}
-
-func (c *current) onFirstApplicationExpression2(h, u interface{}) (interface{}, error) {
-	return Merge{Handler: h.(Term), Union: u.(Term)}, nil
-
-}
-
-func (p *parser) callonFirstApplicationExpression2() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onFirstApplicationExpression2(stack["h"], stack["u"])
-}
-
-func (c *current) onFirstApplicationExpression11(e interface{}) (interface{}, error) {
-	return Some{e.(Term)}, nil
-}
-
-func (p *parser) callonFirstApplicationExpression11() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onFirstApplicationExpression11(stack["e"])
-}
-
-func (c *current) onFirstApplicationExpression17(e interface{}) (interface{}, error) {
-	return ToMap{Record: e.(Term)}, nil
-}
-
-func (p *parser) callonFirstApplicationExpression17() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onFirstApplicationExpression17(stack["e"])
-}
-
-func (c *current) onCompletionExpression1(a, b interface{}) (interface{}, error) {
-	if b == nil {
-		return a, nil
-	}
-	return OpTerm{OpCode: CompleteOp, L: a.(Term), R: b.([]interface{})[1].(Term)}, nil
-}
-
-func (p *parser) callonCompletionExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onCompletionExpression1(stack["a"], stack["b"])
-}
-
-func (c *current) onSelectorExpression1(e, ls interface{}) (interface{}, error) {
-	expr := e.(Term)
-	labels := ls.([]interface{})
-	for _, labelSelector := range labels {
-		selectorIface := labelSelector.([]interface{})[3]
-		switch selector := selectorIface.(type) {
-		case string:
-			expr = Field{expr, selector}
-		case []string:
-			expr = Project{expr, selector}
-		case Term:
-			expr = ProjectType{expr, selector}
-		default:
-			return nil, errors.New("unimplemented")
-		}
-	}
-	return expr, nil
-}
-
-func (p *parser) callonSelectorExpression1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSelectorExpression1(stack["e"], stack["ls"])
-}
-
-func (c *current) onSelector6() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonSelector6() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSelector6()
-}
-
-func (c *current) onSelector2(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonSelector2() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSelector2(stack["label"])
-}
-
-func (c *current) onSelector22() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonSelector22() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSelector22()
-}
-
-func (c *current) onSelector13() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonSelector13() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSelector13()
-}
-
-func (c *current) onSelector45() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonSelector45() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSelector45()
-}
-
-func (c *current) onSelector35() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonSelector35() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSelector35()
-}
-
-func (c *current) onSelector10(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonSelector10() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onSelector10(stack["label"])
-}
-
-func (c *current) onLabels13() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonLabels13() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels13()
-}
-
-func (c *current) onLabels9(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLabels9() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels9(stack["label"])
-}
-
-func (c *current) onLabels29() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonLabels29() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels29()
-}
-
-func (c *current) onLabels20() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonLabels20() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels20()
-}
-
-func (c *current) onLabels52() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonLabels52() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels52()
-}
-
-func (c *current) onLabels42() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonLabels42() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels42()
-}
-
-func (c *current) onLabels17(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLabels17() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels17(stack["label"])
-}
-
-func (c *current) onLabels76() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonLabels76() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels76()
-}
-
-func (c *current) onLabels72(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLabels72() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels72(stack["label"])
-}
-
-func (c *current) onLabels92() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonLabels92() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels92()
-}
-
-func (c *current) onLabels83() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonLabels83() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels83()
-}
-
-func (c *current) onLabels115() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonLabels115() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels115()
-}
-
-func (c *current) onLabels105() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonLabels105() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels105()
-}
-
-func (c *current) onLabels80(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonLabels80() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels80(stack["label"])
-}
-
-func (c *current) onLabels1(optclauses interface{}) (interface{}, error) {
-	if optclauses == nil {
-		return []string{}, nil
-	}
-	clauses := optclauses.([]interface{})
-	labels := []string{clauses[0].(string)}
-	for _, next := range clauses[2].([]interface{}) {
-		labels = append(labels, next.([]interface{})[2].(string))
-	}
-	return labels, nil
-}
-
-func (p *parser) callonLabels1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onLabels1(stack["optclauses"])
-}
-
-func (c *current) onTypeSelector1(e interface{}) (interface{}, error) {
-	return e, nil
-}
-
-func (p *parser) callonTypeSelector1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onTypeSelector1(stack["e"])
-}
-
-func (c *current) onPrimitiveExpression3() (interface{}, error) {
-	d, err := strconv.ParseFloat(string(c.text), 64)
-	if err != nil {
-		return nil, err
-	}
-	return DoubleLit(d), nil
-}
-
-func (p *parser) callonPrimitiveExpression3() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression3()
-}
-
-func (c *current) onPrimitiveExpression27() (interface{}, error) {
-	return DoubleLit(math.Inf(1)), nil
-}
-
-func (p *parser) callonPrimitiveExpression27() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression27()
-}
-
-func (c *current) onPrimitiveExpression29() (interface{}, error) {
-	return DoubleLit(math.Inf(-1)), nil
-}
-
-func (p *parser) callonPrimitiveExpression29() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression29()
-}
-
-func (c *current) onPrimitiveExpression31() (interface{}, error) {
-	return DoubleLit(math.NaN()), nil
-}
-
-func (p *parser) callonPrimitiveExpression31() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression31()
-}
-
-func (c *current) onPrimitiveExpression33() (interface{}, error) {
-	i, err := strconv.Atoi(string(c.text))
-	return NaturalLit(i), err
-}
-
-func (p *parser) callonPrimitiveExpression33() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression33()
-}
-
-func (c *current) onPrimitiveExpression39() (interface{}, error) {
-	i, err := strconv.Atoi(string(c.text))
-	return NaturalLit(i), err
-}
-
-func (p *parser) callonPrimitiveExpression39() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression39()
-}
-
-func (c *current) onPrimitiveExpression36() (interface{}, error) {
-	i, err := strconv.Atoi(string(c.text))
-	if err != nil {
-		return nil, err
-	}
-	return IntegerLit(i), nil
-}
-
-func (p *parser) callonPrimitiveExpression36() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression36()
-}
-
-func (c *current) onPrimitiveExpression43(r interface{}) (interface{}, error) {
-	return r, nil
-}
-
-func (p *parser) callonPrimitiveExpression43() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression43(stack["r"])
-}
-
-func (c *current) onPrimitiveExpression55(u interface{}) (interface{}, error) {
-	return u, nil
-}
-
-func (p *parser) callonPrimitiveExpression55() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression55(stack["u"])
-}
-
-func (c *current) onPrimitiveExpression69(e interface{}) (interface{}, error) {
-	return e, nil
-}
-
-func (p *parser) callonPrimitiveExpression69() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onPrimitiveExpression69(stack["e"])
-}
-
-func (c *current) onRecordTypeOrLiteral2() (interface{}, error) {
-	return RecordLit{}, nil
-}
-
-func (p *parser) callonRecordTypeOrLiteral2() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeOrLiteral2()
-}
-
-func (c *current) onRecordTypeOrLiteral6() (interface{}, error) {
-	return RecordType{}, nil
-}
-
-func (p *parser) callonRecordTypeOrLiteral6() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeOrLiteral6()
-}
-
-func (c *current) onRecordTypeField9() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonRecordTypeField9() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeField9()
-}
-
-func (c *current) onRecordTypeField5(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonRecordTypeField5() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeField5(stack["label"])
-}
-
-func (c *current) onRecordTypeField25() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonRecordTypeField25() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeField25()
-}
-
-func (c *current) onRecordTypeField16() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonRecordTypeField16() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeField16()
-}
-
-func (c *current) onRecordTypeField48() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonRecordTypeField48() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeField48()
-}
-
-func (c *current) onRecordTypeField38() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonRecordTypeField38() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeField38()
-}
-
-func (c *current) onRecordTypeField13(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonRecordTypeField13() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeField13(stack["label"])
-}
-
-func (c *current) onRecordTypeField1(name, expr interface{}) (interface{}, error) {
-	return []interface{}{name, expr}, nil
-}
-
-func (p *parser) callonRecordTypeField1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordTypeField1(stack["name"], stack["expr"])
-}
-
-func (c *current) onMoreRecordType1(f interface{}) (interface{}, error) {
-	return f, nil
-}
-
-func (p *parser) callonMoreRecordType1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onMoreRecordType1(stack["f"])
-}
-
-func (c *current) onNonEmptyRecordType1(first, rest interface{}) (interface{}, error) {
-	fields := rest.([]interface{})
-	content := make(RecordType, len(fields)+1)
-	content[first.([]interface{})[0].(string)] = first.([]interface{})[1].(Term)
-	for _, field := range fields {
-		fieldName := field.([]interface{})[0].(string)
-		if _, ok := content[fieldName]; ok {
-			return nil, fmt.Errorf("Duplicate field %s in record", fieldName)
-		}
-		content[fieldName] = field.([]interface{})[1].(Term)
-	}
-	return content, nil
-
-}
-
-func (p *parser) callonNonEmptyRecordType1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onNonEmptyRecordType1(stack["first"], stack["rest"])
-}
-
-func (c *current) onRecordLiteralField9() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonRecordLiteralField9() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordLiteralField9()
-}
-
-func (c *current) onRecordLiteralField5(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonRecordLiteralField5() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordLiteralField5(stack["label"])
-}
-
-func (c *current) onRecordLiteralField25() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonRecordLiteralField25() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordLiteralField25()
-}
-
-func (c *current) onRecordLiteralField16() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonRecordLiteralField16() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordLiteralField16()
-}
-
-func (c *current) onRecordLiteralField48() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonRecordLiteralField48() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordLiteralField48()
-}
-
-func (c *current) onRecordLiteralField38() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonRecordLiteralField38() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordLiteralField38()
-}
-
-func (c *current) onRecordLiteralField13(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonRecordLiteralField13() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordLiteralField13(stack["label"])
-}
-
-func (c *current) onRecordLiteralField1(name, expr interface{}) (interface{}, error) {
-	return []interface{}{name, expr}, nil
-}
-
-func (p *parser) callonRecordLiteralField1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onRecordLiteralField1(stack["name"], stack["expr"])
-}
-
-func (c *current) onMoreRecordLiteral1(f interface{}) (interface{}, error) {
-	return f, nil
-}
-
-func (p *parser) callonMoreRecordLiteral1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onMoreRecordLiteral1(stack["f"])
-}
-
-func (c *current) onNonEmptyRecordLiteral1(first, rest interface{}) (interface{}, error) {
-	fields := rest.([]interface{})
-	content := make(RecordLit, len(fields)+1)
-	content[first.([]interface{})[0].(string)] = first.([]interface{})[1].(Term)
-	for _, field := range fields {
-		fieldName := field.([]interface{})[0].(string)
-		if _, ok := content[fieldName]; ok {
-			return nil, fmt.Errorf("Duplicate field %s in record", fieldName)
-		}
-		content[fieldName] = field.([]interface{})[1].(Term)
-	}
-	return content, nil
-
-}
-
-func (p *parser) callonNonEmptyRecordLiteral1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onNonEmptyRecordLiteral1(stack["first"], stack["rest"])
-}
-
-func (c *current) onUnionType3() (interface{}, error) {
-	return UnionType{}, nil
-}
-
-func (p *parser) callonUnionType3() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onUnionType3()
-}
-
-func (c *current) onNonEmptyUnionType1(first, rest interface{}) (interface{}, error) {
-	alternatives := make(UnionType)
-	first2 := first.([]interface{})
-	if first2[1] == nil {
-		alternatives[first2[0].(string)] = nil
-	} else {
-		alternatives[first2[0].(string)] = first2[1].([]interface{})[3].(Term)
-	}
-	if rest == nil {
-		return UnionType(alternatives), nil
-	}
-	for _, alternativeSyntax := range rest.([]interface{}) {
-		alternative := alternativeSyntax.([]interface{})[3].([]interface{})
-		name := alternative[0].(string)
-		if _, ok := alternatives[name]; ok {
-			return nil, fmt.Errorf("Duplicate alternative %s in union", name)
-		}
-
-		if alternative[1] == nil {
-			alternatives[name] = nil
-		} else {
-			alternatives[name] = alternative[1].([]interface{})[3].(Term)
-		}
-	}
-	return alternatives, nil
-}
-
-func (p *parser) callonNonEmptyUnionType1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onNonEmptyUnionType1(stack["first"], stack["rest"])
-}
-
-func (c *current) onUnionVariant7() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonUnionVariant7() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onUnionVariant7()
-}
-
-func (c *current) onUnionVariant3(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonUnionVariant3() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onUnionVariant3(stack["label"])
-}
-
-func (c *current) onUnionVariant23() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonUnionVariant23() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onUnionVariant23()
-}
-
-func (c *current) onUnionVariant14() (interface{}, error) {
-	return string(c.text), nil
-}
-
-func (p *parser) callonUnionVariant14() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onUnionVariant14()
-}
-
-func (c *current) onUnionVariant46() (interface{}, error) {
-	return Missing{}, nil
-}
-
-func (p *parser) callonUnionVariant46() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onUnionVariant46()
-}
-
-func (c *current) onUnionVariant36() (interface{}, error) {
-	return string(c.text), nil
-
-}
-
-func (p *parser) callonUnionVariant36() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onUnionVariant36()
-}
-
-func (c *current) onUnionVariant11(label interface{}) (interface{}, error) {
-	return label, nil
-}
-
-func (p *parser) callonUnionVariant11() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onUnionVariant11(stack["label"])
-}
-
-func (c *current) onMoreList1(e interface{}) (interface{}, error) {
-	return e, nil
-}
-
-func (p *parser) callonMoreList1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onMoreList1(stack["e"])
-}
-
-func (c *current) onNonEmptyListLiteral1(first, rest interface{}) (interface{}, error) {
-	exprs := rest.([]interface{})
-	content := make(NonEmptyList, len(exprs)+1)
-	content[0] = first.(Term)
-	for i, expr := range exprs {
-		content[i+1] = expr.(Term)
-	}
-	return content, nil
-
-}
-
-func (p *parser) callonNonEmptyListLiteral1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onNonEmptyListLiteral1(stack["first"], stack["rest"])
-}
-
-var (
-	// errNoRule is returned when the grammar to parse has no rule.
-	errNoRule = errors.New("grammar has no rule")
-
-	// errInvalidEntrypoint is returned when the specified entrypoint rule
-	// does not exit.
-	errInvalidEntrypoint = errors.New("invalid entrypoint")
-
-	// errInvalidEncoding is returned when the source is not properly
-	// utf8-encoded.
-	errInvalidEncoding = errors.New("invalid encoding")
-
-	// errMaxExprCnt is used to signal that the maximum number of
-	// expressions have been parsed.
-	errMaxExprCnt = errors.New("max number of expresssions parsed")
-)
-
-// Option is a function that can set an option on the parser. It returns
-// the previous setting as an Option.
-type Option func(*parser) Option
-
-// MaxExpressions creates an Option to stop parsing after the provided
-// number of expressions have been parsed, if the value is 0 then the parser will
-// parse for as many steps as needed (possibly an infinite number).
-//
-// The default for maxExprCnt is 0.
-func MaxExpressions(maxExprCnt uint64) Option {
-	return func(p *parser) Option {
-		oldMaxExprCnt := p.maxExprCnt
-		p.maxExprCnt = maxExprCnt
-		return MaxExpressions(oldMaxExprCnt)
-	}
-}
-
-// Entrypoint creates an Option to set the rule name to use as entrypoint.
-// The rule name must have been specified in the -alternate-entrypoints
-// if generating the parser with the -optimize-grammar flag, otherwise
-// it may have been optimized out. Passing an empty string sets the
-// entrypoint to the first rule in the grammar.
-//
-// The default is to start parsing at the first rule in the grammar.
-func Entrypoint(ruleName string) Option {
-	return func(p *parser) Option {
-		oldEntrypoint := p.entrypoint
-		p.entrypoint = ruleName
-		if ruleName == "" {
-			p.entrypoint = g.rules[0].name
-		}
-		return Entrypoint(oldEntrypoint)
-	}
-}
-
-// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
-// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
-// by character class matchers and is matched by the any matcher.
-// The returned matched value, c.text and c.offset are NOT affected.
-//
-// The default is false.
-func AllowInvalidUTF8(b bool) Option {
-	return func(p *parser) Option {
-		old := p.allowInvalidUTF8
-		p.allowInvalidUTF8 = b
-		return AllowInvalidUTF8(old)
-	}
-}
-
-// Recover creates an Option to set the recover flag to b. When set to
-// true, this causes the parser to recover from panics and convert it
-// to an error. Setting it to false can be useful while debugging to
-// access the full stack trace.
-//
-// The default is true.
-func Recover(b bool) Option {
-	return func(p *parser) Option {
-		old := p.recover
-		p.recover = b
-		return Recover(old)
-	}
-}
-
-// GlobalStore creates an Option to set a key to a certain value in
-// the globalStore.
-func GlobalStore(key string, value interface{}) Option {
-	return func(p *parser) Option {
-		old := p.cur.globalStore[key]
-		p.cur.globalStore[key] = value
-		return GlobalStore(key, old)
-	}
-}
-
-// ParseFile parses the file identified by filename.
-func ParseFile(filename string, opts ...Option) (i interface{}, err error) {
-	f, err := os.Open(filename)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if closeErr := f.Close(); closeErr != nil {
-			err = closeErr
-		}
-	}()
-	return ParseReader(filename, f, opts...)
-}
-
-// ParseReader parses the data from r using filename as information in the
-// error messages.
-func ParseReader(filename string, r io.Reader, opts ...Option) (interface{}, error) {
-	b, err := ioutil.ReadAll(r)
-	if err != nil {
-		return nil, err
-	}
-
-	return Parse(filename, b, opts...)
-}
-
-// Parse parses the data from b using filename as information in the
-// error messages.
-func Parse(filename string, b []byte, opts ...Option) (interface{}, error) {
-	return newParser(filename, b, opts...).parse(g)
-}
-
-// position records a position in the text.
-type position struct {
-	line, col, offset int
-}
-
-func (p position) String() string {
-	return strconv.Itoa(p.line) + ":" + strconv.Itoa(p.col) + " [" + strconv.Itoa(p.offset) + "]"
-}
-
-// savepoint stores all state required to go back to this point in the
-// parser.
-type savepoint struct {
-	position
-	rn rune
-	w  int
-}
-
-type current struct {
-	pos  position // start position of the match
-	text []byte   // raw text of the match
-
-	// globalStore is a general store for the user to store arbitrary key-value
-	// pairs that they need to manage and that they do not want tied to the
-	// backtracking of the parser. This is only modified by the user and never
-	// rolled back by the parser. It is always up to the user to keep this in a
-	// consistent state.
-	globalStore storeDict
-}
-
-type storeDict map[string]interface{}
-
-// the AST types...
-
-type grammar struct {
-	pos   position
-	rules []*rule
-}
-
-type rule struct {
-	pos         position
-	name        string
-	displayName string
-	expr        interface{}
-}
-
-type choiceExpr struct {
-	pos          position
-	alternatives []interface{}
-}
-
-type actionExpr struct {
-	pos  position
-	expr interface{}
-	run  func(*parser) (interface{}, error)
-}
-
-type recoveryExpr struct {
-	pos          position
-	expr         interface{}
-	recoverExpr  interface{}
-	failureLabel []string
-}
-
-type seqExpr struct {
-	pos   position
-	exprs []interface{}
-}
-
-type throwExpr struct {
-	pos   position
-	label string
-}
-
-type labeledExpr struct {
-	pos   position
-	label string
-	expr  interface{}
-}
-
-type expr struct {
-	pos  position
-	expr interface{}
-}
-
-type andExpr expr
-type notExpr expr
-type zeroOrOneExpr expr
-type zeroOrMoreExpr expr
-type oneOrMoreExpr expr
-
-type ruleRefExpr struct {
-	pos  position
-	name string
-}
-
-type andCodeExpr struct {
-	pos position
-	run func(*parser) (bool, error)
-}
-
-type notCodeExpr struct {
-	pos position
-	run func(*parser) (bool, error)
-}
-
-type litMatcher struct {
-	pos        position
-	val        string
-	ignoreCase bool
-}
-
-type charClassMatcher struct {
-	pos             position
-	val             string
-	basicLatinChars [128]bool
-	chars           []rune
-	ranges          []rune
-	classes         []*unicode.RangeTable
-	ignoreCase      bool
-	inverted        bool
-}
-
-type anyMatcher position
-
-// errList cumulates the errors found by the parser.
-type errList []error
-
-func (e *errList) add(err error) {
-	*e = append(*e, err)
-}
-
-func (e errList) err() error {
-	if len(e) == 0 {
-		return nil
-	}
-	e.dedupe()
-	return e
-}
-
-func (e *errList) dedupe() {
-	var cleaned []error
-	set := make(map[string]bool)
-	for _, err := range *e {
-		if msg := err.Error(); !set[msg] {
-			set[msg] = true
-			cleaned = append(cleaned, err)
-		}
-	}
-	*e = cleaned
-}
-
-func (e errList) Error() string {
-	switch len(e) {
-	case 0:
-		return ""
-	case 1:
-		return e[0].Error()
-	default:
-		var buf bytes.Buffer
-
-		for i, err := range e {
-			if i > 0 {
-				buf.WriteRune('\n')
-			}
-			buf.WriteString(err.Error())
-		}
-		return buf.String()
-	}
-}
-
-// parserError wraps an error with a prefix indicating the rule in which
-// the error occurred. The original error is stored in the Inner field.
-type parserError struct {
-	Inner    error
-	pos      position
-	prefix   string
-	expected []string
-}
-
-// Error returns the error message.
-func (p *parserError) Error() string {
-	return p.prefix + ": " + p.Inner.Error()
-}
-
-// newParser creates a parser with the specified input source and options.
-func newParser(filename string, b []byte, opts ...Option) *parser {
-	stats := Stats{
-		ChoiceAltCnt: make(map[string]map[string]int),
-	}
-
-	p := &parser{
-		filename: filename,
-		errs:     new(errList),
-		data:     b,
-		pt:       savepoint{position: position{line: 1}},
-		recover:  true,
-		cur: current{
-			globalStore: make(storeDict),
-		},
-		maxFailPos:      position{col: 1, line: 1},
-		maxFailExpected: make([]string, 0, 20),
-		Stats:           &stats,
-		// start rule is rule [0] unless an alternate entrypoint is specified
-		entrypoint: g.rules[0].name,
-	}
-	p.setOptions(opts)
-
-	if p.maxExprCnt == 0 {
-		p.maxExprCnt = math.MaxUint64
-	}
-
-	return p
-}
-
-// setOptions applies the options to the parser.
-func (p *parser) setOptions(opts []Option) {
-	for _, opt := range opts {
-		opt(p)
-	}
-}
-
-type resultTuple struct {
-	v   interface{}
-	b   bool
-	end savepoint
-}
-
-const choiceNoMatch = -1
-
-// Stats stores some statistics, gathered during parsing
-type Stats struct {
-	// ExprCnt counts the number of expressions processed during parsing
-	// This value is compared to the maximum number of expressions allowed
-	// (set by the MaxExpressions option).
-	ExprCnt uint64
-
-	// ChoiceAltCnt is used to count for each ordered choice expression,
-	// which alternative is used how may times.
-	// These numbers allow to optimize the order of the ordered choice expression
-	// to increase the performance of the parser
-	//
-	// The outer key of ChoiceAltCnt is composed of the name of the rule as well
-	// as the line and the column of the ordered choice.
-	// The inner key of ChoiceAltCnt is the number (one-based) of the matching alternative.
-	// For each alternative the number of matches are counted. If an ordered choice does not
-	// match, a special counter is incremented. The name of this counter is set with
-	// the parser option Statistics.
-	// For an alternative to be included in ChoiceAltCnt, it has to match at least once.
-	ChoiceAltCnt map[string]map[string]int
-}
-
-type parser struct {
-	filename string
-	pt       savepoint
-	cur      current
-
-	data []byte
-	errs *errList
-
-	depth   int
-	recover bool
-
-	// rules table, maps the rule identifier to the rule node
-	rules map[string]*rule
-	// variables stack, map of label to value
-	vstack []map[string]interface{}
-	// rule stack, allows identification of the current rule in errors
-	rstack []*rule
-
-	// parse fail
-	maxFailPos            position
-	maxFailExpected       []string
-	maxFailInvertExpected bool
-
-	// max number of expressions to be parsed
-	maxExprCnt uint64
-	// entrypoint for the parser
-	entrypoint string
-
-	allowInvalidUTF8 bool
-
-	*Stats
-
-	choiceNoMatch string
-	// recovery expression stack, keeps track of the currently available recovery expression, these are traversed in reverse
-	recoveryStack []map[string]interface{}
-}
-
-// push a variable set on the vstack.
-func (p *parser) pushV() {
-	if cap(p.vstack) == len(p.vstack) {
-		// create new empty slot in the stack
-		p.vstack = append(p.vstack, nil)
-	} else {
-		// slice to 1 more
-		p.vstack = p.vstack[:len(p.vstack)+1]
-	}
-
-	// get the last args set
-	m := p.vstack[len(p.vstack)-1]
-	if m != nil && len(m) == 0 {
-		// empty map, all good
-		return
-	}
-
-	m = make(map[string]interface{})
-	p.vstack[len(p.vstack)-1] = m
-}
-
-// pop a variable set from the vstack.
-func (p *parser) popV() {
-	// if the map is not empty, clear it
-	m := p.vstack[len(p.vstack)-1]
-	if len(m) > 0 {
-		// GC that map
-		p.vstack[len(p.vstack)-1] = nil
-	}
-	p.vstack = p.vstack[:len(p.vstack)-1]
-}
-
-// push a recovery expression with its labels to the recoveryStack
-func (p *parser) pushRecovery(labels []string, expr interface{}) {
-	if cap(p.recoveryStack) == len(p.recoveryStack) {
-		// create new empty slot in the stack
-		p.recoveryStack = append(p.recoveryStack, nil)
-	} else {
-		// slice to 1 more
-		p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)+1]
-	}
-
-	m := make(map[string]interface{}, len(labels))
-	for _, fl := range labels {
-		m[fl] = expr
-	}
-	p.recoveryStack[len(p.recoveryStack)-1] = m
-}
-
-// pop a recovery expression from the recoveryStack
-func (p *parser) popRecovery() {
-	// GC that map
-	p.recoveryStack[len(p.recoveryStack)-1] = nil
-
-	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
-}
-
-func (p *parser) addErr(err error) {
-	p.addErrAt(err, p.pt.position, []string{})
-}
-
-func (p *parser) addErrAt(err error, pos position, expected []string) {
-	var buf bytes.Buffer
-	if p.filename != "" {
-		buf.WriteString(p.filename)
-	}
-	if buf.Len() > 0 {
-		buf.WriteString(":")
-	}
-	buf.WriteString(fmt.Sprintf("%d:%d (%d)", pos.line, pos.col, pos.offset))
-	if len(p.rstack) > 0 {
-		if buf.Len() > 0 {
-			buf.WriteString(": ")
-		}
-		rule := p.rstack[len(p.rstack)-1]
-		if rule.displayName != "" {
-			buf.WriteString("rule " + rule.displayName)
-		} else {
-			buf.WriteString("rule " + rule.name)
-		}
-	}
-	pe := &parserError{Inner: err, pos: pos, prefix: buf.String(), expected: expected}
-	p.errs.add(pe)
-}
-
-func (p *parser) failAt(fail bool, pos position, want string) {
-	// process fail if parsing fails and not inverted or parsing succeeds and invert is set
-	if fail == p.maxFailInvertExpected {
-		if pos.offset < p.maxFailPos.offset {
-			return
-		}
-
-		if pos.offset > p.maxFailPos.offset {
-			p.maxFailPos = pos
-			p.maxFailExpected = p.maxFailExpected[:0]
-		}
-
-		if p.maxFailInvertExpected {
-			want = "!" + want
-		}
-		p.maxFailExpected = append(p.maxFailExpected, want)
-	}
-}
-
-// read advances the parser to the next rune.
-func (p *parser) read() {
-	p.pt.offset += p.pt.w
-	rn, n := utf8.DecodeRune(p.data[p.pt.offset:])
-	p.pt.rn = rn
-	p.pt.w = n
-	p.pt.col++
-	if rn == '\n' {
-		p.pt.line++
-		p.pt.col = 0
-	}
-
-	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
-		if !p.allowInvalidUTF8 {
-			p.addErr(errInvalidEncoding)
-		}
-	}
-}
-
-// restore parser position to the savepoint pt.
-func (p *parser) restore(pt savepoint) {
-	if pt.offset == p.pt.offset {
-		return
-	}
-	p.pt = pt
-}
-
-// get the slice of bytes from the savepoint start to the current position.
-func (p *parser) sliceFrom(start savepoint) []byte {
-	return p.data[start.position.offset:p.pt.position.offset]
-}
-
-func (p *parser) buildRulesTable(g *grammar) {
-	p.rules = make(map[string]*rule, len(g.rules))
-	for _, r := range g.rules {
-		p.rules[r.name] = r
-	}
-}
-
-func (p *parser) parse(g *grammar) (val interface{}, err error) {
-	if len(g.rules) == 0 {
-		p.addErr(errNoRule)
-		return nil, p.errs.err()
-	}
-
-	// TODO : not super critical but this could be generated
-	p.buildRulesTable(g)
-
-	if p.recover {
-		// panic can be used in action code to stop parsing immediately
-		// and return the panic as an error.
-		defer func() {
-			if e := recover(); e != nil {
-				val = nil
-				switch e := e.(type) {
-				case error:
-					p.addErr(e)
-				default:
-					p.addErr(fmt.Errorf("%v", e))
-				}
-				err = p.errs.err()
-			}
-		}()
-	}
-
-	startRule, ok := p.rules[p.entrypoint]
-	if !ok {
-		p.addErr(errInvalidEntrypoint)
-		return nil, p.errs.err()
-	}
-
-	p.read() // advance to first rune
-	val, ok = p.parseRule(startRule)
-	if !ok {
-		if len(*p.errs) == 0 {
-			// If parsing fails, but no errors have been recorded, the expected values
-			// for the farthest parser position are returned as error.
-			maxFailExpectedMap := make(map[string]struct{}, len(p.maxFailExpected))
-			for _, v := range p.maxFailExpected {
-				maxFailExpectedMap[v] = struct{}{}
-			}
-			expected := make([]string, 0, len(maxFailExpectedMap))
-			eof := false
-			if _, ok := maxFailExpectedMap["!."]; ok {
-				delete(maxFailExpectedMap, "!.")
-				eof = true
-			}
-			for k := range maxFailExpectedMap {
-				expected = append(expected, k)
-			}
-			sort.Strings(expected)
-			if eof {
-				expected = append(expected, "EOF")
-			}
-			p.addErrAt(errors.New("no match found, expected: "+listJoin(expected, ", ", "or")), p.maxFailPos, expected)
-		}
-
-		return nil, p.errs.err()
-	}
-	return val, p.errs.err()
-}
-
-func listJoin(list []string, sep string, lastSep string) string {
-	switch len(list) {
-	case 0:
-		return ""
-	case 1:
-		return list[0]
-	default:
-		return strings.Join(list[:len(list)-1], sep) + " " + lastSep + " " + list[len(list)-1]
-	}
-}
-
-func (p *parser) parseRule(rule *rule) (interface{}, bool) {
-	p.rstack = append(p.rstack, rule)
-	p.pushV()
-	val, ok := p.parseExpr(rule.expr)
-	p.popV()
-	p.rstack = p.rstack[:len(p.rstack)-1]
-	return val, ok
-}
-
-func (p *parser) parseExpr(expr interface{}) (interface{}, bool) {
-
-	p.ExprCnt++
-	if p.ExprCnt > p.maxExprCnt {
-		panic(errMaxExprCnt)
-	}
-
-	var val interface{}
-	var ok bool
-	switch expr := expr.(type) {
-	case *actionExpr:
-		val, ok = p.parseActionExpr(expr)
-	case *andCodeExpr:
-		val, ok = p.parseAndCodeExpr(expr)
-	case *andExpr:
-		val, ok = p.parseAndExpr(expr)
-	case *anyMatcher:
-		val, ok = p.parseAnyMatcher(expr)
-	case *charClassMatcher:
-		val, ok = p.parseCharClassMatcher(expr)
-	case *choiceExpr:
-		val, ok = p.parseChoiceExpr(expr)
-	case *labeledExpr:
-		val, ok = p.parseLabeledExpr(expr)
-	case *litMatcher:
-		val, ok = p.parseLitMatcher(expr)
-	case *notCodeExpr:
-		val, ok = p.parseNotCodeExpr(expr)
-	case *notExpr:
-		val, ok = p.parseNotExpr(expr)
-	case *oneOrMoreExpr:
-		val, ok = p.parseOneOrMoreExpr(expr)
-	case *recoveryExpr:
-		val, ok = p.parseRecoveryExpr(expr)
-	case *ruleRefExpr:
-		val, ok = p.parseRuleRefExpr(expr)
-	case *seqExpr:
-		val, ok = p.parseSeqExpr(expr)
-	case *throwExpr:
-		val, ok = p.parseThrowExpr(expr)
-	case *zeroOrMoreExpr:
-		val, ok = p.parseZeroOrMoreExpr(expr)
-	case *zeroOrOneExpr:
-		val, ok = p.parseZeroOrOneExpr(expr)
-	default:
-		panic(fmt.Sprintf("unknown expression type %T", expr))
-	}
-	return val, ok
-}
-
-func (p *parser) parseActionExpr(act *actionExpr) (interface{}, bool) {
-	start := p.pt
-	val, ok := p.parseExpr(act.expr)
-	if ok {
-		p.cur.pos = start.position
-		p.cur.text = p.sliceFrom(start)
-		actVal, err := act.run(p)
-		if err != nil {
-			p.addErrAt(err, start.position, []string{})
-		}
-
-		val = actVal
-	}
-	return val, ok
-}
-
-func (p *parser) parseAndCodeExpr(and *andCodeExpr) (interface{}, bool) {
-
-	ok, err := and.run(p)
-	if err != nil {
-		p.addErr(err)
-	}
-
-	return nil, ok
-}
-
-func (p *parser) parseAndExpr(and *andExpr) (interface{}, bool) {
-	pt := p.pt
-	p.pushV()
-	_, ok := p.parseExpr(and.expr)
-	p.popV()
-	p.restore(pt)
-
-	return nil, ok
-}
-
-func (p *parser) parseAnyMatcher(any *anyMatcher) (interface{}, bool) {
-	if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
-		// EOF - see utf8.DecodeRune
-		p.failAt(false, p.pt.position, ".")
-		return nil, false
-	}
-	start := p.pt
-	p.read()
-	p.failAt(true, start.position, ".")
-	return p.sliceFrom(start), true
-}
-
-func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (interface{}, bool) {
-	cur := p.pt.rn
-	start := p.pt
-
-	// can't match EOF
-	if cur == utf8.RuneError && p.pt.w == 0 { // see utf8.DecodeRune
-		p.failAt(false, start.position, chr.val)
-		return nil, false
-	}
-
-	if chr.ignoreCase {
-		cur = unicode.ToLower(cur)
-	}
-
-	// try to match in the list of available chars
-	for _, rn := range chr.chars {
-		if rn == cur {
-			if chr.inverted {
-				p.failAt(false, start.position, chr.val)
-				return nil, false
-			}
-			p.read()
-			p.failAt(true, start.position, chr.val)
-			return p.sliceFrom(start), true
-		}
-	}
-
-	// try to match in the list of ranges
-	for i := 0; i < len(chr.ranges); i += 2 {
-		if cur >= chr.ranges[i] && cur <= chr.ranges[i+1] {
-			if chr.inverted {
-				p.failAt(false, start.position, chr.val)
-				return nil, false
-			}
-			p.read()
-			p.failAt(true, start.position, chr.val)
-			return p.sliceFrom(start), true
-		}
-	}
-
-	// try to match in the list of Unicode classes
-	for _, cl := range chr.classes {
-		if unicode.Is(cl, cur) {
-			if chr.inverted {
-				p.failAt(false, start.position, chr.val)
-				return nil, false
-			}
-			p.read()
-			p.failAt(true, start.position, chr.val)
-			return p.sliceFrom(start), true
-		}
-	}
-
-	if chr.inverted {
-		p.read()
-		p.failAt(true, start.position, chr.val)
-		return p.sliceFrom(start), true
-	}
-	p.failAt(false, start.position, chr.val)
-	return nil, false
-}
-
-func (p *parser) parseChoiceExpr(ch *choiceExpr) (interface{}, bool) {
-	for altI, alt := range ch.alternatives {
-		// dummy assignment to prevent compile error if optimized
-		_ = altI
-
-		p.pushV()
-		val, ok := p.parseExpr(alt)
-		p.popV()
-		if ok {
-			return val, ok
-		}
-	}
-	return nil, false
-}
-
-func (p *parser) parseLabeledExpr(lab *labeledExpr) (interface{}, bool) {
-	p.pushV()
-	val, ok := p.parseExpr(lab.expr)
-	p.popV()
-	if ok && lab.label != "" {
-		m := p.vstack[len(p.vstack)-1]
-		m[lab.label] = val
-	}
-	return val, ok
-}
-
-func (p *parser) parseLitMatcher(lit *litMatcher) (interface{}, bool) {
-	ignoreCase := ""
-	if lit.ignoreCase {
-		ignoreCase = "i"
-	}
-	val := string(strconv.AppendQuote([]byte{}, lit.val)) + ignoreCase // wrap 'lit.val' with double quotes
-	start := p.pt
-	for _, want := range lit.val {
-		cur := p.pt.rn
-		if lit.ignoreCase {
-			cur = unicode.ToLower(cur)
-		}
-		if cur != want {
-			p.failAt(false, start.position, val)
-			p.restore(start)
-			return nil, false
-		}
-		p.read()
-	}
-	p.failAt(true, start.position, val)
-	return p.sliceFrom(start), true
-}
-
-func (p *parser) parseNotCodeExpr(not *notCodeExpr) (interface{}, bool) {
-	ok, err := not.run(p)
-	if err != nil {
-		p.addErr(err)
-	}
-
-	return nil, !ok
-}
-
-func (p *parser) parseNotExpr(not *notExpr) (interface{}, bool) {
-	pt := p.pt
-	p.pushV()
-	p.maxFailInvertExpected = !p.maxFailInvertExpected
-	_, ok := p.parseExpr(not.expr)
-	p.maxFailInvertExpected = !p.maxFailInvertExpected
-	p.popV()
-	p.restore(pt)
-
-	return nil, !ok
-}
-
-func (p *parser) parseOneOrMoreExpr(expr *oneOrMoreExpr) (interface{}, bool) {
-	var vals []interface{}
-
-	for {
-		p.pushV()
-		val, ok := p.parseExpr(expr.expr)
-		p.popV()
-		if !ok {
-			if len(vals) == 0 {
-				// did not match once, no match
-				return nil, false
-			}
-			return vals, true
-		}
-		vals = append(vals, val)
-	}
-}
-
-func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (interface{}, bool) {
-
-	p.pushRecovery(recover.failureLabel, recover.recoverExpr)
-	val, ok := p.parseExpr(recover.expr)
-	p.popRecovery()
-
-	return val, ok
-}
-
-func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (interface{}, bool) {
-	if ref.name == "" {
-		panic(fmt.Sprintf("%s: invalid rule: missing name", ref.pos))
-	}
-
-	rule := p.rules[ref.name]
-	if rule == nil {
-		p.addErr(fmt.Errorf("undefined rule: %s", ref.name))
-		return nil, false
-	}
-	return p.parseRule(rule)
-}
-
-func (p *parser) parseSeqExpr(seq *seqExpr) (interface{}, bool) {
-	vals := make([]interface{}, 0, len(seq.exprs))
-
-	pt := p.pt
-	for _, expr := range seq.exprs {
-		val, ok := p.parseExpr(expr)
-		if !ok {
-			p.restore(pt)
-			return nil, false
-		}
-		vals = append(vals, val)
-	}
-	return vals, true
-}
-
-func (p *parser) parseThrowExpr(expr *throwExpr) (interface{}, bool) {
-
-	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
-		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
-			if val, ok := p.parseExpr(recoverExpr); ok {
-				return val, ok
-			}
-		}
-	}
-
-	return nil, false
-}
-
-func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (interface{}, bool) {
-	var vals []interface{}
-
-	for {
-		p.pushV()
-		val, ok := p.parseExpr(expr.expr)
-		p.popV()
-		if !ok {
-			return vals, true
-		}
-		vals = append(vals, val)
-	}
-}
-
-func (p *parser) parseZeroOrOneExpr(expr *zeroOrOneExpr) (interface{}, bool) {
-	p.pushV()
-	val, _ := p.parseExpr(expr.expr)
-	p.popV()
-	// whether it matched or not, consider it a match
-	return val, true
-}
@@ -0,0 +1,91 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// An Error is a failure to parse Dhall source.  Its Error method
+// gives the position and message, followed by the offending line of
+// source with a caret under the error and any hint, for example:
+//
+//	config.dhall:2:9: expected `=` after let binding name
+//	    let x : Natural 1
+//	                    ^
+//	    hint: ...
+type Error struct {
+	Filename string
+	// Line and Column are the position of the error, counting from
+	// 1.  Column counts characters, not bytes.
+	Line, Column int
+	// Offset is the position of the error in bytes.
+	Offset int
+	// Message says what went wrong, such as what was expected.
+	Message string
+	// Hint suggests a fix for a common mistake.  It may be empty.
+	Hint string
+	// SourceLine is the line of source containing the error,
+	// without its newline.
+	SourceLine string
+	// Err is the cause of the error if it is one this package
+	// exports, such as ErrLimit, and nil otherwise.
+	Err error
+}
+
+func (e Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:%d:%d: %s", e.Filename, e.Line, e.Column, e.Message)
+	if e.SourceLine != "" {
+		fmt.Fprintf(&b, "\n    %s\n    %s^", e.SourceLine, e.caretIndent())
+	}
+	if e.Hint != "" {
+		fmt.Fprintf(&b, "\n    hint: %s", e.Hint)
+	}
+	return b.String()
+}
+
+// Unwrap returns e.Err.
+func (e Error) Unwrap() error { return e.Err }
+
+// caretIndent returns the whitespace to print before a caret under
+// Column of SourceLine.  Tabs are kept, so that the caret lines up
+// however wide they are.
+func (e Error) caretIndent() string {
+	var b strings.Builder
+	col := 1
+	for _, r := range e.SourceLine {
+		if col >= e.Column {
+			break
+		}
+		if r == '\t' {
+			b.WriteRune('\t')
+		} else {
+			b.WriteRune(' ')
+		}
+		col++
+	}
+	return b.String()
+}
+
+// Hints for common mistakes.
+const (
+	hintMissingIn = "every `let` needs a matching `in` followed by the body, " +
+		"as in `let x = 1 in x + 1`"
+	hintColonInRecordLit = "fields of a record literal are written `name = value`; " +
+		"`:` is for record types"
+	hintEqualsInRecordType = "fields of a record type are written `name : Type`; " +
+		"`=` is for record literals"
+	hintTrailingComma = "remove the trailing `,`: a comma may come before the first " +
+		"element but not after the last"
+	hintSingleQuoteStart = "a `''` text literal must have a newline straight after " +
+		"the opening `''`"
+	hintSingleQuoteEnd = "this `''` ends the text literal; write `'''` for a literal " +
+		"`''` inside it"
+)
+
+// hintUnterminated is the hint for a multi-line text literal which
+// starts at line and col and never ends.
+func hintUnterminated(line, col int) string {
+	return fmt.Sprintf("the `''` text literal which starts at line %d, column %d "+
+		"is never closed", line, col)
+}
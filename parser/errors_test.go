@@ -0,0 +1,58 @@
+package parser_test
+
+import (
+	"github.com/philandstuff/dhall-golang/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func parseError(input string) parser.Error {
+	_, err := parser.Parse("test.dhall", []byte(input))
+	Expect(err).To(BeAssignableToTypeOf(parser.Error{}))
+	return err.(parser.Error)
+}
+
+var _ = Describe("Parse errors", func() {
+	It("shows the position, source line and a caret", func() {
+		err := parseError("let x = 1\nlet y 2\nin x")
+		Expect(err.Line).To(Equal(2))
+		Expect(err.Column).To(Equal(7))
+		Expect(err.Offset).To(Equal(16))
+		Expect(err.Error()).To(Equal(
+			"test.dhall:2:7: expected `=` after let binding name\n" +
+				"    let y 2\n" +
+				"          ^"))
+	})
+	It("lines the caret up after tabs and non-ASCII characters", func() {
+		err := parseError("\t[ \"é\", )")
+		Expect(err.Column).To(Equal(9))
+		Expect(err.Error()).To(HaveSuffix("\n    \t[ \"é\", )\n    \t       ^"))
+	})
+	It("points at the start of a literal which is out of range", func() {
+		err := parseError("[ 1, 99999999999999999999999 ]")
+		Expect(err.Column).To(Equal(6))
+		Expect(err.Message).To(Equal("Natural literal 99999999999999999999999 is too large"))
+	})
+	DescribeTable("hints", func(input string, line, column int, hint string) {
+		err := parseError(input)
+		Expect(err.Line).To(Equal(line))
+		Expect(err.Column).To(Equal(column))
+		Expect(err.Hint).To(ContainSubstring(hint))
+	},
+		Entry("missing in", "let x = 1\nx", 2, 2, "matching `in`"),
+		Entry("trailing comma in list", "[ 1, 2, ]", 1, 9, "trailing `,`"),
+		Entry("trailing comma in record", "{ a = 1, b = 2, }", 1, 17, "trailing `,`"),
+		Entry("trailing comma in record type", "{ a : T, }", 1, 10, "trailing `,`"),
+		Entry("colon in record literal", "{ a = 1, b : 2 }", 1, 12, "`name = value`"),
+		Entry("equals in record type", "{ a : T, b = U }", 1, 12, "`name : Type`"),
+		Entry("unterminated '' literal", "x ++ ''\nabc\n", 3, 1,
+			"starts at line 1, column 6 is never closed"),
+		Entry("'' literal without newline", "''abc''", 1, 3, "newline"),
+		Entry("'' literal ended too early", "''\nit''s\n''", 2, 5, "write `'''`"),
+	)
+	It("has no hint when there is no common mistake", func() {
+		Expect(parseError("if True then 1").Hint).To(BeEmpty())
+	})
+})
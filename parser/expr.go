@@ -0,0 +1,893 @@
+package parser
+
+import (
+	. "github.com/philandstuff/dhall-golang/core"
+)
+
+// expression parses an Expression: a lambda, if, let, forall, or an
+// operator expression optionally followed by an arrow or annotation.
+func (p *parser) expression() (Term, bool) {
+	start := p.pos
+	e, ok := p.expression1()
+	if ok {
+		p.addNode(e, start)
+	}
+	return e, ok
+}
+
+func (p *parser) expression1() (Term, bool) {
+	p.enter()
+	defer p.leave()
+	start := p.pos
+	switch {
+	case p.hasPrefix(`\`) || p.hasPrefix("λ"):
+		return p.lambda(false)
+	case p.keyword("if"):
+		// `if--x` is a label, since `--` doesn't end one
+		if e, ok := p.ifThenElse(); ok {
+			return e, true
+		}
+		p.pos = start
+	case p.hasPrefix("let"):
+		if e, ok := p.let(); ok {
+			return e, true
+		}
+		p.pos = start
+	case p.hasPrefix("forall") || p.hasPrefix("∀"):
+		// `forall` is also a valid variable name, so we try a
+		// function type first and fall back to the general case
+		if e, ok := p.lambda(true); ok {
+			return e, true
+		}
+		p.pos = start
+	}
+
+	if p.hasPrefix("assert") {
+		p.pos += len("assert")
+		p.whitespace()
+		if p.char(':') && p.whitespace1() {
+			if a, ok := p.expression(); ok {
+				return Assert{Annotation: a}, true
+			}
+		}
+		p.pos = start
+	}
+
+	op, opOk := p.operatorExpression()
+	opEnd := p.pos
+	if opOk {
+		p.whitespace()
+		if p.arrow() {
+			p.whitespace()
+			if body, ok := p.expression(); ok {
+				return NewAnonPi(op, body), true
+			}
+		}
+		p.pos = opEnd
+		// `merge h u : T` and `toMap e : T` are special forms,
+		// where T is the annotation of the Merge or ToMap rather than
+		// of the whole expression.  If op is exactly one of these
+		// without an annotation, firstApplicationExpression has
+		// just recorded its extent.
+		if p.keywordAppStart == start && p.keywordAppEnd == opEnd {
+			if e, ok := p.keywordAppAnnotation(op); ok {
+				return e, true
+			}
+			p.pos = opEnd
+		}
+	} else if p.pos = start; p.peek() == '[' {
+		if e, ok := p.emptyList(); ok {
+			return e, true
+		}
+	}
+	if !opOk {
+		p.expect("expression")
+		return nil, false
+	}
+	p.pos = opEnd
+	p.whitespace()
+	if p.char(':') && p.whitespace1() {
+		if a, ok := p.expression(); ok {
+			return Annot{Expr: op, Annotation: a}, true
+		}
+	}
+	p.pos = opEnd
+	return op, true
+}
+
+// lambda parses a lambda, or a forall if pi is true.
+func (p *parser) lambda(pi bool) (Term, bool) {
+	if pi {
+		if !p.either("forall", "∀") {
+			return nil, false
+		}
+	} else if !p.either(`\`, "λ") {
+		return nil, false
+	}
+	p.whitespace()
+	if !p.char('(') {
+		return nil, false
+	}
+	p.whitespace()
+	labelStart := p.pos
+	label, ok := p.nonreservedLabel()
+	if !ok {
+		return nil, false
+	}
+	p.addBinder(label, labelStart)
+	p.whitespace()
+	if !p.char(':') || !p.whitespace1() {
+		return nil, false
+	}
+	t, ok := p.expression()
+	if !ok {
+		return nil, false
+	}
+	p.whitespace()
+	if !p.char(')') {
+		return nil, false
+	}
+	p.whitespace()
+	if !p.arrow() {
+		return nil, false
+	}
+	p.whitespace()
+	body, ok := p.expression()
+	if !ok {
+		return nil, false
+	}
+	if pi {
+		return PiTerm{Label: label, Type: t, Body: body}, true
+	}
+	return LambdaTerm{Label: label, Type: t, Body: body}, true
+}
+
+// ifThenElse parses the rest of an if expression, after the `if`.
+func (p *parser) ifThenElse() (Term, bool) {
+	cond, ok := p.expression()
+	if !ok {
+		return nil, false
+	}
+	p.whitespace()
+	if !p.lit("then") || !p.whitespace1() {
+		return nil, false
+	}
+	t, ok := p.expression()
+	if !ok {
+		return nil, false
+	}
+	p.whitespace()
+	if !p.lit("else") || !p.whitespace1() {
+		return nil, false
+	}
+	f, ok := p.expression()
+	if !ok {
+		return nil, false
+	}
+	return IfTerm{Cond: cond, T: t, F: f}, true
+}
+
+func (p *parser) let() (Term, bool) {
+	var bindings []Binding
+	for {
+		start := p.pos
+		b, ok := p.letBinding()
+		if !ok {
+			p.pos = start
+			break
+		}
+		bindings = append(bindings, b)
+	}
+	if len(bindings) == 0 {
+		return nil, false
+	}
+	if !p.hasPrefix("in") {
+		p.expectHint("`in`", hintMissingIn)
+		return nil, false
+	}
+	p.pos += len("in")
+	if !p.whitespace1() {
+		return nil, false
+	}
+	body, ok := p.expression()
+	if !ok {
+		return nil, false
+	}
+	return NewLet(body, bindings...), true
+}
+
+func (p *parser) letBinding() (Binding, bool) {
+	if !p.keyword("let") {
+		p.expect("`let`")
+		return Binding{}, false
+	}
+	labelStart := p.pos
+	label, ok := p.nonreservedLabel()
+	if !ok {
+		return Binding{}, false
+	}
+	p.addBinder(label, labelStart)
+	b := Binding{Variable: label}
+	p.whitespace()
+	if p.peek() == ':' {
+		start := p.pos
+		p.pos++
+		if p.whitespace1() {
+			if a, ok := p.expression(); ok {
+				b.Annotation = a
+				p.whitespace()
+			} else {
+				p.pos = start
+			}
+		} else {
+			p.pos = start
+		}
+	}
+	if p.peek() != '=' {
+		p.expect("`=` after let binding name")
+		return Binding{}, false
+	}
+	p.pos++
+	p.whitespace()
+	if b.Value, ok = p.expression(); !ok {
+		return Binding{}, false
+	}
+	p.whitespace()
+	return b, true
+}
+
+// keywordAppAnnotation parses the annotation of `merge h u : T` or
+// `toMap e : T`, where op is the Merge or ToMap before the
+// annotation.
+func (p *parser) keywordAppAnnotation(op Term) (Term, bool) {
+	p.whitespace()
+	if !p.char(':') || !p.whitespace1() {
+		return nil, false
+	}
+	a, ok := p.applicationExpression()
+	if !ok {
+		return nil, false
+	}
+	switch op := op.(type) {
+	case Merge:
+		op.Annotation = a
+		return op, true
+	case ToMap:
+		op.Type = a
+		return op, true
+	}
+	return nil, false
+}
+
+func (p *parser) emptyList() (Term, bool) {
+	p.pos++ // '['
+	p.whitespace()
+	if p.peek() == ',' {
+		p.pos++
+		p.whitespace()
+	}
+	if !p.char(']') {
+		return nil, false
+	}
+	p.whitespace()
+	if !p.char(':') || !p.whitespace1() {
+		return nil, false
+	}
+	t, ok := p.applicationExpression()
+	if !ok {
+		return nil, false
+	}
+	return EmptyList{Type: t}, true
+}
+
+// An operator is a binary operator which may appear in an operator
+// expression.
+type operator struct {
+	opCode int
+	// prec is the precedence; operators with higher precedence
+	// bind more tightly
+	prec int
+	// spaced is true if the operator must be followed by
+	// whitespace
+	spaced bool
+}
+
+var (
+	importAltOperator    = operator{ImportAltOp, 0, true}
+	orOperator           = operator{OrOp, 1, false}
+	plusOperator         = operator{PlusOp, 2, true}
+	textAppendOperator   = operator{TextAppendOp, 3, false}
+	listAppendOperator   = operator{ListAppendOp, 4, false}
+	andOperator          = operator{AndOp, 5, false}
+	combineOperator      = operator{RecordMergeOp, 6, false}
+	preferOperator       = operator{RightBiasedRecordMergeOp, 7, false}
+	combineTypesOperator = operator{RecordTypeMergeOp, 8, false}
+	timesOperator        = operator{TimesOp, 9, false}
+	equalOperator        = operator{EqOp, 10, false}
+	notEqualOperator     = operator{NeOp, 11, false}
+	equivalentOperator   = operator{EquivOp, 12, false}
+)
+
+// operator returns the operator at the current position and its
+// length in bytes, or 0 if there isn't one.  Where one operator is a
+// prefix of another, such as + and ++, the longer one is returned.
+func (p *parser) operator() (operator, int) {
+	switch p.peek() {
+	case '?':
+		return importAltOperator, 1
+	case '|':
+		if p.hasPrefix("||") {
+			return orOperator, 2
+		}
+	case '+':
+		if p.hasPrefix("++") {
+			return textAppendOperator, 2
+		}
+		return plusOperator, 1
+	case '#':
+		return listAppendOperator, 1
+	case '&':
+		if p.hasPrefix("&&") {
+			return andOperator, 2
+		}
+	case '/':
+		switch {
+		case p.hasPrefix(`//\\`):
+			return combineTypesOperator, 4
+		case p.hasPrefix(`/\`):
+			return combineOperator, 2
+		case p.hasPrefix("//"):
+			return preferOperator, 2
+		}
+	case '*':
+		return timesOperator, 1
+	case '=':
+		switch {
+		case p.hasPrefix("==="):
+			return equivalentOperator, 3
+		case p.hasPrefix("=="):
+			return equalOperator, 2
+		}
+	case '!':
+		if p.hasPrefix("!=") {
+			return notEqualOperator, 2
+		}
+	case "∧"[0]:
+		for _, op := range unicodeOperators {
+			if p.hasPrefix(op.text) {
+				return op.operator, len(op.text)
+			}
+		}
+	}
+	return operator{}, 0
+}
+
+// unicodeOperators are the operators with Unicode spellings, all of
+// which start with the same byte in UTF-8
+var unicodeOperators = []struct {
+	text string
+	operator
+}{
+	{"∧", combineOperator},
+	{"⫽", preferOperator},
+	{"⩓", combineTypesOperator},
+	{"≡", equivalentOperator},
+}
+
+// operatorExpression parses application expressions separated by
+// binary operators.
+func (p *parser) operatorExpression() (Term, bool) {
+	start := p.pos
+	first, ok := p.applicationExpression()
+	if !ok {
+		return nil, false
+	}
+	e, _ := p.operators(first, start, 0)
+	return e, true
+}
+
+// operators parses the rest of an operator expression whose first
+// operand is left, which starts at leftStart, as long as the
+// operators have at least precedence minPrec.  It returns true if it
+// stopped at an operator without a valid right operand; nothing can
+// follow that operator, so callers with lower precedence should stop
+// too.
+func (p *parser) operators(left Term, leftStart, minPrec int) (Term, bool) {
+	for {
+		start := p.pos
+		p.whitespace()
+		op, n := p.operator()
+		if n == 0 || op.prec < minPrec {
+			p.pos = start
+			return left, false
+		}
+		p.pos += n
+		if op.spaced {
+			if !p.whitespace1() {
+				p.pos = start
+				return left, true
+			}
+		} else {
+			p.whitespace()
+		}
+		rightStart := p.pos
+		right, ok := p.applicationExpression()
+		if !ok {
+			p.pos = start
+			return left, true
+		}
+		right, stop := p.operators(right, rightStart, op.prec+1)
+		left = OpTerm{OpCode: op.opCode, L: left, R: right}
+		p.addNode(left, leftStart)
+		if stop {
+			return left, true
+		}
+	}
+}
+
+func (p *parser) applicationExpression() (Term, bool) {
+	appStart := p.pos
+	e, ok := p.firstApplicationExpression()
+	if !ok {
+		return nil, false
+	}
+	for {
+		start := p.pos
+		if !p.whitespace1() {
+			break
+		}
+		arg, ok := p.importExpression()
+		if !ok {
+			p.pos = start
+			break
+		}
+		e = AppTerm{Fn: e, Arg: arg}
+		p.addNode(e, appStart)
+	}
+	return e, true
+}
+
+func (p *parser) firstApplicationExpression() (Term, bool) {
+	start := p.pos
+	switch {
+	case p.keyword("merge"):
+		if h, ok := p.importExpression(); ok && p.whitespace1() {
+			if u, ok := p.importExpression(); ok {
+				p.keywordAppStart, p.keywordAppEnd = start, p.pos
+				p.addNode(Merge{Handler: h, Union: u}, start)
+				return Merge{Handler: h, Union: u}, true
+			}
+		}
+		p.pos = start
+	case p.keyword("Some"):
+		if e, ok := p.importExpression(); ok {
+			p.addNode(Some{Val: e}, start)
+			return Some{Val: e}, true
+		}
+		p.pos = start
+	case p.keyword("toMap"):
+		if e, ok := p.importExpression(); ok {
+			p.keywordAppStart, p.keywordAppEnd = start, p.pos
+			p.addNode(ToMap{Record: e}, start)
+			return ToMap{Record: e}, true
+		}
+		p.pos = start
+	}
+	return p.importExpression()
+}
+
+func (p *parser) importExpression() (Term, bool) {
+	if p.startsImport() {
+		start := p.pos
+		if i, ok := p.importTerm(); ok {
+			p.addNode(i, start)
+			return i, true
+		}
+		p.pos = start
+	}
+	return p.completionExpression()
+}
+
+func (p *parser) completionExpression() (Term, bool) {
+	eStart := p.pos
+	e, ok := p.selectorExpression()
+	if !ok {
+		return nil, false
+	}
+	if p.hasPrefix("::") {
+		start := p.pos
+		p.pos += 2
+		if r, ok := p.selectorExpression(); ok {
+			e = OpTerm{OpCode: CompleteOp, L: e, R: r}
+			p.addNode(e, eStart)
+			return e, true
+		}
+		p.pos = start
+	}
+	return e, true
+}
+
+func (p *parser) selectorExpression() (Term, bool) {
+	eStart := p.pos
+	e, ok := p.primitiveExpression()
+	if !ok {
+		return nil, false
+	}
+	for {
+		start := p.pos
+		p.whitespace()
+		if p.peek() != '.' {
+			p.pos = start
+			return e, true
+		}
+		p.pos++
+		p.whitespace()
+		switch p.peek() {
+		case '{':
+			labels, ok := p.labels()
+			if !ok {
+				p.pos = start
+				return e, true
+			}
+			e = Project{Record: e, FieldNames: labels}
+			p.addNode(e, eStart)
+		case '(':
+			p.pos++
+			p.whitespace()
+			t, ok := p.expression()
+			if ok {
+				p.whitespace()
+				ok = p.char(')')
+			}
+			if !ok {
+				p.pos = start
+				return e, true
+			}
+			e = ProjectType{Record: e, Selector: t}
+			p.addNode(e, eStart)
+		default:
+			label, ok := p.label()
+			if !ok {
+				p.pos = start
+				return e, true
+			}
+			e = Field{Record: e, FieldName: label}
+			p.addNode(e, eStart)
+		}
+	}
+}
+
+// labels parses the braced labels of a projection, such as
+// `{ a, b }`.
+func (p *parser) labels() ([]string, bool) {
+	p.pos++ // '{'
+	p.whitespace()
+	labels := []string{}
+	if label, ok := p.label(); ok {
+		labels = append(labels, label)
+		p.whitespace()
+		for {
+			start := p.pos
+			if !p.char(',') {
+				break
+			}
+			p.whitespace()
+			p.trailingComma('}', "label")
+			label, ok := p.label()
+			if !ok {
+				p.pos = start
+				break
+			}
+			labels = append(labels, label)
+			p.whitespace()
+		}
+	}
+	if !p.char('}') {
+		return nil, false
+	}
+	return labels, true
+}
+
+func (p *parser) primitiveExpression() (Term, bool) {
+	start := p.pos
+	e, ok := p.primitiveExpression1()
+	if ok {
+		p.addNode(e, start)
+	}
+	return e, ok
+}
+
+func (p *parser) primitiveExpression1() (Term, bool) {
+	switch c := p.peek(); {
+	case isDigit(c) || c == '+' || c == '-':
+		return p.numericLiteral()
+	case c == 'I' && p.hasPrefix("Infinity"), c == 'N' && p.hasPrefix("NaN"):
+		return p.numericLiteral()
+	case c == '"':
+		return p.doubleQuoteLiteral()
+	case c == '\'':
+		return p.singleQuoteLiteral()
+	case c == '{':
+		return p.record()
+	case c == '<':
+		return p.union()
+	case c == '[':
+		return p.nonEmptyList()
+	case c == '(':
+		p.pos++
+		p.whitespace()
+		if p.peek() == '|' {
+			p.pos++
+			p.whitespace()
+		}
+		e, ok := p.expression()
+		if !ok {
+			return nil, false
+		}
+		p.whitespace()
+		if !p.char(')') {
+			return nil, false
+		}
+		return e, true
+	}
+	return p.identifier()
+}
+
+// identifier parses a variable or a builtin.
+func (p *parser) identifier() (Term, bool) {
+	if p.peek() != '`' {
+		end := p.word()
+		word := string(p.src[p.pos:end])
+		if builtin, ok := reserved[word]; ok {
+			p.pos = end
+			return builtin, true
+		}
+		if !isLabelStart(p.peek()) || keywords[word] {
+			p.expect("expression")
+			return nil, false
+		}
+	}
+	name, ok := p.label()
+	if !ok {
+		return nil, false
+	}
+	v := Var{Name: name}
+	start := p.pos
+	p.whitespace()
+	if p.peek() == '@' {
+		p.pos++
+		p.whitespace()
+		if isDigit(p.peek()) {
+			index, _ := p.naturalLiteral()
+			v.Index = int(index)
+			return v, true
+		}
+	}
+	p.pos = start
+	return v, true
+}
+
+func (p *parser) record() (Term, bool) {
+	p.pos++ // '{'
+	p.whitespace()
+	if p.peek() == ',' {
+		p.pos++
+		p.whitespace()
+	}
+	var r Term
+	if p.peek() == '=' {
+		p.pos++
+		r = RecordLit{}
+	} else if name, ok := p.label(); ok {
+		afterName := p.pos
+		if t, ok := p.recordTypeField(); ok {
+			r = p.recordType(name, t)
+		} else {
+			p.pos = afterName
+			r = p.recordLit(name, p.recordLitField(name))
+		}
+	} else {
+		r = RecordType{}
+	}
+	p.whitespace()
+	if !p.char('}') {
+		return nil, false
+	}
+	return r, true
+}
+
+// recordTypeField parses the rest of a record type field, after
+// its name.
+func (p *parser) recordTypeField() (Term, bool) {
+	p.whitespace()
+	if p.peek() == '=' {
+		p.expectHint("`:`", hintEqualsInRecordType)
+	}
+	if !p.char(':') || !p.whitespace1() {
+		return nil, false
+	}
+	return p.expression()
+}
+
+// recordType parses the rest of a record type, after its first
+// field.
+func (p *parser) recordType(name string, t Term) Term {
+	content := RecordType{name: t}
+	duplicate := ""
+	for {
+		start := p.pos
+		p.whitespace()
+		if !p.char(',') {
+			p.pos = start
+			break
+		}
+		p.whitespace()
+		p.trailingComma('}', "label")
+		name, ok := p.label()
+		if !ok {
+			p.pos = start
+			break
+		}
+		t, ok := p.recordTypeField()
+		if !ok {
+			p.pos = start
+			break
+		}
+		if _, ok := content[name]; ok {
+			if duplicate == "" {
+				duplicate = name
+			}
+			continue
+		}
+		content[name] = t
+	}
+	if duplicate != "" {
+		return DuplicateLabel{Term: content, Label: duplicate}
+	}
+	return content
+}
+
+// recordLitField parses the rest of a record literal field, after
+// its name.  If there's no value, the field is a pun: `{ x }` means
+// `{ x = x }`.
+func (p *parser) recordLitField(name string) Term {
+	start := p.pos
+	p.whitespace()
+	if p.peek() == ':' {
+		p.expectHint("`=`", hintColonInRecordLit)
+	}
+	if p.char('=') {
+		p.whitespace()
+		if v, ok := p.expression(); ok {
+			return v
+		}
+	}
+	p.pos = start
+	return Var{Name: name}
+}
+
+// recordLit parses the rest of a record literal, after its first
+// field.
+func (p *parser) recordLit(name string, v Term) Term {
+	content := RecordLit{name: v}
+	for {
+		start := p.pos
+		p.whitespace()
+		if !p.char(',') {
+			p.pos = start
+			break
+		}
+		p.whitespace()
+		p.trailingComma('}', "label")
+		name, ok := p.label()
+		if !ok {
+			p.pos = start
+			break
+		}
+		v := p.recordLitField(name)
+		if existing, ok := content[name]; ok {
+			content[name] = OpTerm{OpCode: RecordMergeOp, L: existing, R: v}
+			continue
+		}
+		content[name] = v
+	}
+	return content
+}
+
+func (p *parser) union() (Term, bool) {
+	p.pos++ // '<'
+	p.whitespace()
+	if p.peek() == '|' {
+		p.pos++
+		p.whitespace()
+	}
+	alternatives := UnionType{}
+	duplicate := ""
+	if name, ok := p.label(); ok {
+		alternatives[name] = p.unionAlternative()
+		for {
+			start := p.pos
+			p.whitespace()
+			if !p.char('|') {
+				p.pos = start
+				break
+			}
+			p.whitespace()
+			name, ok := p.label()
+			if !ok {
+				p.pos = start
+				break
+			}
+			t := p.unionAlternative()
+			if _, ok := alternatives[name]; ok {
+				if duplicate == "" {
+					duplicate = name
+				}
+				continue
+			}
+			alternatives[name] = t
+		}
+	}
+	p.whitespace()
+	if !p.char('>') {
+		return nil, false
+	}
+	if duplicate != "" {
+		return DuplicateLabel{Term: alternatives, Label: duplicate}, true
+	}
+	return alternatives, true
+}
+
+// unionAlternative parses the type of a union alternative, after its
+// name.  It returns nil for an alternative without one.
+func (p *parser) unionAlternative() Term {
+	start := p.pos
+	p.whitespace()
+	if p.char(':') && p.whitespace1() {
+		if t, ok := p.expression(); ok {
+			return t
+		}
+	}
+	p.pos = start
+	return nil
+}
+
+func (p *parser) nonEmptyList() (Term, bool) {
+	p.pos++ // '['
+	p.whitespace()
+	if p.peek() == ',' {
+		p.pos++
+		p.whitespace()
+	}
+	first, ok := p.expression()
+	if !ok {
+		return nil, false
+	}
+	list := NonEmptyList{first}
+	p.whitespace()
+	for p.peek() == ',' {
+		start := p.pos
+		p.pos++
+		p.whitespace()
+		p.trailingComma(']', "expression")
+		e, ok := p.expression()
+		if !ok {
+			p.pos = start
+			break
+		}
+		list = append(list, e)
+		p.whitespace()
+	}
+	if !p.char(']') {
+		return nil, false
+	}
+	return list, true
+}
+
+// trailingComma records a hint if the list or record which has just
+// had a comma ends with close, rather than with the next element.
+func (p *parser) trailingComma(close byte, element string) {
+	if p.peek() == close {
+		p.expectHint(element, hintTrailingComma)
+	}
+}
@@ -0,0 +1,385 @@
+package parser
+
+import (
+	"encoding/hex"
+	"errors"
+	"net"
+	"strings"
+	"unicode/utf8"
+
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/internal"
+)
+
+// startsImport reports whether an import might start at the current
+// position.
+func (p *parser) startsImport() bool {
+	switch p.peek() {
+	case '.', '~', '/':
+		return true
+	case 'm':
+		return p.hasPrefix("missing")
+	case 'h':
+		return p.hasPrefix("http")
+	case 'e':
+		return p.hasPrefix("env:")
+	}
+	return false
+}
+
+// importTerm parses an import, with its optional hash and mode.
+func (p *parser) importTerm() (Term, bool) {
+	var fetchable Fetchable
+	var ok bool
+	switch p.peek() {
+	case 'm':
+		p.pos += len("missing")
+		fetchable, ok = Missing{}, true
+	case 'h':
+		fetchable, ok = p.http()
+	case 'e':
+		fetchable, ok = p.env()
+	default:
+		fetchable, ok = p.local()
+	}
+	if !ok {
+		return nil, false
+	}
+	i := Import{ImportHashed: ImportHashed{Fetchable: fetchable}, ImportMode: Code}
+	start := p.pos
+	if p.whitespace1() && p.hasPrefix("sha256:") {
+		p.pos += len("sha256:")
+		if i.Hash, ok = p.hash(); !ok {
+			return nil, false
+		}
+	} else {
+		p.pos = start
+	}
+	start = p.pos
+	p.whitespace()
+	if p.hasPrefix("as") {
+		p.pos += len("as")
+		if p.whitespace1() {
+			switch {
+			case p.hasPrefix("Text"):
+				p.pos += len("Text")
+				i.ImportMode = RawText
+				return i, true
+			case p.hasPrefix("Location"):
+				p.pos += len("Location")
+				i.ImportMode = Location
+				return i, true
+			}
+		}
+	}
+	p.pos = start
+	return i, true
+}
+
+// hash parses the hex digits of a sha256 hash, returning them as a
+// multihash.
+func (p *parser) hash() ([]byte, bool) {
+	end := p.pos
+	for end < len(p.src) && end < p.pos+64 && isHexDigit(p.src[end]) {
+		end++
+	}
+	if end < p.pos+64 {
+		p.pos = end
+		p.expect("hex digit")
+		return nil, false
+	}
+	digest := make([]byte, 32)
+	if _, err := hex.Decode(digest, p.src[p.pos:end]); err != nil {
+		p.fail(err)
+	}
+	p.pos = end
+	return append([]byte{0x12, 0x20}, digest...), true
+}
+
+func (p *parser) local() (Fetchable, bool) {
+	var prefix string
+	switch {
+	case p.hasPrefix(".."):
+		prefix = ".."
+	case p.hasPrefix("."):
+		prefix = "."
+	case p.hasPrefix("~"):
+		prefix = "~"
+	default:
+		prefix = "/"
+	}
+	if prefix != "/" {
+		p.pos += len(prefix)
+	}
+	var components []string
+	for p.peek() == '/' {
+		start := p.pos
+		p.pos++
+		component, ok := p.pathComponent()
+		if !ok {
+			p.pos = start
+			break
+		}
+		components = append(components, component)
+	}
+	if len(components) == 0 {
+		p.expect("path")
+		return nil, false
+	}
+	return NewLocal(prefix, components), true
+}
+
+// pathComponent parses a path component after its leading slash.  It
+// is either a run of path characters or a quoted string.
+func (p *parser) pathComponent() (string, bool) {
+	start := p.pos
+	if p.peek() == '"' {
+		p.pos++
+		for {
+			c := p.peek()
+			if c == '"' || c == '/' || c < 0x20 {
+				break
+			}
+			if c < 0x80 {
+				p.pos++
+				continue
+			}
+			r, size := utf8.DecodeRune(p.src[p.pos:])
+			if !isValidNonASCII(r) {
+				break
+			}
+			p.pos += size
+		}
+		if p.pos == start+1 || !p.char('"') {
+			return "", false
+		}
+		return string(p.src[start+1 : p.pos-1]), true
+	}
+	for isPathChar(p.peek()) {
+		p.pos++
+	}
+	if p.pos == start {
+		p.expect("path component")
+		return "", false
+	}
+	return string(p.src[start:p.pos]), true
+}
+
+func isPathChar(c byte) bool {
+	switch {
+	case c == '!', c >= '$' && c <= '\'', c == '*', c == '+', c == '-', c == '.',
+		c >= '0' && c <= ';', c == '=', c >= '@' && c <= 'Z', c >= '^' && c <= 'z',
+		c == '|', c == '~':
+		return true
+	}
+	return false
+}
+
+// isPChar reports whether c may appear in a URL path segment or
+// query, other than as part of a percent-encoding.
+func isPChar(c byte) bool {
+	return isAlpha(c) || isDigit(c) || strings.IndexByte("-._~!$&'*+;=:@", c) >= 0
+}
+
+// pctEncoded consumes a percent-encoded byte, such as %20.
+func (p *parser) pctEncoded() bool {
+	if p.peek() == '%' && p.pos+2 < len(p.src) && isHexDigit(p.src[p.pos+1]) && isHexDigit(p.src[p.pos+2]) {
+		p.pos += 3
+		return true
+	}
+	return false
+}
+
+func (p *parser) http() (Fetchable, bool) {
+	start := p.pos
+	p.pos += len("http")
+	if p.peek() == 's' {
+		p.pos++
+	}
+	if !p.lit("://") {
+		return nil, false
+	}
+	p.authority()
+	// the path is a sequence of components, which may be quoted,
+	// empty or made of path characters
+	for p.peek() == '/' {
+		p.pos++
+		if p.peek() == '"' {
+			componentStart := p.pos - 1
+			if _, ok := p.pathComponent(); ok {
+				continue
+			}
+			p.pos = componentStart + 1
+		}
+		for isPathChar(p.peek()) {
+			p.pos++
+		}
+	}
+	if p.peek() == '?' {
+		p.pos++
+		for {
+			if c := p.peek(); isPChar(c) || c == '/' || c == '?' {
+				p.pos++
+			} else if !p.pctEncoded() {
+				break
+			}
+		}
+	}
+	text := string(p.src[start:p.pos])
+	remote, err := ParseRemote(internal.EscapeQuotedPathComponents(text))
+	if err != nil {
+		p.fail(err)
+	}
+	end := p.pos
+	p.whitespace()
+	if p.hasPrefix("using") {
+		p.pos += len("using")
+		if p.whitespace1() {
+			// a chain of using clauses nests without going
+			// through expression
+			p.enter()
+			_, ok := p.importExpression()
+			p.leave()
+			if ok {
+				p.fail(errors.New("dhall-golang does not support ❰using❱ clauses"))
+				return remote, true
+			}
+		}
+	}
+	p.pos = end
+	return remote, true
+}
+
+// authority consumes the authority of a URL, which has optional
+// userinfo and port.  It may be empty.
+func (p *parser) authority() {
+	start := p.pos
+	for {
+		if c := p.peek(); isPChar(c) && c != '@' {
+			p.pos++
+		} else if !p.pctEncoded() {
+			break
+		}
+	}
+	if p.peek() == '@' {
+		p.pos++
+	} else {
+		p.pos = start
+	}
+	if p.peek() == '[' {
+		p.ipLiteral()
+	} else {
+		for {
+			if c := p.peek(); isPChar(c) && c != '@' && c != ':' {
+				p.pos++
+			} else if !p.pctEncoded() {
+				break
+			}
+		}
+	}
+	if p.peek() == ':' {
+		p.pos++
+		p.digits()
+	}
+}
+
+// ipLiteral consumes a bracketed IPv6 address, such as [::1], or a
+// bracketed address of a future version, such as [v7.a:b].
+func (p *parser) ipLiteral() {
+	start := p.pos
+	p.pos++
+	if c := p.peek(); c == 'v' || c == 'V' {
+		p.ipvFuture(start)
+		return
+	}
+	for isHexDigit(p.peek()) {
+		p.pos++
+	}
+	if p.peek() != ':' {
+		p.pos = start
+		return
+	}
+	for c := p.peek(); isHexDigit(c) || c == ':' || c == '.'; c = p.peek() {
+		p.pos++
+	}
+	if p.peek() != ']' {
+		p.pos = start
+		return
+	}
+	if net.ParseIP(string(p.src[start+1:p.pos])) == nil {
+		p.fail(errors.New("Malformed IPv6 address"))
+	}
+	p.pos++
+}
+
+// ipvFuture consumes the rest of a bracketed address of a future
+// version, after the [ at start.
+func (p *parser) ipvFuture(start int) {
+	p.pos++
+	digits := p.pos
+	for isHexDigit(p.peek()) {
+		p.pos++
+	}
+	if p.pos == digits || p.peek() != '.' {
+		p.pos = start
+		return
+	}
+	p.pos++
+	address := p.pos
+	for c := p.peek(); isPChar(c) && c != '@'; c = p.peek() {
+		p.pos++
+	}
+	if p.pos == address || p.peek() != ']' {
+		p.pos = start
+		return
+	}
+	p.pos++
+}
+
+func (p *parser) env() (Fetchable, bool) {
+	p.pos += len("env:")
+	if p.peek() == '"' {
+		return p.posixEnvVar()
+	}
+	start := p.pos
+	if !isLabelStart(p.peek()) {
+		p.expect("environment variable")
+		return nil, false
+	}
+	for c := p.peek(); isAlpha(c) || isDigit(c) || c == '_'; c = p.peek() {
+		p.pos++
+	}
+	return EnvVar(p.src[start:p.pos]), true
+}
+
+var posixEscapes = map[byte]byte{
+	'"': '"', '\\': '\\', 'a': '\a', 'b': '\b',
+	'f': '\f', 'n': '\n', 'r': '\r', 't': '\t', 'v': '\v',
+}
+
+func (p *parser) posixEnvVar() (Fetchable, bool) {
+	p.pos++ // '"'
+	var name strings.Builder
+	for {
+		c := p.peek()
+		switch {
+		case c == '\\':
+			p.pos++
+			escaped, ok := posixEscapes[p.peek()]
+			if !ok {
+				p.expect("escape sequence")
+				return nil, false
+			}
+			name.WriteByte(escaped)
+			p.pos++
+		case c >= 0x20 && c <= 0x7e && c != '"' && c != '=':
+			name.WriteByte(c)
+			p.pos++
+		default:
+			if name.Len() == 0 || !p.char('"') {
+				return nil, false
+			}
+			return EnvVar(name.String()), true
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package parser
+
+import (
+	"unicode/utf8"
+
+	. "github.com/philandstuff/dhall-golang/core"
+)
+
+// This file holds the lexical parts of the grammar: whitespace,
+// comments, labels and keywords.
+
+// peek returns the byte at the current position, or 0 at the end of
+// input.
+func (p *parser) peek() byte {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+func (p *parser) hasPrefix(s string) bool {
+	return len(p.src)-p.pos >= len(s) && string(p.src[p.pos:p.pos+len(s)]) == s
+}
+
+// lit consumes s if it is next in the input.
+func (p *parser) lit(s string) bool {
+	if p.hasPrefix(s) {
+		p.pos += len(s)
+		return true
+	}
+	if p.pos >= p.failPos {
+		p.expect("`" + s + "`")
+	}
+	return false
+}
+
+// char consumes c if it is next in the input.
+func (p *parser) char(c byte) bool {
+	if p.peek() == c {
+		p.pos++
+		return true
+	}
+	if p.pos >= p.failPos {
+		p.expect("`" + string(c) + "`")
+	}
+	return false
+}
+
+// either consumes whichever of the ASCII and Unicode spellings of a
+// symbol, such as -> and →, is next in the input.
+func (p *parser) either(ascii, unicode string) bool {
+	if p.hasPrefix(ascii) {
+		p.pos += len(ascii)
+		return true
+	}
+	if p.hasPrefix(unicode) {
+		p.pos += len(unicode)
+		return true
+	}
+	if p.pos >= p.failPos {
+		p.expect("`" + ascii + "`")
+	}
+	return false
+}
+
+func (p *parser) arrow() bool { return p.either("->", "→") }
+
+// whitespace skips any whitespace and comments.
+func (p *parser) whitespace() {
+	for p.whitespaceChunk() {
+	}
+}
+
+// whitespace1 skips whitespace and comments, and fails if there
+// aren't any.
+func (p *parser) whitespace1() bool {
+	if !p.whitespaceChunk() {
+		return false
+	}
+	p.whitespace()
+	return true
+}
+
+func (p *parser) whitespaceChunk() bool {
+	switch p.peek() {
+	case ' ', '\t', '\n':
+		p.pos++
+		return true
+	case '\r':
+		if p.hasPrefix("\r\n") {
+			p.pos += 2
+			return true
+		}
+	case '-':
+		if p.hasPrefix("--") {
+			return p.lineComment()
+		}
+	case '{':
+		if p.hasPrefix("{-") {
+			return p.blockComment()
+		}
+	}
+	return false
+}
+
+// eol consumes a newline, which may be written \r\n.
+func (p *parser) eol() bool {
+	switch {
+	case p.peek() == '\n':
+		p.pos++
+		return true
+	case p.hasPrefix("\r\n"):
+		p.pos += 2
+		return true
+	}
+	return false
+}
+
+func (p *parser) lineComment() bool {
+	start := p.pos
+	p.pos += len("--")
+	if !p.restOfLine() {
+		p.pos = start
+		return false
+	}
+	return true
+}
+
+// shebang consumes a line starting with #!, so that a file can be
+// run as a script.
+func (p *parser) shebang() bool {
+	if !p.hasPrefix("#!") {
+		return false
+	}
+	start := p.pos
+	p.pos += len("#!")
+	if !p.restOfLine() {
+		p.pos = start
+		return false
+	}
+	return true
+}
+
+// restOfLine consumes the rest of a comment or shebang line, up to
+// and including the newline.
+func (p *parser) restOfLine() bool {
+	for p.pos < len(p.src) {
+		if p.eol() {
+			return true
+		}
+		r, size := utf8.DecodeRune(p.src[p.pos:])
+		if !isPrintable(r) && r != '\t' {
+			break
+		}
+		p.pos += size
+	}
+	p.expect("end of line")
+	return false
+}
+
+func (p *parser) blockComment() bool {
+	start := p.pos
+	p.pos += len("{-")
+	for depth := 1; p.pos < len(p.src); {
+		switch {
+		case p.hasPrefix("-}"):
+			p.pos += 2
+			depth--
+			if depth == 0 {
+				return true
+			}
+			continue
+		case p.hasPrefix("{-"):
+			p.pos += 2
+			depth++
+			continue
+		case p.eol():
+			continue
+		}
+		r, size := utf8.DecodeRune(p.src[p.pos:])
+		if !isPrintable(r) && r != '\t' {
+			break
+		}
+		p.pos += size
+	}
+	p.expect("`-}`")
+	p.pos = start
+	return false
+}
+
+// isPrintable reports whether r is printable ASCII or valid
+// non-ASCII, which is what may appear in comments and text literals
+func isPrintable(r rune) bool {
+	return (r >= 0x20 && r <= 0x7f) || isValidNonASCII(r)
+}
+
+// isValidNonASCII reports whether r is a non-ASCII character which
+// may appear in Dhall source: anything but a surrogate or a
+// non-character at the end of a plane.
+func isValidNonASCII(r rune) bool {
+	switch {
+	case r < 0x80:
+		return false
+	case r <= 0xd7ff:
+		return true
+	case r < 0xe000:
+		return false
+	}
+	return r&0xfffe != 0xfffe && r <= utf8.MaxRune
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func isAlpha(c byte) bool { return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+
+func isLabelStart(c byte) bool { return isAlpha(c) || c == '_' }
+
+func isLabelChar(c byte) bool {
+	return isAlpha(c) || isDigit(c) || c == '_' || c == '/' || c == '-'
+}
+
+// keywords are the words which can't be simple labels.  `assert` is
+// missing because our grammar allows it as a label: `assert : T` is
+// recognised by expression before labels are tried.
+var keywords = map[string]bool{
+	"if": true, "then": true, "else": true,
+	"let": true, "in": true,
+	"using": true, "missing": true, "as": true,
+	"True": true, "False": true,
+	"Infinity": true, "NaN": true,
+	"merge": true, "Some": true, "toMap": true,
+}
+
+// reserved are the names of builtins, which can't be variables
+// unless they are quoted.
+var reserved = map[string]Term{
+	"Natural/build":     NaturalBuild,
+	"Natural/fold":      NaturalFold,
+	"Natural/isZero":    NaturalIsZero,
+	"Natural/even":      NaturalEven,
+	"Natural/odd":       NaturalOdd,
+	"Natural/toInteger": NaturalToInteger,
+	"Natural/show":      NaturalShow,
+	"Natural/subtract":  NaturalSubtract,
+	"Integer/toDouble":  IntegerToDouble,
+	"Integer/show":      IntegerShow,
+	"Double/show":       DoubleShow,
+	"List/build":        ListBuild,
+	"List/fold":         ListFold,
+	"List/length":       ListLength,
+	"List/head":         ListHead,
+	"List/last":         ListLast,
+	"List/indexed":      ListIndexed,
+	"List/reverse":      ListReverse,
+	"Optional/build":    OptionalBuild,
+	"Optional/fold":     OptionalFold,
+	"Text/show":         TextShow,
+	"Bool":              Bool,
+	"True":              True,
+	"False":             False,
+	"Optional":          Optional,
+	"Natural":           Natural,
+	"Integer":           Integer,
+	"Double":            Double,
+	"Text":              Text,
+	"List":              List,
+	"None":              None,
+	"Type":              Type,
+	"Kind":              Kind,
+	"Sort":              Sort,
+}
+
+// startsWord reports whether the next character could be part of a
+// label or text literal.
+func (p *parser) startsWord() bool {
+	return p.pos < len(p.src) && (isLabelChar(p.peek()) || p.peek() == '\'')
+}
+
+// word returns the end of the run of label characters starting at
+// the current position.
+func (p *parser) word() int {
+	end := p.pos
+	for end < len(p.src) && isLabelChar(p.src[end]) {
+		end++
+	}
+	return end
+}
+
+// label parses a quoted label, or a simple label which isn't a
+// keyword.
+func (p *parser) label() (string, bool) {
+	if p.peek() == '`' {
+		return p.quotedLabel()
+	}
+	if !isLabelStart(p.peek()) {
+		p.expect("label")
+		return "", false
+	}
+	end := p.word()
+	if keywords[string(p.src[p.pos:end])] {
+		p.expect("label")
+		return "", false
+	}
+	label := string(p.src[p.pos:end])
+	p.pos = end
+	return label, true
+}
+
+func (p *parser) quotedLabel() (string, bool) {
+	start := p.pos + 1
+	end := start
+	for end < len(p.src) && p.src[end] >= 0x20 && p.src[end] <= 0x7e && p.src[end] != '`' {
+		end++
+	}
+	if end == start || end == len(p.src) || p.src[end] != '`' {
+		p.pos = end
+		p.expect("closing backtick")
+		return "", false
+	}
+	p.pos = end + 1
+	return string(p.src[start:end]), true
+}
+
+// nonreservedLabel parses a label which isn't the name of a builtin,
+// unless it is quoted.
+func (p *parser) nonreservedLabel() (string, bool) {
+	if p.peek() != '`' {
+		if _, ok := reserved[string(p.src[p.pos:p.word()])]; ok {
+			p.expect("label")
+			return "", false
+		}
+	}
+	return p.label()
+}
+
+// keyword consumes kw followed by whitespace.
+func (p *parser) keyword(kw string) bool {
+	start := p.pos
+	if p.hasPrefix(kw) {
+		p.pos += len(kw)
+		if p.whitespace1() {
+			return true
+		}
+	}
+	p.pos = start
+	return false
+}
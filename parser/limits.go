@@ -0,0 +1,51 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/philandstuff/dhall-golang/core"
+)
+
+// ErrLimit is returned, wrapped in an Error, by ParseWithLimits when
+// the input exceeds its Limits.  Use errors.Is to check for it.
+var ErrLimit = errors.New("parser limit exceeded")
+
+// ParseWithLimits parses Dhall source b into a Term, like Parse, but
+// fails with an ErrLimit error if b is too large or too deeply
+// nested, so that hostile input can't exhaust memory or the stack.
+//
+// It takes the same Limits as core.EvalWithLimits and
+// core.TypeOfWithLimits.  Size limits the length of b in bytes, and
+// Depth limits how deeply expressions may be nested, for example
+// inside parentheses, records, lists or the bodies of functions.
+// Steps is ignored.  A zero field means that resource is unlimited.
+func ParseWithLimits(filename string, b []byte, limits Limits) (interface{}, error) {
+	p := &parser{filename: filename, src: b, limits: limits}
+	if limits.Size != 0 && len(b) > limits.Size {
+		p.pos = limits.Size
+		return nil, p.limitError("input is longer than %d bytes", limits.Size)
+	}
+	return p.parse()
+}
+
+// limitPanic unwinds the parser when a limit is exceeded; it is
+// recovered by parse.
+type limitPanic struct{ err Error }
+
+func (p *parser) limitError(format string, args ...interface{}) Error {
+	err := p.errorf("%v: %s", ErrLimit, fmt.Sprintf(format, args...))
+	err.Err = ErrLimit
+	return err
+}
+
+// enter records that parsing has gone one level deeper into nested
+// expressions.
+func (p *parser) enter() {
+	p.depth++
+	if p.limits.Depth != 0 && p.depth > p.limits.Depth {
+		panic(limitPanic{p.limitError("expression nested more than %d deep", p.limits.Depth)})
+	}
+}
+
+func (p *parser) leave() { p.depth-- }
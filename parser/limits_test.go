@@ -0,0 +1,53 @@
+package parser_test
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// nested returns open + "x" + close, with open and close repeated n
+// times
+func nested(open, close string, n int) []byte {
+	return []byte(strings.Repeat(open, n) + "x" + strings.Repeat(close, n))
+}
+
+var _ = Describe("ParseWithLimits", func() {
+	It("Gives up on deeply nested parentheses", func() {
+		_, err := parser.ParseWithLimits("test", nested("(", ")", 1000000), Limits{Depth: 100})
+		Expect(errors.Is(err, parser.ErrLimit)).To(BeTrue())
+		Expect(err.(parser.Error).Column).To(Equal(101))
+	})
+	It("Gives up on deeply nested records", func() {
+		_, err := parser.ParseWithLimits("test", nested("{ a = ", " }", 10000), Limits{Depth: 100})
+		Expect(errors.Is(err, parser.ErrLimit)).To(BeTrue())
+	})
+	It("Gives up on long chains of using clauses", func() {
+		input := strings.Repeat("https://example.com using ", 1000) + "x"
+		_, err := parser.ParseWithLimits("test", []byte(input), Limits{Depth: 100})
+		Expect(errors.Is(err, parser.ErrLimit)).To(BeTrue())
+	})
+	It("Gives up on input which is too large", func() {
+		_, err := parser.ParseWithLimits("test", []byte("[ 1, 2, 3 ]"), Limits{Size: 5})
+		Expect(errors.Is(err, parser.ErrLimit)).To(BeTrue())
+		Expect(err.(parser.Error).Offset).To(Equal(5))
+	})
+	It("Matches Parse within the limits", func() {
+		input := nested("[ ", " ]", 50)
+		expected, err := parser.Parse("test", input)
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := parser.ParseWithLimits("test", input, Limits{Size: 1000, Depth: 100})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+	})
+	It("Reports errors other than limits as usual", func() {
+		_, err := parser.ParseWithLimits("test", []byte("[ 1, ]"), Limits{Depth: 100})
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, parser.ErrLimit)).To(BeFalse())
+	})
+})
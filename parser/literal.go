@@ -0,0 +1,299 @@
+package parser
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/internal"
+)
+
+// numericLiteral parses a Double, Natural or Integer literal.
+func (p *parser) numericLiteral() (Term, bool) {
+	start := p.pos
+	switch {
+	case p.hasPrefix("Infinity"):
+		p.pos += len("Infinity")
+		return DoubleLit(math.Inf(1)), true
+	case p.hasPrefix("-Infinity"):
+		p.pos += len("-Infinity")
+		return DoubleLit(math.Inf(-1)), true
+	case p.hasPrefix("NaN"):
+		p.pos += len("NaN")
+		return DoubleLit(math.NaN()), true
+	}
+	signed := p.peek() == '+' || p.peek() == '-'
+	if signed {
+		p.pos++
+	}
+	if !isDigit(p.peek()) {
+		p.expect("digit")
+		p.pos = start
+		return nil, false
+	}
+	p.digits()
+	isDouble := false
+	if p.peek() == '.' && p.pos+1 < len(p.src) && isDigit(p.src[p.pos+1]) {
+		p.pos++
+		p.digits()
+		isDouble = true
+	}
+	if p.exponent() {
+		isDouble = true
+	}
+	text := string(p.src[start:p.pos])
+	if isDouble {
+		d, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			p.failAt(start, fmt.Errorf("Double literal %s is out of range", text))
+		}
+		return DoubleLit(d), true
+	}
+	i, err := strconv.Atoi(text)
+	if signed {
+		if err != nil {
+			p.failAt(start, fmt.Errorf("Integer literal %s is out of range", text))
+		}
+		return IntegerLit(i), true
+	}
+	if err != nil {
+		p.failAt(start, fmt.Errorf("Natural literal %s is too large", text))
+	}
+	return NaturalLit(i), true
+}
+
+// naturalLiteral parses a Natural literal, such as the index of a
+// variable.
+func (p *parser) naturalLiteral() (NaturalLit, bool) {
+	start := p.pos
+	if !isDigit(p.peek()) {
+		p.expect("digit")
+		return 0, false
+	}
+	p.digits()
+	text := string(p.src[start:p.pos])
+	i, err := strconv.Atoi(text)
+	if err != nil {
+		p.failAt(start, fmt.Errorf("Natural literal %s is too large", text))
+	}
+	return NaturalLit(i), true
+}
+
+func (p *parser) digits() {
+	for isDigit(p.peek()) {
+		p.pos++
+	}
+}
+
+// exponent consumes the exponent of a Double literal, such as e-5,
+// if there is one.
+func (p *parser) exponent() bool {
+	if c := p.peek(); c != 'e' && c != 'E' {
+		return false
+	}
+	start := p.pos
+	p.pos++
+	if c := p.peek(); c == '+' || c == '-' {
+		p.pos++
+	}
+	if !isDigit(p.peek()) {
+		p.pos = start
+		return false
+	}
+	p.digits()
+	return true
+}
+
+// A textBuilder accumulates the chunks of a text literal.
+type textBuilder struct {
+	str    strings.Builder
+	chunks Chunks
+}
+
+func (b *textBuilder) interpolate(e Term) {
+	b.chunks = append(b.chunks, Chunk{Prefix: b.str.String(), Expr: e})
+	b.str.Reset()
+}
+
+func (b *textBuilder) term() TextLitTerm {
+	return TextLitTerm{Chunks: b.chunks, Suffix: b.str.String()}
+}
+
+// interpolation parses `${ e }`.  It fails without recording
+// anything, because a `$` which doesn't start an interpolation is
+// just a character.
+func (p *parser) interpolation() (Term, bool) {
+	if !p.hasPrefix("${") {
+		return nil, false
+	}
+	start := p.pos
+	p.pos += 2
+	p.whitespace()
+	if e, ok := p.expression(); ok {
+		p.whitespace()
+		if p.char('}') {
+			return e, true
+		}
+	}
+	p.pos = start
+	return nil, false
+}
+
+func (p *parser) doubleQuoteLiteral() (Term, bool) {
+	p.pos++ // '"'
+	var b textBuilder
+	for {
+		if e, ok := p.interpolation(); ok {
+			b.interpolate(e)
+			continue
+		}
+		switch c := p.peek(); {
+		case c == '"':
+			p.pos++
+			return b.term(), true
+		case c == '\\':
+			p.pos++
+			if !p.escape(&b.str) {
+				return nil, false
+			}
+			continue
+		case c >= 0x20 && c <= 0x7f:
+			b.str.WriteByte(c)
+			p.pos++
+			continue
+		}
+		r, size := utf8.DecodeRune(p.src[p.pos:])
+		if p.pos == len(p.src) || !isValidNonASCII(r) {
+			p.expect("`\"`")
+			return nil, false
+		}
+		b.str.WriteRune(r)
+		p.pos += size
+	}
+}
+
+// escape parses the rest of an escape sequence in a double-quoted
+// literal, after the backslash, and writes what it stands for to str.
+func (p *parser) escape(str *strings.Builder) bool {
+	c := p.peek()
+	switch c {
+	case '"', '$', '\\', '/':
+		str.WriteByte(c)
+	case 'b':
+		str.WriteByte('\b')
+	case 'f':
+		str.WriteByte('\f')
+	case 'n':
+		str.WriteByte('\n')
+	case 'r':
+		str.WriteByte('\r')
+	case 't':
+		str.WriteByte('\t')
+	case 'u':
+		p.pos++
+		return p.unicodeEscape(str)
+	default:
+		p.expect("escape sequence")
+		return false
+	}
+	p.pos++
+	return true
+}
+
+// unicodeEscape parses the hex digits of a \u escape, which are
+// either exactly four digits or any number in braces.
+func (p *parser) unicodeEscape(str *strings.Builder) bool {
+	start, end := p.pos, p.pos
+	if p.peek() == '{' {
+		start++
+		end = start
+		for end < len(p.src) && isHexDigit(p.src[end]) {
+			end++
+		}
+		if end == start || end == len(p.src) || p.src[end] != '}' {
+			p.pos = end
+			p.expect("hex digit")
+			return false
+		}
+		p.pos = end + 1
+	} else {
+		for end < start+4 && end < len(p.src) && isHexDigit(p.src[end]) {
+			end++
+		}
+		if end < start+4 {
+			p.pos = end
+			p.expect("hex digit")
+			return false
+		}
+		p.pos = end
+	}
+	text := string(p.src[start:end])
+	i, err := strconv.ParseInt(text, 16, 32)
+	if err != nil {
+		p.fail(err)
+		return true
+	}
+	if r := rune(i); !validCodepoint(r) {
+		p.fail(fmt.Errorf("%s is not a valid unicode code point", text))
+	} else {
+		str.WriteRune(r)
+	}
+	return true
+}
+
+func validCodepoint(r rune) bool {
+	return utf8.ValidRune(r) && r&0xfffe != 0xfffe
+}
+
+func (p *parser) singleQuoteLiteral() (Term, bool) {
+	start := p.pos
+	if !p.lit("''") {
+		return nil, false
+	}
+	if !p.eol() {
+		p.expectHint("newline", hintSingleQuoteStart)
+		return nil, false
+	}
+	var b textBuilder
+	for {
+		if e, ok := p.interpolation(); ok {
+			b.interpolate(e)
+			continue
+		}
+		switch {
+		case p.hasPrefix("'''"):
+			b.str.WriteString("''")
+			p.pos += 3
+			continue
+		case p.hasPrefix("''${"):
+			b.str.WriteString("${")
+			p.pos += 4
+			continue
+		case p.hasPrefix("''"):
+			p.pos += 2
+			p.singleQuoteEnd = p.pos
+			return internal.RemoveLeadingCommonIndent(b.term()), true
+		case p.peek() == '\t':
+			b.str.WriteByte('\t')
+			p.pos++
+			continue
+		case p.eol():
+			b.str.WriteByte('\n')
+			continue
+		}
+		r, size := utf8.DecodeRune(p.src[p.pos:])
+		if p.pos == len(p.src) {
+			p.expectHint("`''`", hintUnterminated(p.position(start)))
+			return nil, false
+		}
+		if !isPrintable(r) {
+			p.expect("`''`")
+			return nil, false
+		}
+		b.str.WriteRune(r)
+		p.pos += size
+	}
+}
@@ -0,0 +1,167 @@
+package parser
+
+import (
+	. "github.com/philandstuff/dhall-golang/core"
+)
+
+// A Node is a part of the source which a Term was parsed from.
+// Nodes are for tools, such as editors, which need to know where
+// things are in the source; Terms don't record it.
+type Node struct {
+	// Term is the Term which was parsed, or nil if the Node is the
+	// name bound by a let binding, a λ or a ∀.
+	Term Term
+	// Binder is the bound name, if Term is nil.
+	Binder string
+	// Start and End are the byte offsets of the start of the Node
+	// and of the end of it.
+	Start, End int
+	// Children are the Nodes inside this one, in source order.
+	// Only expressions, their operands and arguments, imports,
+	// selections and bound names have Nodes, so not every part of
+	// Term has a child.
+	Children []*Node
+}
+
+// ParseNode parses Dhall source like Parse, and returns the Node of
+// the whole expression.  Its Start is after any shebang lines,
+// comments and whitespace which begin the source.
+func ParseNode(filename string, b []byte) (*Node, error) {
+	p := &parser{filename: filename, src: b, recording: true}
+	if _, err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.nodes[len(p.nodes)-1], nil
+}
+
+// Path returns the Nodes which contain offset, starting with n and
+// ending with the innermost one, or nil if n doesn't contain offset.
+// A Node contains its start offset and its end offset, so that
+// tools can find the Node just before a cursor.
+func (n *Node) Path(offset int) []*Node {
+	if offset < n.Start || offset > n.End {
+		return nil
+	}
+	path := []*Node{n}
+	for {
+		var next *Node
+		for _, child := range n.Children {
+			if offset >= child.Start && offset <= child.End {
+				next = child
+				if offset < child.End {
+					break
+				}
+			}
+		}
+		if next == nil {
+			return path
+		}
+		path = append(path, next)
+		n = next
+	}
+}
+
+// A LetBinding is the Nodes of a binding of a let expression.
+type LetBinding struct {
+	// Binder is the Node of the bound name.
+	Binder *Node
+	// Annotation is the Node of the type annotation, or nil if the
+	// binding has none.
+	Annotation *Node
+	// Value is the Node of the bound value.
+	Value *Node
+}
+
+// LetBindings returns the Nodes of the bindings of n, whose Term is a
+// Let, in the order of its Bindings, and the Node of its body.  It
+// returns false if n isn't a let or doesn't have the children of one:
+// the name, any annotation and the value of each binding, and then
+// the body.
+func (n *Node) LetBindings() ([]LetBinding, *Node, bool) {
+	let, ok := n.Term.(Let)
+	if !ok {
+		return nil, nil, false
+	}
+	bindings := make([]LetBinding, len(let.Bindings))
+	i := 0
+	for k, b := range let.Bindings {
+		end := i + 2
+		if b.Annotation != nil {
+			end++
+		}
+		if end > len(n.Children) || n.Children[i].Term != nil {
+			return nil, nil, false
+		}
+		bindings[k].Binder = n.Children[i]
+		if b.Annotation != nil {
+			bindings[k].Annotation = n.Children[i+1]
+		}
+		bindings[k].Value = n.Children[end-1]
+		i = end
+	}
+	if i+1 != len(n.Children) {
+		return nil, nil, false
+	}
+	return bindings, n.Children[i], true
+}
+
+// addNode adds a Node for t, parsed from start to the current
+// position, when the parser is recording Nodes.  The Nodes recorded
+// since start become its children.
+//
+// Rather than having every alternative which fails throw away its
+// Nodes, addNode discards the Nodes which can't be part of the
+// result: those which start at or after the current position.
+func (p *parser) addNode(t Term, start int) {
+	p.pushNode(&Node{Term: t, Start: start, End: p.pos})
+}
+
+// addBinder adds a Node for the name bound by a let binding, a λ
+// or a ∀, parsed from start to the current position.
+func (p *parser) addBinder(name string, start int) {
+	p.pushNode(&Node{Binder: name, Start: start, End: p.pos})
+}
+
+// pushNode adds n to the recorded Nodes, making the Nodes within it
+// its children.
+func (p *parser) pushNode(n *Node) {
+	if !p.recording {
+		return
+	}
+	p.discardNodes(n.End)
+	i := len(p.nodes)
+	for i > 0 && p.nodes[i-1].Start >= n.Start {
+		i--
+	}
+	// a failed alternative may have left Nodes which overlap the
+	// ones which matched, so we keep the latest Nodes which fit
+	var children []*Node
+	end := n.End
+	for j := len(p.nodes) - 1; j >= i; j-- {
+		if child := p.nodes[j]; child.End <= end {
+			children = append(children, child)
+			end = child.Start
+		}
+	}
+	if len(children) == 1 && children[0].Start == n.Start && children[0].End == n.End {
+		// the same text, such as an operand which is a single
+		// argument; the inner Node is enough
+		p.nodes = append(p.nodes[:i], children[0])
+		return
+	}
+	for l, r := 0, len(children)-1; l < r; l, r = l+1, r-1 {
+		children[l], children[r] = children[r], children[l]
+	}
+	n.Children = children
+	p.nodes = append(p.nodes[:i], n)
+}
+
+// discardNodes discards the recorded Nodes which start at or after
+// offset.  The parser has backtracked over them.
+func (p *parser) discardNodes(offset int) {
+	i := len(p.nodes)
+	for i > 0 && p.nodes[i-1].Start >= offset {
+		i--
+	}
+	p.nodes = p.nodes[:i]
+}
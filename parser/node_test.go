@@ -0,0 +1,118 @@
+package parser_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// outline describes n and its children, one per line, by the type of
+// their Term and their source text.
+func outline(source string, n *parser.Node) string {
+	var b strings.Builder
+	var walk func(n *parser.Node, depth int)
+	walk = func(n *parser.Node, depth int) {
+		what := fmt.Sprintf("%T", n.Term)
+		if n.Term == nil {
+			what = "binder " + n.Binder
+		}
+		fmt.Fprintf(&b, "%s%s %s\n", strings.Repeat("  ", depth), what, source[n.Start:n.End])
+		for _, child := range n.Children {
+			walk(child, depth+1)
+		}
+	}
+	walk(n, 0)
+	return b.String()
+}
+
+func parseNode(source string) *parser.Node {
+	n, err := parser.ParseNode("-", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	return n
+}
+
+var _ = Describe("ParseNode", func() {
+	It("records where each part of the expression is", func() {
+		source := "-- header\nlet f = λ(n : Natural) → n + 1\nin  f x.a"
+		Expect(outline(source, parseNode(source))).To(Equal(`core.Let let f = λ(n : Natural) → n + 1
+in  f x.a
+  binder f f
+  core.LambdaTerm λ(n : Natural) → n + 1
+    binder n n
+    core.Builtin Natural
+    core.OpTerm n + 1
+      core.Var n
+      core.NaturalLit 1
+  core.AppTerm f x.a
+    core.Var f
+    core.Field x.a
+      core.Var x
+`))
+	})
+	It("returns the same Term as Parse", func() {
+		source := `if ifx then merge { A = 1 } < A >.A : Natural else (x.{a}) ? ./foo.dhall`
+		term, err := parser.Parse("-", []byte(source))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parseNode(source).Term).To(Equal(term))
+	})
+	It("forgets alternatives which didn't match", func() {
+		source := `[ assert : x ≡ y, assertion, forall x ]`
+		Expect(outline(source, parseNode(source))).To(Equal(`core.NonEmptyList [ assert : x ≡ y, assertion, forall x ]
+  core.Assert assert : x ≡ y
+    core.OpTerm x ≡ y
+      core.Var x
+      core.Var y
+  core.Var assertion
+  core.AppTerm forall x
+    core.Var forall
+    core.Var x
+`))
+	})
+	It("fails like Parse", func() {
+		_, err := parser.ParseNode("-", []byte(`let x = in x`))
+		Expect(err).To(BeAssignableToTypeOf(parser.Error{}))
+	})
+	Describe("LetBindings", func() {
+		It("finds the parts of each binding and the body", func() {
+			source := "let a = 1\nlet b : Natural = a\nin  b"
+			bindings, body, ok := parseNode(source).LetBindings()
+			Expect(ok).To(BeTrue())
+			Expect(bindings).To(HaveLen(2))
+			text := func(n *parser.Node) string { return source[n.Start:n.End] }
+			Expect(text(bindings[0].Binder)).To(Equal("a"))
+			Expect(bindings[0].Annotation).To(BeNil())
+			Expect(text(bindings[0].Value)).To(Equal("1"))
+			Expect(text(bindings[1].Binder)).To(Equal("b"))
+			Expect(text(bindings[1].Annotation)).To(Equal("Natural"))
+			Expect(text(bindings[1].Value)).To(Equal("a"))
+			Expect(text(body)).To(Equal("b"))
+		})
+		It("fails on Nodes which aren't lets", func() {
+			_, _, ok := parseNode("λ(x : Natural) → x").LetBindings()
+			Expect(ok).To(BeFalse())
+		})
+	})
+	Describe("Path", func() {
+		It("finds the Nodes around an offset", func() {
+			source := `λ(x : Natural) → x + 1`
+			n := parseNode(source)
+			var found []string
+			for _, node := range n.Path(strings.Index(source, "1")) {
+				found = append(found, source[node.Start:node.End])
+			}
+			Expect(found).To(Equal([]string{source, "x + 1", "1"}))
+			Expect(n.Path(len(source))).To(HaveLen(3))
+			Expect(n.Path(len(source) + 1)).To(BeNil())
+		})
+		It("finds a Node which ends at the offset", func() {
+			source := `f x`
+			path := parseNode(source).Path(1)
+			Expect(path[len(path)-1].Term).To(Equal(Var{Name: "f"}))
+		})
+	})
+})
@@ -0,0 +1,219 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	. "github.com/philandstuff/dhall-golang/core"
+)
+
+// Parse parses Dhall source b into a Term.  filename is used only in
+// error messages.  If b isn't valid Dhall, the error is an Error.
+//
+// The returned value is always a Term; it is an interface{} for
+// compatibility with earlier versions of this package.
+func Parse(filename string, b []byte) (interface{}, error) {
+	p := &parser{filename: filename, src: b}
+	return p.parse()
+}
+
+// ParseFile parses the Dhall source in the named file into a Term.
+func ParseFile(filename string) (interface{}, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(filename, b)
+}
+
+// ParseReader parses the Dhall source read from r into a Term.
+// filename is used only in error messages.
+func ParseReader(filename string, r io.Reader) (interface{}, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(filename, b)
+}
+
+// A parser holds the state of a single parse.
+//
+// The parsing methods follow the grammar in the standard,
+// dhall-lang/standard/dhall.abnf, and each returns false if it doesn't
+// match.  A method which fails may leave pos anywhere; a caller which
+// wants to try something else must restore pos itself.
+type parser struct {
+	filename string
+	src      []byte
+	pos      int
+
+	// failPos is the farthest position at which anything failed to
+	// match, and expected describes what would have matched there.
+	// When the parse fails, this is the error we report.
+	failPos  int
+	expected []string
+	// hint, if not empty, suggests a fix for the failure at failPos
+	hint string
+
+	// singleQuoteEnd is the end of the most recent `''` text
+	// literal, which may have been closed too early
+	singleQuoteEnd int
+
+	// err is the first error found in text which otherwise
+	// matched, such as a Natural literal which is too large.
+	err error
+
+	// keywordAppStart and keywordAppEnd are the extent of the most
+	// recently parsed `merge h u` or `toMap e`; see expression.
+	keywordAppStart, keywordAppEnd int
+
+	limits Limits
+	// depth is how deeply nested the current expression is
+	depth int
+
+	// recording is true if the parser records Nodes, in which case
+	// nodes are the outermost Nodes recorded so far; see addNode
+	recording bool
+	nodes     []*Node
+}
+
+func (p *parser) parse() (t Term, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			limit, ok := r.(limitPanic)
+			if !ok {
+				panic(r)
+			}
+			t, err = nil, limit.err
+		}
+	}()
+	if !utf8.Valid(p.src) {
+		p.pos = invalidUTF8Offset(p.src)
+		return nil, p.errorf("invalid UTF-8 encoding")
+	}
+	for p.shebang() {
+	}
+	p.whitespace()
+	e, ok := p.expression()
+	if ok {
+		p.whitespace()
+		if p.pos < len(p.src) {
+			p.expect("end of input")
+			ok = false
+		}
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	if !ok {
+		p.pos = p.failPos
+		err := p.errorf("expected %s", describe(p.expected))
+		err.Hint = p.hint
+		if err.Hint == "" && p.failPos == p.singleQuoteEnd && p.startsWord() {
+			// the literal was probably meant to go on, as in
+			// ''\nit''s'', where the author forgot to escape
+			// the quotes
+			err.Hint = hintSingleQuoteEnd
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+// expect records that what would have matched at the current
+// position.  Callers which have to build what may check p.failPos
+// first, since expectations before it are discarded.
+func (p *parser) expect(what string) {
+	if p.pos < p.failPos {
+		return
+	}
+	if p.pos > p.failPos {
+		p.failPos = p.pos
+		p.expected = p.expected[:0]
+		p.hint = ""
+	}
+	for _, e := range p.expected {
+		if e == what {
+			return
+		}
+	}
+	p.expected = append(p.expected, what)
+}
+
+// expectHint records that what would have matched at the current
+// position, and that hint may help the user fix their mistake.
+func (p *parser) expectHint(what, hint string) {
+	p.expect(what)
+	if p.pos == p.failPos && p.hint == "" {
+		p.hint = hint
+	}
+}
+
+// fail records err, found at the current position, unless an error
+// has already been recorded.  Parsing carries on regardless.
+func (p *parser) fail(err error) {
+	p.failAt(p.pos, err)
+}
+
+// failAt is like fail, for an error found at offset.
+func (p *parser) failAt(offset int, err error) {
+	if p.err == nil {
+		pos := p.pos
+		p.pos = offset
+		p.err = p.errorf("%v", err)
+		p.pos = pos
+	}
+}
+
+// errorf returns an Error at the current position.
+func (p *parser) errorf(format string, args ...interface{}) Error {
+	line, col := p.position(p.pos)
+	lineStart := strings.LastIndexByte(string(p.src[:p.pos]), '\n') + 1
+	lineEnd := len(p.src)
+	if i := strings.IndexByte(string(p.src[p.pos:]), '\n'); i >= 0 {
+		lineEnd = p.pos + i
+	}
+	return Error{
+		Filename:   p.filename,
+		Line:       line,
+		Column:     col,
+		Offset:     p.pos,
+		Message:    fmt.Sprintf(format, args...),
+		SourceLine: strings.TrimSuffix(string(p.src[lineStart:lineEnd]), "\r"),
+	}
+}
+
+// position returns the line and column, counting from 1, of offset.
+func (p *parser) position(offset int) (line, col int) {
+	line = 1 + strings.Count(string(p.src[:offset]), "\n")
+	lineStart := strings.LastIndexByte(string(p.src[:offset]), '\n') + 1
+	col = 1 + utf8.RuneCount(p.src[lineStart:offset])
+	return line, col
+}
+
+func describe(expected []string) string {
+	sorted := append([]string(nil), expected...)
+	sort.Strings(sorted)
+	switch len(sorted) {
+	case 0:
+		return "valid Dhall"
+	case 1:
+		return sorted[0]
+	}
+	return strings.Join(sorted[:len(sorted)-1], ", ") + " or " + sorted[len(sorted)-1]
+}
+
+func invalidUTF8Offset(b []byte) int {
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		if r == utf8.RuneError && size == 1 {
+			return i
+		}
+		i += size
+	}
+	return len(b)
+}
@@ -0,0 +1,8 @@
+/*
+Package printer renders Terms as Dhall source.
+
+The output parses back to the Term it was printed from.  Comments
+and the choice between equivalent spellings, such as `\` and `λ`,
+are not recorded in a Term, so they are not preserved.
+*/
+package printer
@@ -0,0 +1,134 @@
+package printer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// A doc is a document which can be laid out in different ways,
+// following Wadler's "A prettier printer".  It is one of text, line,
+// hardline, cat, nest or group.
+type doc interface{}
+
+type (
+	// text is printed as it is; it mustn't contain newlines
+	text string
+	// line is a newline followed by indentation, or flat if its
+	// group fits on one line
+	line struct{ flat string }
+	// hardline is always a newline
+	hardline struct{}
+	cat      []doc
+	// nest indents any lines in doc by n more columns
+	nest struct {
+		n   int
+		doc doc
+	}
+	// group is laid out on one line if it fits, or with all of its
+	// own lines broken otherwise
+	group struct{ doc doc }
+)
+
+var (
+	space    = line{flat: " "}
+	softline = line{flat: ""}
+)
+
+// An item is a doc waiting to be laid out, with the indentation
+// and mode of its enclosing group.
+type item struct {
+	indent int
+	flat   bool
+	doc    doc
+}
+
+// layout lays out d to fit within width columns where it can.
+// Lines never have trailing whitespace: indentation is only written
+// before text.
+func layout(d doc, width int) string {
+	var out strings.Builder
+	col, pending := 0, 0
+	stack := []item{{doc: d}}
+	for len(stack) > 0 {
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		switch d := it.doc.(type) {
+		case text:
+			if d == "" {
+				continue
+			}
+			if pending > 0 {
+				out.WriteString(strings.Repeat(" ", pending))
+				pending = 0
+			}
+			out.WriteString(string(d))
+			col += utf8.RuneCountInString(string(d))
+		case line:
+			if it.flat {
+				stack = append(stack, item{it.indent, true, text(d.flat)})
+				continue
+			}
+			out.WriteByte('\n')
+			col, pending = it.indent, it.indent
+		case hardline:
+			out.WriteByte('\n')
+			col, pending = it.indent, it.indent
+		case cat:
+			for i := len(d) - 1; i >= 0; i-- {
+				stack = append(stack, item{it.indent, it.flat, d[i]})
+			}
+		case nest:
+			stack = append(stack, item{it.indent + d.n, it.flat, d.doc})
+		case group:
+			flat := item{it.indent, true, d.doc}
+			stack = append(stack, flat)
+			if !it.flat && !fits(width-col, stack) {
+				stack[len(stack)-1].flat = false
+			}
+		}
+	}
+	return out.String()
+}
+
+// fits reports whether the items on stack can be laid out in width
+// columns up to the next line break, which is the first line in a
+// group that is not flat.
+func fits(width int, stack []item) bool {
+	var local []item
+	for i := len(stack) - 1; width >= 0; {
+		var it item
+		if n := len(local); n > 0 {
+			it, local = local[n-1], local[:n-1]
+		} else if i >= 0 {
+			it, i = stack[i], i-1
+		} else {
+			return true
+		}
+		switch d := it.doc.(type) {
+		case text:
+			width -= utf8.RuneCountInString(string(d))
+		case line:
+			if !it.flat {
+				return true
+			}
+			width -= utf8.RuneCountInString(d.flat)
+		case hardline:
+			return !it.flat
+		case cat:
+			for j := len(d) - 1; j >= 0; j-- {
+				local = append(local, item{it.indent, it.flat, d[j]})
+			}
+		case nest:
+			local = append(local, item{it.indent + d.n, it.flat, d.doc})
+		case group:
+			local = append(local, item{it.indent, it.flat, d.doc})
+		}
+	}
+	return false
+}
+
+// flatten lays out d on a single line.  d must not contain any
+// hardlines.
+func flatten(d doc) string {
+	return layout(group{d}, int(^uint(0)>>1))
+}
@@ -0,0 +1,630 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/internal"
+)
+
+// DefaultWidth is the line width used by Sprint and Fprint.
+const DefaultWidth = 80
+
+// A Config controls how Terms are printed.
+type Config struct {
+	// Width is the line width to fit the output within.  Terms
+	// which don't fit are broken over several lines, in the style of
+	// `dhall format`.  If Width is zero, Terms are printed on a
+	// single line.
+	Width int
+}
+
+// Sprint returns t as Dhall source, laid out to fit within
+// DefaultWidth columns where it can.
+func Sprint(t Term) string {
+	return Config{Width: DefaultWidth}.Sprint(t)
+}
+
+// Fprint writes t to w as Dhall source, laid out to fit within
+// DefaultWidth columns where it can.
+func Fprint(w io.Writer, t Term) error {
+	return Config{Width: DefaultWidth}.Fprint(w, t)
+}
+
+// Sprint returns t as Dhall source.
+func (c Config) Sprint(t Term) string {
+	p := printer{oneLine: c.Width <= 0}
+	d := p.term(t, exprLevel)
+	if p.oneLine {
+		return flatten(d)
+	}
+	return layout(d, c.Width)
+}
+
+// Fprint writes t to w as Dhall source.
+func (c Config) Fprint(w io.Writer, t Term) error {
+	_, err := io.WriteString(w, c.Sprint(t))
+	return err
+}
+
+// The levels of the Dhall grammar, from the loosest to the tightest
+// binding.  A Term printed where a tighter level is required is
+// parenthesized.
+const (
+	// lambdas, lets, annotations and so on
+	exprLevel = iota
+	// binary operators, other than ::
+	operatorLevel
+	// function application, and merge, Some and toMap
+	applicationLevel
+	// imports and record completion
+	importLevel
+	// field selection and projection
+	selectorLevel
+	// literals, variables and anything else which is self-delimiting
+	primitiveLevel
+)
+
+type printer struct {
+	// oneLine is true if the Term is to be printed on a single line
+	oneLine bool
+}
+
+// operators gives the spelling and precedence of each binary
+// operator, other than ::.  Higher precedences bind more tightly.
+var operators = map[int]struct {
+	text string
+	prec int
+}{
+	ImportAltOp:              {"?", 0},
+	OrOp:                     {"||", 1},
+	PlusOp:                   {"+", 2},
+	TextAppendOp:             {"++", 3},
+	ListAppendOp:             {"#", 4},
+	AndOp:                    {"&&", 5},
+	RecordMergeOp:            {"∧", 6},
+	RightBiasedRecordMergeOp: {"⫽", 7},
+	RecordTypeMergeOp:        {"⩓", 8},
+	TimesOp:                  {"*", 9},
+	EqOp:                     {"==", 10},
+	NeOp:                     {"!=", 11},
+	EquivOp:                  {"≡", 12},
+}
+
+// level returns the grammar level of t, and its precedence if it is
+// an operator.
+func level(t Term) (int, int) {
+	switch t := t.(type) {
+	case LambdaTerm, PiTerm, Let, Annot, IfTerm, Assert, EmptyList:
+		return exprLevel, 0
+	case Merge:
+		if t.Annotation != nil {
+			return exprLevel, 0
+		}
+		return applicationLevel, 0
+	case ToMap:
+		if t.Type != nil {
+			return exprLevel, 0
+		}
+		return applicationLevel, 0
+	case OpTerm:
+		if t.OpCode == CompleteOp {
+			return importLevel, 0
+		}
+		return operatorLevel, operators[t.OpCode].prec
+	case AppTerm, Some:
+		return applicationLevel, 0
+	case Import:
+		return importLevel, 0
+	case Field, Project, ProjectType:
+		return selectorLevel, 0
+	}
+	return primitiveLevel, 0
+}
+
+// term returns the doc for t, parenthesized unless it is at least
+// at level min.
+func (p printer) term(t Term, min int) doc {
+	return p.termPrec(t, min, 0)
+}
+
+// termPrec is like term, but an operator must also have at least
+// precedence minPrec if min is operatorLevel.
+func (p printer) termPrec(t Term, min, minPrec int) doc {
+	lvl, prec := level(t)
+	if lvl < min || lvl == operatorLevel && min == operatorLevel && prec < minPrec {
+		return cat{text("("), nest{1, p.unparenthesized(t)}, text(")")}
+	}
+	return p.unparenthesized(t)
+}
+
+func (p printer) unparenthesized(t Term) doc {
+	switch t := t.(type) {
+	case Universe, Builtin:
+		return text(fmt.Sprint(t))
+	case BoolLit:
+		if t {
+			return text("True")
+		}
+		return text("False")
+	case NaturalLit:
+		return text(fmt.Sprintf("%d", uint(t)))
+	case IntegerLit:
+		if t >= 0 {
+			return text(fmt.Sprintf("+%d", int(t)))
+		}
+		return text(fmt.Sprintf("%d", int(t)))
+	case DoubleLit:
+		return text(t.String())
+	case TextLitTerm:
+		return p.textLit(t)
+	case Var:
+		if t.Index == 0 {
+			return text(nonreservedLabel(t.Name))
+		}
+		return text(fmt.Sprintf("%s@%d", nonreservedLabel(t.Name), t.Index))
+	case LambdaTerm:
+		return p.binder("λ", t.Label, t.Type, t.Body)
+	case PiTerm:
+		if t.Label == "_" {
+			return group{cat{
+				p.termPrec(t.Type, operatorLevel, 0), text(" →"), space,
+				p.term(t.Body, exprLevel),
+			}}
+		}
+		return p.binder("∀", t.Label, t.Type, t.Body)
+	case AppTerm:
+		var args []Term
+		var fn Term = t
+		for app, ok := fn.(AppTerm); ok; app, ok = fn.(AppTerm) {
+			args = append([]Term{app.Arg}, args...)
+			fn = app.Fn
+		}
+		var rest cat
+		for _, arg := range args {
+			rest = append(rest, space, p.term(arg, importLevel))
+		}
+		return group{cat{p.term(fn, applicationLevel), nest{2, rest}}}
+	case OpTerm:
+		return p.operator(t)
+	case Let:
+		return p.let(t)
+	case Annot:
+		expr := p.termPrec(t.Expr, operatorLevel, 0)
+		switch e := t.Expr.(type) {
+		case Merge, ToMap:
+			// `merge h u : T` would be read as a Merge with an
+			// annotation
+			expr = cat{text("("), nest{1, p.unparenthesized(e)}, text(")")}
+		}
+		return group{cat{
+			expr, space, text(": "),
+			nest{2, p.term(t.Annotation, exprLevel)},
+		}}
+	case IfTerm:
+		return group{cat{
+			text("if "), nest{3, p.term(t.Cond, exprLevel)},
+			space, text("then "), nest{5, p.term(t.T, exprLevel)},
+			space, text("else "), nest{5, p.term(t.F, exprLevel)},
+		}}
+	case EmptyList:
+		return cat{text("[] : "), nest{5, p.term(t.Type, applicationLevel)}}
+	case NonEmptyList:
+		elems := make([]doc, len(t))
+		for i, elem := range t {
+			elems[i] = p.term(elem, exprLevel)
+		}
+		return p.bracketed("[ ", ", ", "]", elems)
+	case Some:
+		return group{cat{text("Some"), nest{2, cat{space, p.term(t.Val, importLevel)}}}}
+	case RecordType:
+		if len(t) == 0 {
+			return text("{}")
+		}
+		return p.bracketed("{ ", ", ", "}", p.fields(t, " :", ""))
+	case RecordLit:
+		if len(t) == 0 {
+			return text("{=}")
+		}
+		return p.bracketed("{ ", ", ", "}", p.fields(t, " =", ""))
+	case UnionType:
+		if len(t) == 0 {
+			return text("<>")
+		}
+		return p.bracketed("< ", "| ", ">", p.fields(t, " :", ""))
+	case DuplicateLabel:
+		switch inner := t.Term.(type) {
+		case RecordType:
+			return p.bracketed("{ ", ", ", "}", p.fields(inner, " :", t.Label))
+		case UnionType:
+			return p.bracketed("< ", "| ", ">", p.fields(inner, " :", t.Label))
+		}
+		return p.unparenthesized(t.Term)
+	case ToMap:
+		d := cat{text("toMap "), nest{2, p.term(t.Record, importLevel)}}
+		if t.Type != nil {
+			d = append(d, text(" : "), p.term(t.Type, applicationLevel))
+		}
+		return group{d}
+	case Merge:
+		d := cat{text("merge"), nest{2, cat{
+			space, p.term(t.Handler, importLevel),
+			space, p.term(t.Union, importLevel),
+		}}}
+		if t.Annotation != nil {
+			d = append(d, space, text(": "), nest{2, p.term(t.Annotation, applicationLevel)})
+		}
+		return group{d}
+	case Field:
+		return cat{p.term(t.Record, selectorLevel), text("." + label(t.FieldName))}
+	case Project:
+		names := make([]string, len(t.FieldNames))
+		for i, name := range t.FieldNames {
+			names[i] = label(name)
+		}
+		if len(names) == 0 {
+			return cat{p.term(t.Record, selectorLevel), text(".{}")}
+		}
+		return cat{p.term(t.Record, selectorLevel), text(".{ " + strings.Join(names, ", ") + " }")}
+	case ProjectType:
+		return cat{
+			p.term(t.Record, selectorLevel), text(".("),
+			nest{2, p.term(t.Selector, exprLevel)}, text(")"),
+		}
+	case Assert:
+		return cat{text("assert : "), nest{2, p.term(t.Annotation, exprLevel)}}
+	case Import:
+		return text(importString(t))
+	}
+	panic(fmt.Sprintf("printer: unknown Term type %T", t))
+}
+
+// binder returns the doc for a lambda or forall.
+func (p printer) binder(symbol, label string, t, body Term) doc {
+	return group{cat{
+		text(symbol + "(" + nonreservedLabel(label) + " : "),
+		nest{2, p.term(t, exprLevel)}, text(") →"),
+		nest{2, cat{space, p.term(body, exprLevel)}},
+	}}
+}
+
+// operator returns the doc for an operator expression.  A chain of
+// the same operator is laid out as a single group, with each operand
+// on its own line if it doesn't fit.
+func (p printer) operator(t OpTerm) doc {
+	if t.OpCode == CompleteOp {
+		return cat{p.term(t.L, selectorLevel), text("::"), p.term(t.R, selectorLevel)}
+	}
+	op := operators[t.OpCode]
+	operands := []Term{t.R}
+	l := t.L
+	for lop, ok := l.(OpTerm); ok && lop.OpCode == t.OpCode; lop, ok = l.(OpTerm) {
+		operands = append([]Term{lop.R}, operands...)
+		l = lop.L
+	}
+	d := cat{p.termPrec(l, operatorLevel, op.prec)}
+	for _, operand := range operands {
+		d = append(d, space, text(op.text+" "),
+			nest{len([]rune(op.text)) + 1, p.termPrec(operand, operatorLevel, op.prec+1)})
+	}
+	return group{d}
+}
+
+// let returns the doc for a let expression.  Unless printing on one
+// line, each binding is on its own line, separated by blank lines.
+func (p printer) let(t Let) doc {
+	var d cat
+	for _, b := range t.Bindings {
+		binding := cat{text("let " + nonreservedLabel(b.Variable))}
+		if b.Annotation != nil {
+			binding = append(binding, text(" : "), nest{4, p.term(b.Annotation, exprLevel)})
+		}
+		binding = append(binding, text(" ="), nest{6, cat{space, p.term(b.Value, exprLevel)}})
+		d = append(d, group{binding})
+		if p.oneLine {
+			d = append(d, text(" "))
+		} else {
+			d = append(d, hardline{}, hardline{})
+		}
+	}
+	if p.oneLine {
+		return append(d, text("in "), p.term(t.Body, exprLevel))
+	}
+	return append(d, text("in  "), nest{4, p.term(t.Body, exprLevel)})
+}
+
+// bracketed returns the doc for a list, record or union, in the
+// style of `dhall format`: on one line if it fits, and otherwise with
+// each element on its own line, preceded by sep.
+func (p printer) bracketed(open, sep, close string, elems []doc) doc {
+	// a comma follows the previous element directly, but a bar is
+	// separated from it
+	before := softline
+	if sep == "| " {
+		before = space
+	}
+	d := cat{text(open), nest{2, elems[0]}}
+	for _, elem := range elems[1:] {
+		d = append(d, before, text(sep), nest{2, elem})
+	}
+	return group{append(d, space, text(close))}
+}
+
+// fields returns the docs for the fields of a record or union,
+// sorted by label.  An alternative with a nil type is just its
+// label.  If duplicate is not empty, that field is repeated.
+func (p printer) fields(fields interface{}, sep, duplicate string) []doc {
+	m := reflect.ValueOf(fields)
+	var names []string
+	for _, name := range m.MapKeys() {
+		names = append(names, name.String())
+	}
+	sort.Strings(names)
+	if duplicate != "" {
+		names = append(names, duplicate)
+	}
+	docs := make([]doc, len(names))
+	for i, name := range names {
+		value, _ := m.MapIndex(reflect.ValueOf(name)).Interface().(Term)
+		if value == nil {
+			docs[i] = text(label(name))
+			continue
+		}
+		docs[i] = group{cat{
+			text(label(name) + sep),
+			nest{2, cat{space, p.term(value, exprLevel)}},
+		}}
+	}
+	return docs
+}
+
+// importString returns the source of i.
+func importString(i Import) string {
+	var s string
+	switch f := i.Fetchable.(type) {
+	case EnvVar:
+		s = "env:" + envVarName(string(f))
+	default:
+		s = f.String()
+	}
+	if i.Hash != nil {
+		s += fmt.Sprintf(" sha256:%x", i.Hash[2:])
+	}
+	switch i.ImportMode {
+	case RawText:
+		s += " as Text"
+	case Location:
+		s += " as Location"
+	}
+	return s
+}
+
+var bashEnvVar = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// envVarName quotes name if it isn't a valid bash environment
+// variable name.
+func envVarName(name string) string {
+	if bashEnvVar.MatchString(name) {
+		return name
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range name {
+		switch r {
+		case '"', '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case '\a':
+			b.WriteString(`\a`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\v':
+			b.WriteString(`\v`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+var simpleLabel = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_/-]*$`)
+
+// keywords can't be used as labels without backticks.  forall and
+// assert are included because a label spelled like them would be
+// read as the start of a function type or an assertion.
+var keywords = map[string]bool{
+	"if": true, "then": true, "else": true,
+	"let": true, "in": true,
+	"using": true, "missing": true, "as": true,
+	"True": true, "False": true,
+	"Infinity": true, "NaN": true,
+	"merge": true, "Some": true, "toMap": true,
+	"forall": true, "assert": true,
+}
+
+// label returns name as a label, quoted with backticks if it needs
+// to be.  Labels starting with `missing` are quoted too, since the
+// parser reads them as the missing import.
+func label(name string) string {
+	if simpleLabel.MatchString(name) && !keywords[name] && !strings.HasPrefix(name, "missing") {
+		return name
+	}
+	return "`" + name + "`"
+}
+
+// nonreservedLabel is like label, but also quotes the names of
+// builtins, as required for bound variables.
+func nonreservedLabel(name string) string {
+	if builtins[name] {
+		return "`" + name + "`"
+	}
+	return label(name)
+}
+
+// builtins are the names of Builtins and Universes.
+var builtins = map[string]bool{
+	"Natural/build":     true,
+	"Natural/fold":      true,
+	"Natural/isZero":    true,
+	"Natural/even":      true,
+	"Natural/odd":       true,
+	"Natural/toInteger": true,
+	"Natural/show":      true,
+	"Natural/subtract":  true,
+	"Integer/toDouble":  true,
+	"Integer/show":      true,
+	"Double/show":       true,
+	"List/build":        true,
+	"List/fold":         true,
+	"List/length":       true,
+	"List/head":         true,
+	"List/last":         true,
+	"List/indexed":      true,
+	"List/reverse":      true,
+	"Optional/build":    true,
+	"Optional/fold":     true,
+	"Text/show":         true,
+	"Bool":              true,
+	"Optional":          true,
+	"Natural":           true,
+	"Integer":           true,
+	"Double":            true,
+	"Text":              true,
+	"List":              true,
+	"None":              true,
+	"Type":              true,
+	"Kind":              true,
+	"Sort":              true,
+}
+
+// textLit returns the doc for a text literal.  Text with several
+// lines is printed as a multi-line literal where that represents it
+// exactly.
+func (p printer) textLit(t TextLitTerm) doc {
+	if !p.oneLine && multiline(t) {
+		return p.multilineText(t)
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, chunk := range t.Chunks {
+		b.WriteString(escapeText(chunk.Prefix))
+		b.WriteString("${" + p.interpolation(chunk.Expr) + "}")
+	}
+	b.WriteString(escapeText(t.Suffix))
+	b.WriteByte('"')
+	return text(b.String())
+}
+
+// interpolation returns expr, as it is printed inside `${ }`.
+func (p printer) interpolation(expr Term) string {
+	return flatten(printer{oneLine: true}.term(expr, exprLevel))
+}
+
+func escapeText(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == '"':
+			b.WriteString(`\"`)
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '$' && strings.HasPrefix(s[i:], "${"):
+			b.WriteString(`\$`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20:
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// multiline reports whether t has several lines and can be written
+// exactly as a multi-line literal: it has no carriage returns or
+// other control characters which would need escaping, no lines with
+// a common indent which would be removed, and no single quote which
+// would run into the escape before an interpolation or into the
+// closing quotes.
+func multiline(t TextLitTerm) bool {
+	hasNewline := strings.Contains(t.Suffix, "\n")
+	for _, chunk := range t.Chunks {
+		if !plainLines(chunk.Prefix) || strings.HasSuffix(chunk.Prefix, "'") {
+			return false
+		}
+		hasNewline = hasNewline || strings.Contains(chunk.Prefix, "\n")
+	}
+	if !hasNewline || !plainLines(t.Suffix) || strings.HasSuffix(t.Suffix, "'") {
+		return false
+	}
+	return reflect.DeepEqual(internal.RemoveLeadingCommonIndent(t), t)
+}
+
+// plainLines reports whether s has only printable characters, tabs
+// and newlines, and no `'` before `${`.
+func plainLines(s string) bool {
+	for _, r := range s {
+		if r < 0x20 && r != '\t' && r != '\n' {
+			return false
+		}
+	}
+	return !strings.Contains(s, "'${")
+}
+
+// multilineText returns the doc for t as a multi-line literal.  Its
+// lines are indented to the current nesting level, which is removed
+// again when it is parsed.
+func (p printer) multilineText(t TextLitTerm) doc {
+	d := cat{text("''"), hardline{}}
+	addLines := func(s string) {
+		lines := strings.Split(escapeMultiline(s), "\n")
+		for i, l := range lines {
+			if i > 0 {
+				d = append(d, hardline{})
+			}
+			d = append(d, text(l))
+		}
+	}
+	for _, chunk := range t.Chunks {
+		addLines(chunk.Prefix)
+		d = append(d, text("${"+p.interpolation(chunk.Expr)+"}"))
+	}
+	addLines(t.Suffix)
+	return append(d, text("''"))
+}
+
+// escapeMultiline escapes pairs of single quotes and `${` in the text
+// of a multi-line literal.
+func escapeMultiline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "''"):
+			b.WriteString("'''")
+			i++
+		case strings.HasPrefix(s[i:], "${"):
+			b.WriteString("''${")
+			i++
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
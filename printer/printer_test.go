@@ -0,0 +1,127 @@
+package printer_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+	"github.com/philandstuff/dhall-golang/printer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func parse(source string) Term {
+	term, err := parser.Parse("-", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	return term.(Term)
+}
+
+// RoundTrip checks that input, printed at various widths, parses
+// back to the same Term.
+func RoundTrip(input string) {
+	term := parse(input)
+	for _, width := range []int{0, 80, 20, 1} {
+		printed := printer.Config{Width: width}.Sprint(term)
+		reparsed, err := parser.Parse("-", []byte(printed))
+		Expect(err).ToNot(HaveOccurred(), "width %d:\n%s", width, printed)
+		Expect(reparsed).To(Equal(term), "width %d:\n%s", width, printed)
+	}
+}
+
+var _ = Describe("Sprint", func() {
+	DescribeTable("round trips", RoundTrip,
+		Entry("variables", `[ x, x@2, `+"`if`, `Natural`, `missingx`, `forall`"+`, ifx, _ ]`),
+		Entry("builtins", `[ Natural/fold, Type, Kind, Sort, True, None ]`),
+		Entry("numbers", `[ 1, +2, -3, 4.5, -6e7, Infinity, -Infinity ]`),
+		Entry("natural followed by selector", `1.x`),
+		Entry("text", `"a${b}c\"\$\\\/\b\f\n\r\té\u{1F600}${"d"}"`),
+		Entry("multiline text", "''\n  foo\n  ${bar}\n  '''baz''${qux}\n\n   quux\n  ''"),
+		Entry("multiline text without final newline", "''\n  foo\n    bar''"),
+		Entry("text with common indent", `"  a\n  b"`),
+		Entry("text with quote before interpolation", `"a\n'${x}'"`),
+		Entry("text ending with a quote", `"a\nb'"`),
+		Entry("text with CRLF", `"a\r\nb"`),
+		Entry("multiline text inside a record",
+			"{ a = ''\n  foo\n  bar\n  '', b = [ ''\n  baz\n  '' ] }"),
+		Entry("lambda", `λ(x : Natural) → λ(`+"`Bool`"+` : Type) → x`),
+		Entry("forall", `∀(a : Type) → ∀(_ : a) → a`),
+		Entry("arrows", `(Natural → Bool) → Text → Natural`),
+		Entry("if", `if if a then b else c then (λ(x : T) → x) else f x`),
+		Entry("let", `let x = 1 let y : Natural = let z = 2 in z in x + y`),
+		Entry("let in let", `let x = 1 in let y = x in y`),
+		Entry("operators", `a ? b || c + d ++ e # f && g ∧ h ⫽ i ⩓ j * k == l != m ≡ n`),
+		Entry("operators in reverse", `a ≡ b != c == d * e ⩓ f ⫽ g ∧ h && i # j ++ k + l || m ? n`),
+		Entry("right-nested operators", `a + (b + c) + (d * e) * (f || g)`),
+		Entry("application", `f x y (g z) (Some 1) (merge a b) (x : T) (T::r)`),
+		Entry("application of keyword expressions", `merge x y z (Some 1 2)`),
+		Entry("annotations", `(x : T) : (U : Kind)`),
+		Entry("annotated merge and toMap", `[ (merge x y) : T, (toMap x) : T ]`),
+		Entry("merge", `[ merge x y, merge x y : T, (merge x y : T) z, merge (f x) (g y) : List T ]`),
+		Entry("toMap", `[ toMap x, toMap x : List T, toMap (f x) : List (T U) ]`),
+		Entry("empty list", `[ [] : List Natural, ([] : List Natural) # x ]`),
+		Entry("assert", `assert : 1 + 1 ≡ 2`),
+		Entry("records", `[ {=}, {}, { a : T, b : U }, { a = 1, b = 2 }, { `+"`if`"+` = 1 } ]`),
+		Entry("duplicate record type fields", `{ a : T, a : U }`),
+		Entry("unions", `[ <>, < A >, < A | B : T >, < A : T | A > ]`),
+		Entry("union of imports", `< A : ./a | B : ./b >`),
+		Entry("selectors", `(f x).a.{ b, c }.({ d : T }).{}`),
+		Entry("selection of an import", `(./a).b`),
+		Entry("completion", `[ T::{ a = 1 }, (T::r)::s, (f x)::r ]`),
+		Entry("imports", `[ ./a/b, ../c, ~/d, /e/f, ./"g h"/i, missing, env:HOME, env:"with\nescape" ]`),
+		Entry("remote import", `https://user@example.com:8080/a/"b c"/d?e=f%20g`),
+		Entry("import modes", `[ ./a as Text, ./b as Location, ./c ]`),
+		Entry("hashed import",
+			`./a sha256:0000000000000000000000000000000000000000000000000000000000000000 as Text`),
+		Entry("import alternative", `./a ? ./b ? missing`),
+	)
+	It("round trips the dhall-lang parser tests", func() {
+		files, _ := filepath.Glob("../dhall-lang/tests/parser/success/*A.dhall")
+		files2, _ := filepath.Glob("../dhall-lang/tests/parser/success/*/*A.dhall")
+		for _, file := range append(files, files2...) {
+			input, err := ioutil.ReadFile(file)
+			Expect(err).ToNot(HaveOccurred())
+			if strings.Contains(string(input), "using") {
+				continue
+			}
+			By(file)
+			RoundTrip(string(input))
+		}
+	})
+	It("prints on one line if the width is zero", func() {
+		Expect(printer.Config{}.Sprint(parse(`let x = { a = 1, b = "a\nb" } in x.a`))).To(Equal(
+			`let x = { a = 1, b = "a\nb" } in x.a`))
+	})
+	It("keeps short expressions on one line", func() {
+		Expect(printer.Sprint(parse(`\(x : Natural) -> [ x, x + 1 ]`))).To(Equal(
+			`λ(x : Natural) → [ x, x + 1 ]`))
+	})
+	It("breaks long expressions in the style of dhall format", func() {
+		Expect(printer.Config{Width: 30}.Sprint(parse(
+			`let config = { name = "example", ports = [ 80, 443 ], enabled = True } in config`,
+		))).To(Equal(`let config =
+      { enabled = True
+      , name = "example"
+      , ports = [ 80, 443 ]
+      }
+
+in  config`))
+	})
+	It("prints text with several lines as a multi-line literal", func() {
+		Expect(printer.Sprint(parse(`{ a = "server {\n  listen ${port};\n}\n" }`))).To(Equal(`{ a =
+    ''
+    server {
+      listen ${port};
+    }
+    ''
+}`))
+	})
+	It("writes to a Writer", func() {
+		var b strings.Builder
+		Expect(printer.Fprint(&b, parse(`Some 1`))).To(Succeed())
+		Expect(b.String()).To(Equal("Some 1"))
+	})
+})
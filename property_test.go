@@ -1,7 +1,6 @@
 package dhall_test
 
 import (
-	"fmt"
 	"math"
 	"reflect"
 	"testing"
@@ -12,6 +11,7 @@ import (
 
 	"github.com/philandstuff/dhall-golang/core"
 	"github.com/philandstuff/dhall-golang/parser"
+	"github.com/philandstuff/dhall-golang/printer"
 )
 
 var (
@@ -77,13 +77,12 @@ func TestParseWhatYouWrite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping slow test in short mode")
 	}
-	t.Skip("TODO: This is broken on the nbe branch until we reproduce proper printing")
 	properties := gopter.NewProperties(nil)
 
 	properties.Property("written expressions parse back as themselves",
 		prop.ForAll(
 			func(e core.Term) bool {
-				expr, err := parser.Parse("-", []byte(fmt.Sprint(e)))
+				expr, err := parser.Parse("-", []byte(printer.Sprint(e)))
 				if err != nil {
 					return false
 				}
@@ -0,0 +1,5 @@
+/*
+Package repl implements an interactive read-eval-print loop for
+Dhall, for experimenting with expressions.
+*/
+package repl
@@ -0,0 +1,356 @@
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/philandstuff/dhall-golang/binary"
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/imports"
+	"github.com/philandstuff/dhall-golang/parser"
+	"github.com/philandstuff/dhall-golang/printer"
+)
+
+// The prompts which Run writes before each input, and before each
+// further line of an input which spans several lines.
+const (
+	Prompt             = "⊢ "
+	ContinuationPrompt = "| "
+)
+
+const help = `Enter an expression to see its normal form, or one of these commands:
+  :let NAME = EXPR      bind NAME to EXPR for the rest of the session
+  :let NAME : T = EXPR  bind NAME to EXPR, which must have type T
+  :type EXPR            show the type of EXPR
+  :hash EXPR            show the semantic hash of EXPR
+  :load FILE            bind the let bindings at the top of FILE
+  :save FILE            write the bindings made so far to FILE
+  :help                 show this help
+  :quit                 end the session
+An input which is incomplete continues on the next line; enter an
+empty line to give up on it.`
+
+var (
+	// errIncomplete means that the input so far could be the start
+	// of a valid input, and more lines are needed
+	errIncomplete = errors.New("incomplete input")
+	// errQuit means that the session is over
+	errQuit = errors.New("quit")
+)
+
+// A REPL is an interactive session.  It remembers the bindings made
+// with :let and :load, which are in scope in every later input.
+type REPL struct {
+	// Cache is used for saving and fetching imports which are
+	// protected by a hash.  If nil, no caching is done.
+	Cache imports.DhallCache
+	// bindings are the bindings made so far, in order.  Each
+	// Value is a closed normal form.
+	bindings []core.Binding
+}
+
+// Run reads inputs from in, one line at a time, and writes prompts,
+// results and errors to out.  An input whose first line doesn't
+// parse on its own continues on the following lines, until it
+// parses or an empty line is entered.  Errors are reported and the
+// session carries on.  Run returns when in is exhausted or :quit is
+// entered.
+func (r *REPL) Run(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, 1<<20)
+	var input strings.Builder
+	for {
+		if input.Len() == 0 {
+			fmt.Fprint(out, Prompt)
+		} else {
+			fmt.Fprint(out, ContinuationPrompt)
+		}
+		if !scanner.Scan() {
+			if input.Len() > 0 {
+				if err := r.exec(input.String(), true, out); err != nil && err != errQuit {
+					fmt.Fprintln(out, err)
+				}
+			}
+			return scanner.Err()
+		}
+		line := scanner.Text()
+		blank := strings.TrimSpace(line) == ""
+		if blank && input.Len() == 0 {
+			continue
+		}
+		if !blank {
+			input.WriteString(line)
+			input.WriteByte('\n')
+		}
+		err := r.exec(input.String(), blank, out)
+		switch err {
+		case errIncomplete:
+			continue
+		case errQuit:
+			return nil
+		case nil:
+		default:
+			fmt.Fprintln(out, err)
+		}
+		input.Reset()
+	}
+}
+
+// exec runs a single input, which is an expression or a command, and
+// writes its result to out.  Unless final is true, it returns
+// errIncomplete if input could be continued on another line.
+func (r *REPL) exec(input string, final bool, out io.Writer) error {
+	if !strings.HasPrefix(input, ":") {
+		expr, err := r.parseExpr(input, final)
+		if err != nil {
+			return err
+		}
+		_, value, err := r.eval(expr)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, printer.Sprint(core.Quote(value)))
+		return nil
+	}
+	command := input
+	arg := ""
+	if i := strings.IndexFunc(input, unicode.IsSpace); i >= 0 {
+		command, arg = input[:i], input[i:]
+	}
+	switch command {
+	case ":let":
+		bindings, err := r.parseBindings(input, final)
+		if err != nil {
+			return err
+		}
+		return r.bind(bindings, out)
+	case ":type":
+		expr, err := r.parseArg(command, arg, final)
+		if err != nil {
+			return err
+		}
+		typ, _, err := r.eval(expr)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, printer.Sprint(core.Quote(typ)))
+		return nil
+	case ":hash":
+		expr, err := r.parseArg(command, arg, final)
+		if err != nil {
+			return err
+		}
+		if _, _, err := r.eval(expr); err != nil {
+			return err
+		}
+		hash, err := binary.SemanticHash(r.inScope(expr))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "sha256:%x\n", hash[2:])
+		return nil
+	case ":load":
+		return r.load(strings.TrimSpace(arg), out)
+	case ":save":
+		return r.save(strings.TrimSpace(arg), out)
+	case ":help":
+		fmt.Fprintln(out, help)
+		return nil
+	case ":quit":
+		return errQuit
+	}
+	return fmt.Errorf("Unknown command %s; enter :help for a list of commands", command)
+}
+
+// parse parses src.  Unless final is true, it returns errIncomplete
+// if the parser ran out of input, so that more lines might make src
+// valid.
+func parse(src string, final bool) (core.Term, error) {
+	term, err := parser.Parse("(input)", []byte(src))
+	if err != nil {
+		if !final && atEnd(err, src) {
+			return nil, errIncomplete
+		}
+		return nil, fmt.Errorf("Parse error: %v", err)
+	}
+	return term.(core.Term), nil
+}
+
+// atEnd reports whether err is a parse error at the end of src,
+// ignoring trailing whitespace.
+func atEnd(err error, src string) bool {
+	var perr parser.Error
+	return errors.As(err, &perr) &&
+		perr.Offset >= len(strings.TrimRightFunc(src, unicode.IsSpace))
+}
+
+// parseExpr parses src as an expression, and resolves its imports
+// relative to the current directory.
+func (r *REPL) parseExpr(src string, final bool) (core.Term, error) {
+	expr, err := parse(src, final)
+	if err != nil {
+		return nil, err
+	}
+	return r.resolve(expr)
+}
+
+// parseArg parses the argument of command as an expression.  arg
+// keeps its leading whitespace, so that parse errors have the right
+// column.
+func (r *REPL) parseArg(command, arg string, final bool) (core.Term, error) {
+	if strings.TrimSpace(arg) == "" {
+		return nil, fmt.Errorf("%s needs an expression, such as `%s 1 + 1`", command, command)
+	}
+	return r.parseExpr(strings.Repeat(" ", len(command))+arg, final)
+}
+
+// resolve resolves the imports in expr, using r.Cache.
+func (r *REPL) resolve(expr core.Term, ancestors ...core.Fetchable) (core.Term, error) {
+	cache := r.Cache
+	if cache == nil {
+		cache = imports.NoCache{}
+	}
+	resolved, err := imports.LoadWith(cache, expr, ancestors...)
+	if err != nil {
+		return nil, fmt.Errorf("Import resolve error: %v", err)
+	}
+	return resolved, nil
+}
+
+// parseBindings parses input, which is a :let command, and resolves
+// the imports of the bindings it makes.
+func (r *REPL) parseBindings(input string, final bool) ([]core.Binding, error) {
+	// replacing the colon with a space gives a let expression
+	// without its body, whose errors have the same columns as
+	// input; a dummy body is added to parse it
+	src := " " + input[1:]
+	errIn := errors.New("Parse error: :let takes bindings without `in`, such as `:let x = 1`")
+	term, err := parser.Parse("(input)", []byte(src+"in {=}"))
+	if err != nil {
+		if !atEnd(err, src) {
+			return nil, fmt.Errorf("Parse error: %v", colonFirst(err))
+		}
+		if !final {
+			return nil, errIncomplete
+		}
+		if _, err := parser.Parse("(input)", []byte(src)); err != nil {
+			return nil, fmt.Errorf("Parse error: %v", colonFirst(err))
+		}
+		return nil, errIn
+	}
+	let := term.(core.Let)
+	if body, ok := let.Body.(core.RecordLit); !ok || len(body) != 0 {
+		return nil, errIn
+	}
+	resolved, err := r.resolve(core.NewLet(core.RecordLit{}, let.Bindings...))
+	if err != nil {
+		return nil, err
+	}
+	return resolved.(core.Let).Bindings, nil
+}
+
+// colonFirst puts the colon back at the start of the first line of a
+// parse error in a :let command.
+func colonFirst(err error) error {
+	var perr parser.Error
+	if errors.As(err, &perr) && perr.Line == 1 && perr.SourceLine != "" {
+		perr.SourceLine = ":" + perr.SourceLine[1:]
+		return perr
+	}
+	return err
+}
+
+// inScope returns expr with the session's bindings in scope.
+func (r *REPL) inScope(expr core.Term) core.Term {
+	if len(r.bindings) == 0 {
+		return expr
+	}
+	return core.NewLet(expr, r.bindings...)
+}
+
+// eval typechecks and evaluates expr with the session's bindings in
+// scope.
+func (r *REPL) eval(expr core.Term) (core.Value, core.Value, error) {
+	expr = r.inScope(expr)
+	typ, err := core.TypeOf(expr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Type error: %v", err)
+	}
+	return typ, core.Eval(expr), nil
+}
+
+// bind adds bindings to the session and writes their types to out.
+// Each binding is checked with the earlier ones in scope.  If any
+// fails, none are added.
+func (r *REPL) bind(bindings []core.Binding, out io.Writer) error {
+	session := REPL{Cache: r.Cache, bindings: r.bindings[:len(r.bindings):len(r.bindings)]}
+	var report strings.Builder
+	for _, b := range bindings {
+		typ, value, err := session.eval(core.NewLet(core.Var{Name: b.Variable}, b))
+		if err != nil {
+			return fmt.Errorf("%s: %v", b.Variable, err)
+		}
+		session.bindings = append(session.bindings, core.Binding{
+			Variable: b.Variable,
+			Value:    core.Quote(value),
+		})
+		fmt.Fprintf(&report, "%s : %s\n", b.Variable, printer.Sprint(core.Quote(typ)))
+	}
+	r.bindings = session.bindings
+	_, err := io.WriteString(out, report.String())
+	return err
+}
+
+// load adds the let bindings at the top of the file at path to the
+// session.  Their imports are resolved relative to the file.
+func (r *REPL) load(path string, out io.Writer) error {
+	if path == "" {
+		return errors.New(":load needs a file name, such as `:load ./package.dhall`")
+	}
+	expr, err := parser.ParseFile(path)
+	if err != nil {
+		return fmt.Errorf("Parse error: %v", err)
+	}
+	let, ok := expr.(core.Let)
+	if !ok {
+		return fmt.Errorf("%s has no let bindings to load", path)
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	here := core.NewLocal("/", strings.Split(strings.TrimPrefix(filepath.ToSlash(abs), "/"), "/"))
+	resolved, err := r.resolve(core.NewLet(core.RecordLit{}, let.Bindings...), here)
+	if err != nil {
+		return err
+	}
+	return r.bind(resolved.(core.Let).Bindings, out)
+}
+
+// save writes the session's bindings to the file at path, as a let
+// expression whose body is a record of the bound names, so that
+// :load can read them back.
+func (r *REPL) save(path string, out io.Writer) error {
+	if path == "" {
+		return errors.New(":save needs a file name, such as `:save ./session.dhall`")
+	}
+	if len(r.bindings) == 0 {
+		return errors.New("There are no bindings to save; make some with :let")
+	}
+	record := core.RecordLit{}
+	for _, b := range r.bindings {
+		record[b.Variable] = core.Var{Name: b.Variable}
+	}
+	source := printer.Sprint(core.NewLet(record, r.bindings...)) + "\n"
+	if err := ioutil.WriteFile(path, []byte(source), 0666); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Saved %d bindings to %s\n", len(r.bindings), path)
+	return nil
+}
@@ -0,0 +1,13 @@
+package repl_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestREPL(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "REPL Suite")
+}
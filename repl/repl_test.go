@@ -0,0 +1,139 @@
+package repl_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/binary"
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+	"github.com/philandstuff/dhall-golang/repl"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// transcript runs a session with the given input lines, and returns
+// what it wrote with the prompts removed.
+func transcript(r *repl.REPL, lines ...string) string {
+	var out strings.Builder
+	err := r.Run(strings.NewReader(strings.Join(lines, "\n")+"\n"), &out)
+	Expect(err).ToNot(HaveOccurred())
+	s := strings.ReplaceAll(out.String(), repl.Prompt, "")
+	return strings.ReplaceAll(s, repl.ContinuationPrompt, "")
+}
+
+func run(lines ...string) string {
+	return transcript(&repl.REPL{}, lines...)
+}
+
+var _ = Describe("REPL", func() {
+	It("shows the normal form of an expression", func() {
+		Expect(run(`1 + 1`, `λ(x : Natural) → x + 0`)).To(Equal("2\nλ(x : Natural) → x\n"))
+	})
+	It("shows types", func() {
+		Expect(run(`:type [ 1, 2 ]`)).To(Equal("List Natural\n"))
+	})
+	It("keeps :let bindings for later inputs", func() {
+		Expect(run(
+			`:let x = 2`,
+			`:let double : Natural → Natural = λ(n : Natural) → n * 2`,
+			`double x`,
+			`:type double`,
+		)).To(Equal("x : Natural\ndouble : ∀(n : Natural) → Natural\n4\n∀(n : Natural) → Natural\n"))
+	})
+	It("lets later bindings shadow earlier ones", func() {
+		Expect(run(`:let x = 1`, `:let x = x + 1`, `[ x, x@1 ]`)).To(Equal(
+			"x : Natural\nx : Natural\n[ 2, 1 ]\n"))
+	})
+	It("shows semantic hashes", func() {
+		hash, err := binary.SemanticHash(NaturalLit(2))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(run(`:let x = 1`, `:hash x + 1`)).To(Equal(
+			fmt.Sprintf("x : Natural\nsha256:%x\n", hash[2:])))
+	})
+	It("continues incomplete input on the next line", func() {
+		Expect(run(`{ a = 1`, `, b = "x"`, `}`, `:let y =`, `  True`, `y`)).To(Equal(
+			"{ a = 1, b = \"x\" }\ny : Bool\nTrue\n"))
+	})
+	It("gives up on incomplete input at an empty line", func() {
+		Expect(run(`1 +`, ``, `2`)).To(Equal(
+			"Parse error: (input):2:1: expected expression\n2\n"))
+	})
+	It("reports errors and carries on", func() {
+		Expect(run(
+			`:let x : Bool = 1`,
+			`:let y = )`,
+			`x`,
+			`:frobnicate`,
+			`3`,
+		)).To(Equal(
+			"x: Type error: " + typeError(`let x : Bool = 1 in x`) + "\n" +
+				"Parse error: (input):1:10: expected expression\n" +
+				"    :let y = )\n" +
+				"             ^\n" +
+				"Type error: " + typeError(`x`) + "\n" +
+				"Unknown command :frobnicate; enter :help for a list of commands\n" +
+				"3\n"))
+	})
+	It("rejects a :let with a body", func() {
+		Expect(run(`:let x = 1 in x`, ``)).To(Equal(
+			"Parse error: :let takes bindings without `in`, such as `:let x = 1`\n"))
+	})
+	It("stops at :quit", func() {
+		Expect(run(`:quit`, `1`)).To(Equal(""))
+	})
+	It("shows help", func() {
+		Expect(run(`:help`)).To(ContainSubstring(":let NAME = EXPR"))
+	})
+	Describe("files", func() {
+		var dir string
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "repl")
+			Expect(err).ToNot(HaveOccurred())
+		})
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+		It("saves bindings which can be loaded again", func() {
+			file := filepath.Join(dir, "session.dhall")
+			Expect(run(
+				`:let x = 1 + 1`,
+				`:let f = λ(n : Natural) → n + x`,
+				`:save `+file,
+			)).To(Equal(fmt.Sprintf("x : Natural\nf : ∀(n : Natural) → Natural\nSaved 2 bindings to %s\n", file)))
+			Expect(ioutil.ReadFile(file)).To(BeEquivalentTo(
+				"let x = 2\n\nlet f = λ(n : Natural) → n + 2\n\nin  { f = f, x = x }\n"))
+			Expect(run(`:load `+file, `f 1`)).To(Equal("x : Natural\nf : ∀(n : Natural) → Natural\n3\n"))
+		})
+		It("resolves imports in loaded files relative to the file", func() {
+			Expect(ioutil.WriteFile(filepath.Join(dir, "a.dhall"), []byte(`let b = ./b.dhall in b`), 0666)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "b.dhall"), []byte(`"b"`), 0666)).To(Succeed())
+			Expect(run(`:load `+filepath.Join(dir, "a.dhall"), `b`)).To(Equal("b : Text\n\"b\"\n"))
+		})
+		It("doesn't load anything if a binding fails", func() {
+			file := filepath.Join(dir, "bad.dhall")
+			Expect(ioutil.WriteFile(file, []byte(`let a = 1 let b = a + True in b`), 0666)).To(Succeed())
+			r := &repl.REPL{}
+			Expect(transcript(r, `:load `+file)).To(HavePrefix("b: Type error:"))
+			Expect(transcript(r, `a`)).To(HavePrefix("Type error:"))
+		})
+		It("refuses to save an empty session", func() {
+			Expect(run(`:save ` + filepath.Join(dir, "empty.dhall"))).To(Equal(
+				"There are no bindings to save; make some with :let\n"))
+		})
+	})
+})
+
+// typeError returns the error from typechecking source.
+func typeError(source string) string {
+	term, err := parser.Parse("-", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	_, err = TypeOf(term.(Term))
+	Expect(err).To(HaveOccurred())
+	return err.Error()
+}